@@ -4,6 +4,7 @@ import (
 	"crypto/tls"
 	_ "embed"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"math"
@@ -18,6 +19,7 @@ import (
 	gonet "github.com/shirou/gopsutil/v4/net"
 
 	"github.com/sensdata/idb/agent/agent/action"
+	"github.com/sensdata/idb/agent/agent/backup"
 	"github.com/sensdata/idb/agent/agent/ca"
 	"github.com/sensdata/idb/agent/agent/docker"
 	"github.com/sensdata/idb/agent/agent/file"
@@ -42,7 +44,9 @@ var (
 	CONFMAN       *config.Manager
 	AGENT         IAgent
 	RsyncLib      = rsync.NewRsyncLib()
+	BackupLib     = backup.NewBackupLib()
 	FileService   = file.NewIFileService()
+	Uploads       = file.NewUploadManager()
 	SshService    = ssh.NewISSHService()
 	GitService    = git.NewIGitService()
 	DockerService = docker.NewIDockerService()
@@ -742,7 +746,15 @@ func (a *Agent) processFileMessage(conn net.Conn, msg *message.FileMessage) {
 	global.LOG.Info("FileMessage: %s, %d, %d", msg.FileName, msg.Offset, msg.ChunkSize)
 
 	switch msg.Type {
+	case message.UploadQuery: //查询上传进度，不存在时创建会话
+		state, err := Uploads.Open(msg.UploadID, msg.Path, msg.FileName, msg.TotalSize, msg.FileHash)
+		a.sendUploadState(conn, msg, state, err)
 	case message.Upload: //上传
+		if msg.UploadID != "" {
+			state, err := Uploads.Write(msg.UploadID, msg.Offset, msg.Chunk, msg.ChunkHash)
+			a.sendUploadState(conn, msg, state, err)
+			return
+		}
 		err := files.NewFileOp().WriteChunkToFile(
 			msg.Path,
 			msg.FileName,
@@ -775,6 +787,7 @@ func (a *Agent) processFileMessage(conn net.Conn, msg *message.FileMessage) {
 			msg.TotalSize = totalSize
 			msg.ChunkSize = bytesRead
 			msg.Chunk = chunk
+			msg.ChunkHash = message.FileChecksum(chunk[:bytesRead])
 			msg.Status = message.FileOk
 			//如果是最后一次传输，则设置为Done
 			if msg.Offset+int64(msg.ChunkSize) == msg.TotalSize {
@@ -1558,11 +1571,15 @@ func (a *Agent) processBusinessAction(actionData *model.Action) (*model.Action,
 			return nil, err
 		}
 
-		err := FileService.Delete(req)
+		deleted, err := FileService.Delete(req)
 		if err != nil {
 			return nil, err
 		}
-		return actionSuccessResult(actionData.Action, "")
+		result, err := utils.ToJSONString(deleted)
+		if err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, result)
 
 		// 批量删除
 	case model.File_Batch_Delete:
@@ -1571,11 +1588,15 @@ func (a *Agent) processBusinessAction(actionData *model.Action) (*model.Action,
 			return nil, err
 		}
 
-		err := FileService.BatchDelete(req)
+		deleted, err := FileService.BatchDelete(req)
 		if err != nil {
 			return nil, err
 		}
-		return actionSuccessResult(actionData.Action, "")
+		result, err := utils.ToJSONString(deleted)
+		if err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, result)
 
 	// 批量修改用户/组
 	case model.File_Batch_Change_Owner:
@@ -1730,6 +1751,221 @@ func (a *Agent) processBusinessAction(actionData *model.Action) (*model.Action,
 		}
 		return actionSuccessResult(actionData.Action, "")
 
+	// 跨设备传输：打包
+	case model.File_Transfer_Pack:
+		var req model.FileTransferPack
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+
+		if err := FileService.TransferPack(req); err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, "")
+
+	// 跨设备传输：解包
+	case model.File_Transfer_Unpack:
+		var req model.FileTransferUnpack
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+
+		if err := FileService.TransferUnpack(req); err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, "")
+
+	// 跨设备传输：打包、解包进度
+	case model.File_Transfer_Status:
+		var req model.FileTransferQuery
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+
+		rsp, err := FileService.TransferStatus(req)
+		if err != nil {
+			return nil, err
+		}
+		result, err := utils.ToJSONString(rsp)
+		if err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, result)
+
+	// 跨设备传输：清理临时文件
+	case model.File_Transfer_Clean:
+		var req model.FileTransferClean
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+
+		if err := FileService.TransferClean(req); err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, "")
+
+	// 回收站：列表
+	case model.File_Trash_List:
+		var req model.TrashList
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+
+		rsp, err := FileService.TrashList(req)
+		if err != nil {
+			return nil, err
+		}
+		result, err := utils.ToJSONString(rsp)
+		if err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, result)
+
+	// 回收站：恢复
+	case model.File_Trash_Restore:
+		var req model.TrashRestore
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+
+		rsp, err := FileService.TrashRestore(req)
+		if err != nil {
+			return nil, err
+		}
+		result, err := utils.ToJSONString(rsp)
+		if err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, result)
+
+	// 回收站：彻底删除
+	case model.File_Trash_Purge:
+		var req model.TrashPurge
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+
+		if err := FileService.TrashPurge(req); err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, "")
+
+	// 回收站：获取设置
+	case model.File_Trash_Settings:
+		rsp, err := FileService.TrashSettings()
+		if err != nil {
+			return nil, err
+		}
+		result, err := utils.ToJSONString(rsp)
+		if err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, result)
+
+	// 回收站：修改设置
+	case model.File_Trash_Set:
+		var req model.TrashSettings
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+
+		if err := FileService.SetTrashSettings(req); err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, "")
+
+	// 内容搜索
+	case model.File_Grep:
+		var req model.FileGrep
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+
+		rsp, err := FileService.Grep(req)
+		if err != nil {
+			return nil, err
+		}
+		result, err := utils.ToJSONString(rsp)
+		if err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, result)
+
+	// 内容搜索：结果
+	case model.File_Grep_Result:
+		var req model.FileGrepQuery
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+
+		rsp, err := FileService.GrepResult(req)
+		if err != nil {
+			return nil, err
+		}
+		result, err := utils.ToJSONString(rsp)
+		if err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, result)
+
+	// 内容搜索：取消
+	case model.File_Grep_Cancel:
+		var req model.FileGrepQuery
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+
+		if err := FileService.GrepCancel(req); err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, "")
+
+	// 远程下载
+	case model.File_Wget:
+		var req model.FileWget
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+
+		key, err := FileService.Wget(req)
+		if err != nil {
+			return nil, err
+		}
+		result, err := utils.ToJSONString(model.FileWgetRes{Key: key})
+		if err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, result)
+
+	// 远程下载：进度
+	case model.File_Wget_Status:
+		var req model.FileWgetQuery
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+
+		rsp, err := FileService.WgetStatus(req)
+		if err != nil {
+			return nil, err
+		}
+		result, err := utils.ToJSONString(rsp)
+		if err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, result)
+
+	// 远程下载：取消
+	case model.File_Wget_Cancel:
+		var req model.FileWgetQuery
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+
+		if err := FileService.WgetCancel(req); err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, "")
+
 	// 目录大小
 	case model.File_Dir_Size:
 		var req model.DirSizeReq
@@ -3107,6 +3343,123 @@ func (a *Agent) processBusinessAction(actionData *model.Action) (*model.Action,
 		}
 		return actionSuccessResult(actionData.Action, result)
 
+	case model.Backup_Create:
+		var req model.CreateBackupJob
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+		created, err := BackupLib.Create(req)
+		if err != nil {
+			return nil, err
+		}
+		result, err := utils.ToJSONString(created)
+		if err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, result)
+
+	case model.Backup_Update:
+		var req model.UpdateBackupJob
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+		if err := BackupLib.Update(req); err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, "")
+
+	case model.Backup_Delete:
+		var req model.QueryBackupJob
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+		if err := BackupLib.Delete(req.ID); err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, "")
+
+	case model.Backup_List:
+		result, err := utils.ToJSONString(BackupLib.List())
+		if err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, result)
+
+	case model.Backup_Run:
+		var req model.QueryBackupJob
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+		log, err := BackupLib.Run(req.ID, backup.TriggerManual)
+		if err != nil {
+			return nil, err
+		}
+		result, err := utils.ToJSONString(log)
+		if err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, result)
+
+	case model.Backup_Snapshots:
+		var req model.QueryBackupJob
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+		snapshots, err := BackupLib.Snapshots(req.ID)
+		if err != nil {
+			return nil, err
+		}
+		result, err := utils.ToJSONString(snapshots)
+		if err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, result)
+
+	case model.Backup_Files:
+		var req model.QueryBackupFiles
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+		files, err := BackupLib.Files(req)
+		if err != nil {
+			return nil, err
+		}
+		result, err := utils.ToJSONString(files)
+		if err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, result)
+
+	case model.Backup_Restore:
+		var req model.BackupRestoreRequest
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+		log, err := BackupLib.Restore(req)
+		if err != nil {
+			return nil, err
+		}
+		result, err := utils.ToJSONString(log)
+		if err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, result)
+
+	case model.Backup_Repository:
+		var req model.QueryBackupJob
+		if err := json.Unmarshal([]byte(actionData.Data), &req); err != nil {
+			return nil, err
+		}
+		repo, err := BackupLib.Repository(req.ID)
+		if err != nil {
+			return nil, err
+		}
+		result, err := utils.ToJSONString(repo)
+		if err != nil {
+			return nil, err
+		}
+		return actionSuccessResult(actionData.Action, result)
+
 	default:
 		return nil, nil
 	}
@@ -3231,6 +3584,42 @@ func (a *Agent) sendUploadResult(conn net.Conn, msg *message.FileMessage, status
 	}
 }
 
+// sendUploadState 回复上传会话的接收进度，会话丢失、块校验失败等可通过续传恢复的错误回复 FileRetry
+func (a *Agent) sendUploadState(conn net.Conn, msg *message.FileMessage, state *file.UploadState, err error) {
+	rspMsg, _ := message.CreateFileMessage(
+		msg.MsgID,
+		msg.Type,
+		message.FileOk,
+		msg.Path,
+		msg.FileName,
+		msg.TotalSize,
+		msg.Offset,
+		0,
+		nil,
+	)
+	rspMsg.UploadID = msg.UploadID
+	switch {
+	case errors.Is(err, file.ErrUploadNotFound), errors.Is(err, file.ErrChunkChecksum), errors.Is(err, file.ErrUploadTooManyChunk):
+		global.LOG.Warn("Upload %s at %d: %v", msg.UploadID, msg.Offset, err)
+		rspMsg.Status = message.FileRetry
+		rspMsg.Error = err.Error()
+	case err != nil:
+		global.LOG.Error("Failed to process upload %s: %v", msg.UploadID, err)
+		rspMsg.Status = message.FileErr
+		rspMsg.Error = err.Error()
+	default:
+		rspMsg.Received = state.Received
+		if state.Done {
+			rspMsg.Status = message.FileDone
+		}
+	}
+
+	if err := message.SendFileMessage(conn, rspMsg); err != nil {
+		global.LOG.Error("Failed to send file rsp : %v", err)
+		a.resetConnection()
+	}
+}
+
 func (a *Agent) sendDownloadResult(conn net.Conn, msg *message.FileMessage) {
 	err := message.SendFileMessage(conn, msg)
 	if err != nil {
@@ -0,0 +1,655 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	rsyncpkg "github.com/sensdata/idb/agent/agent/rsync/pkg"
+	"github.com/sensdata/idb/agent/global"
+	"github.com/sensdata/idb/core/constant"
+	"github.com/sensdata/idb/core/model"
+)
+
+const (
+	// scheduleTick 定时任务的检查间隔
+	scheduleTick = 30 * time.Second
+	// maxJobLogs 每个任务保留的日志数量
+	maxJobLogs = 30
+	// maxListFiles 浏览快照时单个目录返回的文件数量上限
+	maxListFiles = 1000
+
+	TriggerManual   = "manual"
+	TriggerSchedule = "schedule"
+)
+
+// ErrJobBusy 任务正在执行
+var ErrJobBusy = errors.New("backup job is already running")
+
+func backupDir() string {
+	return filepath.Join(constant.AgentDataDir, "backup")
+}
+
+type BackupLib struct {
+	storage *jobStorage
+	mu      sync.Mutex
+	running map[string]bool
+	stopCh  chan struct{}
+}
+
+func NewBackupLib() *BackupLib {
+	storage, err := newJobStorage(filepath.Join(backupDir(), "jobs.json"))
+	if err != nil {
+		panic(err)
+	}
+	lib := &BackupLib{
+		storage: storage,
+		running: map[string]bool{},
+		stopCh:  make(chan struct{}),
+	}
+	go lib.scheduler()
+	return lib
+}
+
+func (l *BackupLib) Create(req model.CreateBackupJob) (*model.BackupJobCreated, error) {
+	if err := validateJob(req, true); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	job := Job{
+		ID:         uuid.New().String(),
+		Name:       req.Name,
+		Repository: req.Repository,
+		Sources:    req.Sources,
+		Excludes:   req.Excludes,
+		Retention:  req.Retention,
+		State:      StatePending,
+		CreatedAt:  now,
+	}
+	if err := applySchedule(&job, req.Schedule, now); err != nil {
+		return nil, err
+	}
+	if err := l.storage.put(job); err != nil {
+		return nil, err
+	}
+	return &model.BackupJobCreated{ID: job.ID}, nil
+}
+
+// Update 仓库的密码、密钥为空时沿用原值，执行中的任务不能修改
+func (l *BackupLib) Update(req model.UpdateBackupJob) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.running[req.ID] {
+		return ErrJobBusy
+	}
+	job, err := l.storage.get(req.ID)
+	if err != nil {
+		return err
+	}
+	repo := req.Repository
+	old := job.Repository
+	keep := func(v *string, saved string) {
+		if *v == "" {
+			*v = saved
+		}
+	}
+	keep(&repo.Password, old.Password)
+	keep(&repo.SSHPassword, old.SSHPassword)
+	keep(&repo.SSHPrivateKey, old.SSHPrivateKey)
+	keep(&repo.AccessKey, old.AccessKey)
+	keep(&repo.SecretKey, old.SecretKey)
+	req.Repository = repo
+	if err := validateJob(req.CreateBackupJob, true); err != nil {
+		return err
+	}
+
+	job.Name = req.Name
+	job.Repository = repo
+	job.Sources = req.Sources
+	job.Excludes = req.Excludes
+	job.Retention = req.Retention
+	if err := applySchedule(&job, req.Schedule, time.Now()); err != nil {
+		return err
+	}
+	return l.storage.put(job)
+}
+
+// Delete 删除任务及其日志，仓库中已有的快照不受影响
+func (l *BackupLib) Delete(id string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.running[id] {
+		return ErrJobBusy
+	}
+	if err := l.storage.delete(id); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(filepath.Join(backupDir(), "logs", id)); err != nil {
+		global.LOG.Warn("[backup] failed to remove logs of job %s: %v", id, err)
+	}
+	return nil
+}
+
+func (l *BackupLib) List() *model.BackupJobList {
+	jobs := l.storage.list()
+	list := &model.BackupJobList{Total: len(jobs), Jobs: make([]*model.BackupJobInfo, 0, len(jobs))}
+	for i := range jobs {
+		list.Jobs = append(list.Jobs, toJobInfo(&jobs[i]))
+	}
+	return list
+}
+
+// Repository 返回任务的完整仓库配置，仅用于恢复到其他设备
+func (l *BackupLib) Repository(id string) (*model.BackupRepository, error) {
+	job, err := l.storage.get(id)
+	if err != nil {
+		return nil, err
+	}
+	return &job.Repository, nil
+}
+
+// Run 异步执行备份，返回日志路径
+func (l *BackupLib) Run(id string, trigger string) (*model.BackupLog, error) {
+	job, err := l.storage.get(id)
+	if err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	if l.running[id] {
+		l.mu.Unlock()
+		return nil, ErrJobBusy
+	}
+	l.running[id] = true
+	l.mu.Unlock()
+
+	logFile, err := openLog(id, "backup")
+	if err != nil {
+		l.finish(id)
+		return nil, err
+	}
+	now := time.Now()
+	err = l.storage.update(id, func(j *Job) {
+		j.State = StateRunning
+		j.LastRunAt = &now
+		j.LastError = ""
+		j.LastLogPath = logFile.Name()
+	})
+	if err != nil {
+		logFile.Close()
+		l.finish(id)
+		return nil, err
+	}
+
+	go func() {
+		defer l.finish(id)
+		defer logFile.Close()
+		fmt.Fprintf(logFile, "[%s] backup job %s started (%s)\n", now.Format(time.DateTime), job.Name, trigger)
+		result, runErr := runBackup(job, logFile)
+		if runErr != nil {
+			fmt.Fprintf(logFile, "[%s] backup failed: %v\n", time.Now().Format(time.DateTime), runErr)
+		} else {
+			fmt.Fprintf(logFile, "[%s] backup finished, snapshot %s\n", time.Now().Format(time.DateTime), result.SnapshotID)
+		}
+		err := l.storage.update(id, func(j *Job) {
+			if runErr != nil {
+				j.State = StateFailed
+				j.LastError = runErr.Error()
+				return
+			}
+			j.State = StateSucceeded
+			j.LastError = result.warning
+			j.LastSnapshotID = result.SnapshotID
+			j.LastSummary = &result.BackupSummary
+		})
+		if err != nil {
+			global.LOG.Error("[backup] failed to update job %s: %v", id, err)
+		}
+	}()
+	return &model.BackupLog{LogPath: logFile.Name()}, nil
+}
+
+func (l *BackupLib) finish(id string) {
+	l.mu.Lock()
+	delete(l.running, id)
+	l.mu.Unlock()
+}
+
+// Snapshots 列出任务的快照，按时间倒序
+func (l *BackupLib) Snapshots(id string) (*model.BackupSnapshotList, error) {
+	job, err := l.storage.get(id)
+	if err != nil {
+		return nil, err
+	}
+	r, cleanup, err := newRestic(job.Repository)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	out, err := r.output(context.Background(), "snapshots", "--json", "--tag", jobTag(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %v", err)
+	}
+	var snapshots []*model.BackupSnapshot
+	if err := json.Unmarshal(out, &snapshots); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshots: %v", err)
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Time.After(snapshots[j].Time) })
+	return &model.BackupSnapshotList{Total: len(snapshots), Snapshots: snapshots}, nil
+}
+
+// Files 列出快照中指定目录下的文件
+func (l *BackupLib) Files(req model.QueryBackupFiles) (*model.BackupFileList, error) {
+	if err := checkSnapshot(req.Snapshot); err != nil {
+		return nil, err
+	}
+	dir := req.Path
+	if dir == "" {
+		dir = "/"
+	}
+	if !path.IsAbs(dir) {
+		return nil, errors.New("path must be absolute")
+	}
+	dir = path.Clean(dir)
+
+	job, err := l.storage.get(req.JobID)
+	if err != nil {
+		return nil, err
+	}
+	r, cleanup, err := newRestic(job.Repository)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	args := []string{"ls", "--json"}
+	if req.Snapshot == "latest" {
+		args = append(args, "--tag", jobTag(req.JobID))
+	}
+	out, err := r.output(context.Background(), append(args, req.Snapshot, dir)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %v", err)
+	}
+
+	list := &model.BackupFileList{Files: []*model.BackupFile{}}
+	for _, line := range strings.Split(string(out), "\n") {
+		var node struct {
+			StructType string    `json:"struct_type"`
+			Name       string    `json:"name"`
+			Type       string    `json:"type"`
+			Path       string    `json:"path"`
+			Size       int64     `json:"size"`
+			MTime      time.Time `json:"mtime"`
+		}
+		if json.Unmarshal([]byte(line), &node) != nil || node.StructType != "node" {
+			continue
+		}
+		// 仅返回目录的直接子项
+		if node.Path == dir || path.Dir(node.Path) != dir {
+			continue
+		}
+		list.Total++
+		if len(list.Files) < maxListFiles {
+			list.Files = append(list.Files, &model.BackupFile{
+				Name:    node.Name,
+				Type:    node.Type,
+				Path:    node.Path,
+				Size:    node.Size,
+				ModTime: node.MTime,
+			})
+		}
+	}
+	return list, nil
+}
+
+// Restore 异步将快照恢复到本机目录，未携带仓库时使用本机任务的仓库
+func (l *BackupLib) Restore(req model.BackupRestoreRequest) (*model.BackupLog, error) {
+	if err := checkSnapshot(req.Snapshot); err != nil {
+		return nil, err
+	}
+	if req.JobID == "" || strings.ContainsAny(req.JobID, `/\`) || req.JobID == ".." {
+		return nil, errors.New("invalid job id")
+	}
+	if !filepath.IsAbs(req.TargetPath) {
+		return nil, errors.New("target path must be absolute")
+	}
+	for _, include := range req.Includes {
+		if !path.IsAbs(include) {
+			return nil, fmt.Errorf("include path %q must be absolute", include)
+		}
+	}
+
+	var repo model.BackupRepository
+	if req.Repository != nil {
+		if err := validateRepository(*req.Repository, true); err != nil {
+			return nil, err
+		}
+		repo = *req.Repository
+	} else {
+		job, err := l.storage.get(req.JobID)
+		if err != nil {
+			return nil, err
+		}
+		repo = job.Repository
+	}
+	r, cleanup, err := newRestic(repo)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(req.TargetPath, 0755); err != nil {
+		cleanup()
+		return nil, err
+	}
+	logFile, err := openLog(req.JobID, "restore")
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+
+	args := []string{"restore", req.Snapshot, "--target", req.TargetPath}
+	if req.Snapshot == "latest" {
+		args = append(args, "--tag", jobTag(req.JobID))
+	}
+	for _, include := range req.Includes {
+		args = append(args, "--include", include)
+	}
+	go func() {
+		defer cleanup()
+		defer logFile.Close()
+		fmt.Fprintf(logFile, "[%s] restore snapshot %s to %s\n", time.Now().Format(time.DateTime), req.Snapshot, req.TargetPath)
+		cmd := r.command(context.Background(), args...)
+		cmd.Stdout = logFile
+		cmd.Stderr = logFile
+		if err := cmd.Run(); err != nil {
+			fmt.Fprintf(logFile, "[%s] restore failed: %v\n", time.Now().Format(time.DateTime), err)
+			return
+		}
+		fmt.Fprintf(logFile, "[%s] restore finished\n", time.Now().Format(time.DateTime))
+	}()
+	return &model.BackupLog{LogPath: logFile.Name()}, nil
+}
+
+// backupResult 备份结果，warning 为部分文件读取失败时的提示
+type backupResult struct {
+	SnapshotID string `json:"snapshot_id"`
+	model.BackupSummary
+	warning string
+}
+
+// runBackup 初始化仓库、执行备份并按保留策略清理旧快照
+func runBackup(job Job, logFile *os.File) (*backupResult, error) {
+	r, cleanup, err := newRestic(job.Repository)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	paths, err := resolveSources(job.Sources)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	if err := r.ensureInit(ctx); err != nil {
+		return nil, err
+	}
+
+	args := []string{"backup", "--json", "--tag", "idb", "--tag", jobTag(job.ID)}
+	for _, exclude := range job.Excludes {
+		args = append(args, "--exclude", exclude)
+	}
+	args = append(args, "--")
+	args = append(args, paths...)
+
+	output := newBackupOutput(logFile)
+	cmd := r.command(ctx, args...)
+	cmd.Stdout = output
+	cmd.Stderr = logFile
+	err = cmd.Run()
+	output.flush()
+	result := &backupResult{}
+	if output.summary != nil {
+		result = output.summary
+	}
+	if err != nil {
+		// 退出码 3 表示快照已创建，但部分文件无法读取
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) || exitErr.ExitCode() != 3 || result.SnapshotID == "" {
+			return nil, err
+		}
+		result.warning = "snapshot created, but some source files could not be read"
+	}
+
+	if keep := retentionArgs(job.Retention); len(keep) > 0 {
+		fmt.Fprintf(logFile, "[%s] applying retention policy\n", time.Now().Format(time.DateTime))
+		args := append([]string{"forget", "--tag", jobTag(job.ID), "--group-by", "host,tags", "--prune"}, keep...)
+		cmd := r.command(ctx, args...)
+		cmd.Stdout = logFile
+		cmd.Stderr = logFile
+		if err := cmd.Run(); err != nil {
+			return nil, fmt.Errorf("snapshot %s created, but failed to apply retention: %v", result.SnapshotID, err)
+		}
+	}
+	return result, nil
+}
+
+// backupOutput 解析 backup --json 的输出，进度按间隔写入日志
+type backupOutput struct {
+	log        *os.File
+	buf        []byte
+	lastStatus time.Time
+	summary    *backupResult
+}
+
+const statusInterval = 5 * time.Second
+
+func newBackupOutput(log *os.File) *backupOutput {
+	return &backupOutput{log: log}
+}
+
+func (o *backupOutput) Write(p []byte) (int, error) {
+	o.buf = append(o.buf, p...)
+	for {
+		idx := bytes.IndexByte(o.buf, '\n')
+		if idx < 0 {
+			break
+		}
+		o.handleLine(string(o.buf[:idx]))
+		o.buf = o.buf[idx+1:]
+	}
+	return len(p), nil
+}
+
+func (o *backupOutput) flush() {
+	if len(o.buf) > 0 {
+		o.handleLine(string(o.buf))
+		o.buf = nil
+	}
+}
+
+func (o *backupOutput) handleLine(line string) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return
+	}
+	var msg struct {
+		MessageType  string   `json:"message_type"`
+		PercentDone  float64  `json:"percent_done"`
+		TotalFiles   int64    `json:"total_files"`
+		FilesDone    int64    `json:"files_done"`
+		TotalBytes   int64    `json:"total_bytes"`
+		BytesDone    int64    `json:"bytes_done"`
+		Item         string   `json:"item"`
+		CurrentFiles []string `json:"current_files"`
+		Error        struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(line), &msg); err != nil {
+		fmt.Fprintln(o.log, line)
+		return
+	}
+	switch msg.MessageType {
+	case "status":
+		if time.Since(o.lastStatus) < statusInterval {
+			return
+		}
+		o.lastStatus = time.Now()
+		fmt.Fprintf(o.log, "progress %.1f%%, files %d/%d, bytes %d/%d\n",
+			msg.PercentDone*100, msg.FilesDone, msg.TotalFiles, msg.BytesDone, msg.TotalBytes)
+	case "error":
+		fmt.Fprintf(o.log, "error: %s %s\n", msg.Item, msg.Error.Message)
+	case "summary":
+		var summary backupResult
+		if err := json.Unmarshal([]byte(line), &summary); err == nil {
+			o.summary = &summary
+		}
+		fmt.Fprintf(o.log, "files new %d, changed %d, unmodified %d, added %d bytes\n",
+			summary.FilesNew, summary.FilesChanged, summary.FilesUnmodified, summary.DataAdded)
+	case "verbose_status":
+	default:
+		fmt.Fprintln(o.log, line)
+	}
+}
+
+// scheduler 按定时配置执行到期的任务
+func (l *BackupLib) scheduler() {
+	ticker := time.NewTicker(scheduleTick)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			l.runDueJobs(now)
+		case <-l.stopCh:
+			return
+		}
+	}
+}
+
+// runDueJobs 上一次执行尚未结束时跳过本次，下次执行时间始终从当前时间重新计算
+func (l *BackupLib) runDueJobs(now time.Time) {
+	for _, job := range l.storage.list() {
+		if job.Schedule == "" {
+			continue
+		}
+		if job.NextRunAt != nil && now.Before(*job.NextRunAt) {
+			continue
+		}
+		sched, err := rsyncpkg.ParseSchedule(job.Schedule)
+		if err != nil {
+			global.LOG.Error("[backup] invalid schedule of job %s: %v", job.ID, err)
+			continue
+		}
+		due := job.NextRunAt != nil
+		next := sched.Next(now)
+		if err := l.storage.update(job.ID, func(j *Job) { j.NextRunAt = &next }); err != nil {
+			global.LOG.Error("[backup] failed to update next run of job %s: %v", job.ID, err)
+			continue
+		}
+		if !due {
+			continue
+		}
+		if _, err := l.Run(job.ID, TriggerSchedule); err != nil {
+			global.LOG.Warn("[backup] skip scheduled run of job %s: %v", job.ID, err)
+		}
+	}
+}
+
+func validateJob(req model.CreateBackupJob, requireSecret bool) error {
+	if strings.TrimSpace(req.Name) == "" {
+		return errors.New("name required")
+	}
+	if err := validateRepository(req.Repository, requireSecret); err != nil {
+		return err
+	}
+	if len(req.Sources.Paths)+len(req.Sources.Apps)+len(req.Sources.Volumes) == 0 {
+		return errors.New("no backup sources")
+	}
+	for _, p := range req.Sources.Paths {
+		if !filepath.IsAbs(p) {
+			return fmt.Errorf("backup path %q must be absolute", p)
+		}
+	}
+	for _, exclude := range req.Excludes {
+		if strings.TrimSpace(exclude) == "" || strings.ContainsAny(exclude, "\r\n") {
+			return fmt.Errorf("invalid exclude pattern %q", exclude)
+		}
+	}
+	return nil
+}
+
+// applySchedule 设置定时配置，配置未变化时保留原有的下次执行时间
+func applySchedule(job *Job, spec string, now time.Time) error {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		job.Schedule = ""
+		job.NextRunAt = nil
+		return nil
+	}
+	sched, err := rsyncpkg.ParseSchedule(spec)
+	if err != nil {
+		return err
+	}
+	if spec == job.Schedule && job.NextRunAt != nil {
+		return nil
+	}
+	next := sched.Next(now)
+	job.Schedule = spec
+	job.NextRunAt = &next
+	return nil
+}
+
+// openLog 在任务日志目录下创建日志文件，并清理超出数量的旧日志
+func openLog(jobID string, kind string) (*os.File, error) {
+	dir := filepath.Join(backupDir(), "logs", jobID)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	if entries, err := os.ReadDir(dir); err == nil && len(entries) >= maxJobLogs {
+		// 文件名以时间开头，按名称排序即按时间排序
+		sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+		for _, e := range entries[:len(entries)-maxJobLogs+1] {
+			os.Remove(filepath.Join(dir, e.Name()))
+		}
+	}
+	name := fmt.Sprintf("%s-%s.log", time.Now().Format("20060102150405.000"), kind)
+	return os.OpenFile(filepath.Join(dir, name), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+}
+
+// toJobInfo 隐藏仓库的密码、密钥
+func toJobInfo(job *Job) *model.BackupJobInfo {
+	repo := job.Repository
+	repo.Password = ""
+	repo.SSHPassword = ""
+	repo.SSHPrivateKey = ""
+	repo.AccessKey = ""
+	repo.SecretKey = ""
+	return &model.BackupJobInfo{
+		ID:             job.ID,
+		Name:           job.Name,
+		Repository:     repo,
+		Sources:        job.Sources,
+		Excludes:       job.Excludes,
+		Schedule:       job.Schedule,
+		NextRunAt:      job.NextRunAt,
+		Retention:      job.Retention,
+		State:          job.State,
+		LastRunAt:      job.LastRunAt,
+		LastError:      job.LastError,
+		LastSnapshotID: job.LastSnapshotID,
+		LastSummary:    job.LastSummary,
+		LastLogPath:    job.LastLogPath,
+		CreatedAt:      job.CreatedAt,
+		UpdatedAt:      job.UpdatedAt,
+	}
+}
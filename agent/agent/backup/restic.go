@@ -0,0 +1,238 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/sensdata/idb/core/model"
+)
+
+const resticBin = "restic"
+
+// snapshotPattern 快照 ID（完整或前缀）
+var snapshotPattern = regexp.MustCompile(`^[0-9a-f]{8,64}$`)
+
+// validateRepository 校验仓库配置，requireSecret 为 false 时允许密码、密钥为空（更新时保留原值）
+func validateRepository(repo model.BackupRepository, requireSecret bool) error {
+	if requireSecret && repo.Password == "" {
+		return errors.New("repository password is required")
+	}
+	switch repo.Type {
+	case "local":
+		if !filepath.IsAbs(repo.Path) {
+			return errors.New("local repository path must be absolute")
+		}
+	case "sftp":
+		if repo.SSHHost == "" || repo.SSHUser == "" {
+			return errors.New("sftp repository requires host and user")
+		}
+		// sftp.command 按 shell 规则拆分，主机与用户名不能包含空白及引号
+		if strings.ContainsAny(repo.SSHHost+repo.SSHUser, " \t'\"\\") {
+			return errors.New("invalid sftp host or user")
+		}
+		if !filepath.IsAbs(repo.Path) {
+			return errors.New("sftp repository path must be absolute")
+		}
+		if requireSecret && repo.SSHPassword == "" && repo.SSHPrivateKey == "" {
+			return errors.New("sftp repository requires password or private key")
+		}
+	case "s3":
+		if repo.Endpoint == "" || repo.Bucket == "" {
+			return errors.New("s3 repository requires endpoint and bucket")
+		}
+		if requireSecret && (repo.AccessKey == "" || repo.SecretKey == "") {
+			return errors.New("s3 repository requires access key and secret key")
+		}
+		return validateEndpoint(repo.Endpoint)
+	default:
+		return fmt.Errorf("unsupported repository type %q", repo.Type)
+	}
+	return nil
+}
+
+// restic 封装对单个仓库执行的 restic 命令
+type restic struct {
+	repo    model.BackupRepository
+	keyPath string
+}
+
+// newRestic sftp 仓库使用私钥时将其写入临时文件，调用方须执行返回的 cleanup
+func newRestic(repo model.BackupRepository) (*restic, func(), error) {
+	if _, err := exec.LookPath(resticBin); err != nil {
+		return nil, nil, errors.New("restic is not installed on this host")
+	}
+	r := &restic{repo: repo}
+	cleanup := func() {}
+	if repo.Type == "sftp" && repo.SSHPrivateKey != "" {
+		dir := filepath.Join(backupDir(), "keys")
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, nil, err
+		}
+		f, err := os.CreateTemp(dir, "key-*")
+		if err != nil {
+			return nil, nil, err
+		}
+		key := repo.SSHPrivateKey
+		if !strings.HasSuffix(key, "\n") {
+			key += "\n"
+		}
+		_, err = f.WriteString(key)
+		f.Close()
+		if err != nil {
+			os.Remove(f.Name())
+			return nil, nil, err
+		}
+		r.keyPath = f.Name()
+		cleanup = func() { os.Remove(r.keyPath) }
+	}
+	return r, cleanup, nil
+}
+
+func (r *restic) repoURL() string {
+	switch r.repo.Type {
+	case "sftp":
+		return fmt.Sprintf("sftp:%s@%s:%s", r.repo.SSHUser, r.repo.SSHHost, r.repo.Path)
+	case "s3":
+		endpoint := strings.TrimRight(r.repo.Endpoint, "/")
+		if !strings.Contains(endpoint, "://") {
+			endpoint = "https://" + endpoint
+		}
+		u := "s3:" + endpoint + "/" + r.repo.Bucket
+		if prefix := strings.Trim(r.repo.Path, "/"); prefix != "" {
+			u += "/" + prefix
+		}
+		return u
+	default:
+		return r.repo.Path
+	}
+}
+
+// sftpCommand 由 restic 按 shell 规则拆分后直接执行，密码通过 SSHPASS 环境变量传递
+func (r *restic) sftpCommand() string {
+	port := r.repo.SSHPort
+	if port == 0 {
+		port = 22
+	}
+	parts := []string{
+		"ssh", r.repo.SSHUser + "@" + r.repo.SSHHost,
+		"-p", strconv.Itoa(port),
+		"-o", "StrictHostKeyChecking=no",
+		"-o", "UserKnownHostsFile=/dev/null",
+	}
+	if r.keyPath != "" {
+		parts = append(parts, "-i", r.keyPath, "-o", "BatchMode=yes")
+	} else if r.repo.SSHPassword != "" {
+		parts = append([]string{"sshpass", "-e"}, parts...)
+	}
+	return strings.Join(append(parts, "-s", "sftp"), " ")
+}
+
+func (r *restic) env() []string {
+	env := []string{
+		"RESTIC_REPOSITORY=" + r.repoURL(),
+		"RESTIC_PASSWORD=" + r.repo.Password,
+		"RESTIC_CACHE_DIR=" + filepath.Join(backupDir(), "cache"),
+	}
+	switch r.repo.Type {
+	case "sftp":
+		if r.keyPath == "" && r.repo.SSHPassword != "" {
+			env = append(env, "SSHPASS="+r.repo.SSHPassword)
+		}
+	case "s3":
+		env = append(env,
+			"AWS_ACCESS_KEY_ID="+r.repo.AccessKey,
+			"AWS_SECRET_ACCESS_KEY="+r.repo.SecretKey,
+		)
+		if r.repo.Region != "" {
+			env = append(env, "AWS_DEFAULT_REGION="+r.repo.Region)
+		}
+	}
+	return env
+}
+
+func (r *restic) command(ctx context.Context, args ...string) *exec.Cmd {
+	if r.repo.Type == "sftp" {
+		args = append([]string{"-o", "sftp.command=" + r.sftpCommand()}, args...)
+	}
+	cmd := exec.CommandContext(ctx, resticBin, args...)
+	cmd.Env = append(os.Environ(), r.env()...)
+	return cmd
+}
+
+// output 执行命令并返回标准输出，失败时错误中包含标准错误的内容
+func (r *restic) output(ctx context.Context, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := r.command(ctx, args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return out, fmt.Errorf("%v: %s", err, msg)
+		}
+		return out, err
+	}
+	return out, nil
+}
+
+// ensureInit 仓库不存在时初始化
+func (r *restic) ensureInit(ctx context.Context) error {
+	if _, err := r.output(ctx, "cat", "config"); err == nil {
+		return nil
+	}
+	if _, err := r.output(ctx, "init"); err != nil {
+		return fmt.Errorf("failed to init repository: %v", err)
+	}
+	return nil
+}
+
+func jobTag(id string) string {
+	return "job:" + id
+}
+
+// checkSnapshot 快照须为 ID 或 latest，防止被当作命令行参数
+func checkSnapshot(snapshot string) error {
+	if snapshot == "latest" || snapshotPattern.MatchString(snapshot) {
+		return nil
+	}
+	return fmt.Errorf("invalid snapshot %q", snapshot)
+}
+
+// retentionArgs 保留策略对应的 forget 参数，未设置时返回空
+func retentionArgs(r model.BackupRetention) []string {
+	var args []string
+	for _, keep := range []struct {
+		flag  string
+		value int
+	}{
+		{"--keep-last", r.KeepLast},
+		{"--keep-daily", r.KeepDaily},
+		{"--keep-weekly", r.KeepWeekly},
+		{"--keep-monthly", r.KeepMonthly},
+	} {
+		if keep.value > 0 {
+			args = append(args, keep.flag, strconv.Itoa(keep.value))
+		}
+	}
+	return args
+}
+
+// validateEndpoint s3 地址须为 http(s) 地址或主机名
+func validateEndpoint(endpoint string) error {
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid s3 endpoint %q", endpoint)
+	}
+	return nil
+}
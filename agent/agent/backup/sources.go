@@ -0,0 +1,74 @@
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/joho/godotenv"
+	"github.com/sensdata/idb/core/constant"
+	"github.com/sensdata/idb/core/model"
+	"github.com/sensdata/idb/core/utils"
+)
+
+// resolveSources 将路径、应用及 Docker 卷解析为待备份的目录
+func resolveSources(sources model.BackupSources) ([]string, error) {
+	paths := append([]string{}, sources.Paths...)
+	for _, app := range sources.Apps {
+		path, err := appDataPath(app)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	for _, volume := range sources.Volumes {
+		path, err := volumeMountpoint(volume)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	if len(paths) == 0 {
+		return nil, errors.New("no backup sources")
+	}
+	for _, path := range paths {
+		if !filepath.IsAbs(path) {
+			return nil, fmt.Errorf("backup path %q must be absolute", path)
+		}
+	}
+	return paths, nil
+}
+
+// appDataPath 读取应用 .env 中的 iDB_service_data_path，相对路径以应用目录为基准
+func appDataPath(app string) (string, error) {
+	if app == "" || utils.CheckIllegal(app) || strings.ContainsAny(app, `/\`) || app == ".." {
+		return "", fmt.Errorf("invalid app name %q", app)
+	}
+	dir := filepath.Join(constant.AgentDockerDir, app)
+	env, err := godotenv.Read(filepath.Join(dir, ".env"))
+	if err != nil {
+		return "", fmt.Errorf("failed to read env of app %s: %v", app, err)
+	}
+	path := env[constant.IDB_service_data_path]
+	if path == "" {
+		return "", fmt.Errorf("app %s has no %s", app, constant.IDB_service_data_path)
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+	return filepath.Clean(path), nil
+}
+
+// volumeMountpoint 查询 Docker 卷在本机的挂载点
+func volumeMountpoint(name string) (string, error) {
+	if name == "" || strings.HasPrefix(name, "-") || utils.CheckIllegal(name) {
+		return "", fmt.Errorf("invalid volume name %q", name)
+	}
+	out, err := exec.Command("docker", "volume", "inspect", "--format", "{{.Mountpoint}}", name).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect volume %s: %s", name, strings.TrimSpace(string(out)))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,150 @@
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sensdata/idb/agent/global"
+	"github.com/sensdata/idb/core/model"
+)
+
+const (
+	StatePending   = "pending"
+	StateRunning   = "running"
+	StateSucceeded = "succeeded"
+	StateFailed    = "failed"
+)
+
+// Job 备份任务，仓库的密码、密钥一并保存，文件仅 root 可读
+type Job struct {
+	ID             string                 `json:"id"`
+	Name           string                 `json:"name"`
+	Repository     model.BackupRepository `json:"repository"`
+	Sources        model.BackupSources    `json:"sources"`
+	Excludes       []string               `json:"excludes,omitempty"`
+	Schedule       string                 `json:"schedule,omitempty"`
+	NextRunAt      *time.Time             `json:"next_run_at,omitempty"`
+	Retention      model.BackupRetention  `json:"retention"`
+	State          string                 `json:"state"`
+	LastRunAt      *time.Time             `json:"last_run_at,omitempty"`
+	LastError      string                 `json:"last_error,omitempty"`
+	LastSnapshotID string                 `json:"last_snapshot_id,omitempty"`
+	LastSummary    *model.BackupSummary   `json:"last_summary,omitempty"`
+	LastLogPath    string                 `json:"last_log_path,omitempty"`
+	CreatedAt      time.Time              `json:"created_at"`
+	UpdatedAt      time.Time              `json:"updated_at"`
+}
+
+var errJobNotFound = errors.New("backup job not found")
+
+// jobStorage 以 JSON 文件保存任务，读写均返回副本
+type jobStorage struct {
+	file string
+	mu   sync.RWMutex
+	jobs map[string]*Job
+}
+
+func newJobStorage(path string) (*jobStorage, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create storage dir: %w", err)
+	}
+	s := &jobStorage{file: path, jobs: map[string]*Job{}}
+
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read storage file: %w", err)
+	}
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, &s.jobs); err != nil {
+			return nil, fmt.Errorf("failed to parse storage file: %w", err)
+		}
+	}
+	// 修复异常退出时仍处于运行中的任务
+	for _, job := range s.jobs {
+		if job.State == StateRunning {
+			job.State = StateFailed
+			job.LastError = "agent restarted while job running"
+		}
+	}
+	return s, s.persistLocked()
+}
+
+func (s *jobStorage) persistLocked() error {
+	b, err := json.MarshalIndent(s.jobs, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.file, b, 0600)
+}
+
+func (s *jobStorage) get(id string) (Job, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return Job{}, errJobNotFound
+	}
+	return *job, nil
+}
+
+// list 按创建时间排序
+func (s *jobStorage) list() []Job {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]Job, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		out = append(out, *job)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.Before(out[j].CreatedAt) })
+	return out
+}
+
+// put 保存任务，名称须唯一
+func (s *jobStorage) put(job Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, existing := range s.jobs {
+		if id != job.ID && existing.Name == job.Name {
+			return errors.New("backup job name already exists")
+		}
+	}
+	job.UpdatedAt = time.Now()
+	s.jobs[job.ID] = &job
+	return s.persistLocked()
+}
+
+// update 在锁内修改任务，用于执行过程中更新状态
+func (s *jobStorage) update(id string, fn func(job *Job)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return errJobNotFound
+	}
+	fn(job)
+	job.UpdatedAt = time.Now()
+	return s.persistLocked()
+}
+
+func (s *jobStorage) delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[id]; !ok {
+		return errJobNotFound
+	}
+	delete(s.jobs, id)
+	if err := s.persistLocked(); err != nil {
+		global.LOG.Error("[backup] failed to persist jobs: %v", err)
+		return err
+	}
+	return nil
+}
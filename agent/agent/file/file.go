@@ -27,8 +27,8 @@ type IFileService interface {
 	SearchFiles(op model.FileOption) (*model.PageResult, error)
 	GetFileTree(op model.FileOption) ([]model.FileTree, error)
 	Create(op model.FileCreate) error
-	Delete(op model.FileDelete) error
-	BatchDelete(op model.FileBatchDelete) error
+	Delete(op model.FileDelete) (*model.FileDeleteResult, error)
+	BatchDelete(op model.FileBatchDelete) (*model.FileDeleteResult, error)
 	Compress(c model.FileCompress) error
 	DeCompress(c model.FileDeCompress) error
 	GetContent(op model.FileContentReq) (*model.FileInfo, error)
@@ -38,11 +38,25 @@ type IFileService interface {
 	DirSize(req model.DirSizeReq) (*model.DirSizeRes, error)
 	ChangeName(req model.FileRename) error
 	Wget(w model.FileWget) (string, error)
+	WgetStatus(req model.FileWgetQuery) (*model.FileWgetStatus, error)
+	WgetCancel(req model.FileWgetQuery) error
+	Grep(req model.FileGrep) (*model.FileGrepRes, error)
+	GrepResult(req model.FileGrepQuery) (*model.FileGrepResult, error)
+	GrepCancel(req model.FileGrepQuery) error
+	TrashList(req model.TrashList) (*model.PageResult, error)
+	TrashRestore(req model.TrashRestore) (*model.TrashItem, error)
+	TrashPurge(req model.TrashPurge) error
+	TrashSettings() (*model.TrashSettings, error)
+	SetTrashSettings(req model.TrashSettings) error
 	MvFile(m model.FileMove) error
 	ChangeOwner(req model.FileRoleUpdate) error
 	ChangeMode(op model.FileCreate) error
 	BatchChangeMode(op model.FileModeReq) error
 	BatchChangeOwner(op model.FileRoleReq) error
+	TransferPack(req model.FileTransferPack) error
+	TransferUnpack(req model.FileTransferUnpack) error
+	TransferStatus(req model.FileTransferQuery) (*model.FileTransferStatus, error)
+	TransferClean(req model.FileTransferClean) error
 
 	GetFavoriteList(req model.PageInfo) (*model.PageResult, error)
 	CreateFavorite(req model.FavoriteCreate) (*model.Favorite, error)
@@ -156,43 +170,71 @@ func (f *FileService) Create(op model.FileCreate) error {
 	return nil
 }
 
-func (f *FileService) Delete(op model.FileDelete) error {
+// Delete 删除文件，默认移入回收站，大于回收站容量上限的直接删除并在结果中返回
+func (f *FileService) Delete(op model.FileDelete) (*model.FileDeleteResult, error) {
 	fo := files.NewFileOp()
 	info, err := fo.Fs.Stat(op.Path)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if op.ForceDelete {
-		if info.IsDir() {
-			return fo.DeleteDir(op.Path)
-		} else {
-			return fo.DeleteFile(op.Path)
+	result := &model.FileDeleteResult{Purged: []string{}}
+	if !op.ForceDelete {
+		trashed, err := f.moveToTrash(op.Path)
+		switch {
+		case errors.Is(err, errTrashOversize):
+			result.Purged = append(result.Purged, op.Path)
+		case err != nil:
+			return nil, err
+		case trashed:
+			f.enforceTrash()
+			return result, db.FavoriteRepo.Delete(db.FavoriteRepo.WithByPath(op.Path))
 		}
 	}
-	if err := NewIRecycleBinService().Create(model.RecycleBinCreate{SourcePath: op.Path}); err != nil {
-		return err
+	if info.IsDir() {
+		err = fo.DeleteDir(op.Path)
+	} else {
+		err = fo.DeleteFile(op.Path)
 	}
-	return db.FavoriteRepo.Delete(db.FavoriteRepo.WithByPath(op.Path))
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
-func (f *FileService) BatchDelete(op model.FileBatchDelete) error {
+// BatchDelete 批量删除，与 Delete 相同，大于回收站容量上限的直接删除并在结果中返回
+func (f *FileService) BatchDelete(op model.FileBatchDelete) (*model.FileDeleteResult, error) {
 	fo := files.NewFileOp()
+	if !op.ForceDelete {
+		defer f.enforceTrash()
+	}
+	result := &model.FileDeleteResult{Purged: []string{}}
 	for _, path := range op.Paths {
 		info, err := fo.Fs.Stat(path)
 		if err != nil {
 			continue
 		}
+		if !op.ForceDelete {
+			trashed, err := f.moveToTrash(path)
+			switch {
+			case errors.Is(err, errTrashOversize):
+				result.Purged = append(result.Purged, path)
+			case err != nil:
+				return nil, err
+			case trashed:
+				continue
+			}
+		}
 		if info.IsDir() {
 			if err := fo.DeleteDir(path); err != nil {
-				return err
+				return nil, err
 			}
 		} else {
 			if err := fo.DeleteFile(path); err != nil {
-				return err
+				return nil, err
 			}
 		}
 	}
-	return nil
+	return result, nil
 }
 
 func (f *FileService) ChangeMode(op model.FileCreate) error {
@@ -303,6 +345,10 @@ func (f *FileService) SaveContent(edit model.FileEdit) error {
 		return err
 	}
 
+	if err := f.snapshot(edit.Source); err != nil {
+		return err
+	}
+	f.enforceTrash()
 	fo := files.NewFileOp()
 	return fo.WriteFile(edit.Source, strings.NewReader(edit.Content), info.FileMode)
 }
@@ -320,12 +366,6 @@ func (f *FileService) ChangeName(req model.FileRename) error {
 	return fo.Rename(req.Source, req.NewName)
 }
 
-func (f *FileService) Wget(w model.FileWget) (string, error) {
-	fo := files.NewFileOp()
-	key := "file-wget-" + utils.GenerateUuid()
-	return key, fo.DownloadFileWithProcess(w.Url, filepath.Join(w.Path, w.Name), key, w.IgnoreCertificate, func(process files.Process) {})
-}
-
 func (f *FileService) MvFile(m model.FileMove) error {
 	fo := files.NewFileOp()
 	if !fo.Stat(m.Dest) {
@@ -342,6 +382,19 @@ func (f *FileService) MvFile(m model.FileMove) error {
 			return errors.New(constant.ErrMovePathFailed)
 		}
 	}
+	if m.Cover {
+		// 覆盖已存在的文件前保存快照，目录不会被 mv、cp 整体替换
+		for _, src := range m.Sources {
+			target := filepath.Join(m.Dest, filepath.Base(src))
+			if m.Type == "cut" && m.Name != "" && !fo.Stat(filepath.Join(m.Dest, m.Name)) {
+				target = filepath.Join(m.Dest, m.Name)
+			}
+			if err := f.snapshot(target); err != nil {
+				return err
+			}
+		}
+		f.enforceTrash()
+	}
 	if m.Type == "cut" {
 		global.LOG.Info("cut files %v to %s", m.Sources, m.Dest)
 		return fo.Cut(m.Sources, m.Dest, m.Name, m.Cover)
@@ -0,0 +1,364 @@
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sensdata/idb/agent/global"
+	"github.com/sensdata/idb/core/model"
+	"github.com/sensdata/idb/core/utils"
+)
+
+const (
+	grepDefaultMaxFileSize = 10 * 1024 * 1024
+	grepDefaultMaxResults  = 1000
+	grepMaxLineLength      = 512              // 返回的行内容的最大长度
+	grepMaxLineSize        = 1024 * 1024      // 参与匹配的单行最大长度，超出部分丢弃
+	grepBinaryCheckSize    = 8000             // 前 8000 字节中有 0 字节时视为二进制文件，与 GNU grep 一致
+	grepIdleTimeout        = time.Minute      // 超过该时间未被查询时取消搜索
+	grepDoneExpire         = 5 * time.Minute  // 结束的搜索保留一段时间，供读取剩余结果
+	grepCleanInterval      = 30 * time.Second // 清理过期搜索的间隔
+)
+
+var ErrGrepNotFound = errors.New("search not found")
+
+// grepSkipDirs 虚拟文件系统，仅在作为搜索路径时搜索
+var grepSkipDirs = map[string]bool{"/proc": true, "/sys": true, "/dev": true, "/run": true}
+
+// greps 进行中及最近结束的内容搜索
+var greps = &grepManager{tasks: map[string]*grepTask{}}
+
+type grepManager struct {
+	mu      sync.Mutex
+	tasks   map[string]*grepTask
+	cleaner sync.Once
+}
+
+type grepTask struct {
+	mu         sync.Mutex
+	result     model.FileGrepResult
+	cancel     context.CancelFunc
+	lastActive time.Time
+	finished   time.Time
+}
+
+type grepper struct {
+	req     model.FileGrep
+	re      *regexp.Regexp
+	task    *grepTask
+	limit   int
+	maxSize int64
+}
+
+// Grep 在后台递归搜索文件内容，返回用于读取结果的 key
+func (f *FileService) Grep(req model.FileGrep) (*model.FileGrepRes, error) {
+	root := filepath.Clean(req.Path)
+	if !filepath.IsAbs(root) {
+		return nil, fmt.Errorf("invalid path %q", req.Path)
+	}
+	if _, err := os.Stat(root); err != nil {
+		return nil, err
+	}
+	pattern := req.Pattern
+	if !req.Regex {
+		pattern = regexp.QuoteMeta(pattern)
+	}
+	if req.IgnoreCase {
+		pattern = "(?i)" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern: %v", err)
+	}
+	for _, glob := range append(append([]string{}, req.Includes...), req.Excludes...) {
+		if _, err := filepath.Match(glob, ""); err != nil {
+			return nil, fmt.Errorf("invalid glob %q", glob)
+		}
+	}
+
+	g := &grepper{req: req, re: re, limit: req.MaxResults, maxSize: req.MaxFileSize}
+	if g.limit <= 0 {
+		g.limit = grepDefaultMaxResults
+	}
+	if g.maxSize <= 0 {
+		g.maxSize = grepDefaultMaxFileSize
+	}
+	key := "file-grep-" + utils.GenerateUuid()
+	ctx, cancel := context.WithCancel(context.Background())
+	g.task = &grepTask{
+		result:     model.FileGrepResult{Matches: []model.FileGrepMatch{}},
+		cancel:     cancel,
+		lastActive: time.Now(),
+	}
+	greps.add(key, g.task)
+
+	go func() {
+		defer cancel()
+		err := g.walk(ctx, root)
+		g.task.mu.Lock()
+		defer g.task.mu.Unlock()
+		g.task.result.Done = true
+		g.task.finished = time.Now()
+		if err != nil && !errors.Is(err, errGrepLimit) {
+			g.task.result.Error = err.Error()
+		}
+		global.LOG.Info("Grep %q in %s finished: %d matches in %d files", req.Pattern, root, len(g.task.result.Matches), g.task.result.Scanned)
+	}()
+	return &model.FileGrepRes{Key: key}, nil
+}
+
+// GrepResult 返回第 Offset 个之后的匹配结果及当前进度
+func (f *FileService) GrepResult(req model.FileGrepQuery) (*model.FileGrepResult, error) {
+	task, ok := greps.get(req.Key)
+	if !ok {
+		return nil, ErrGrepNotFound
+	}
+	task.mu.Lock()
+	defer task.mu.Unlock()
+	task.lastActive = time.Now()
+	result := task.result
+	offset := min(max(req.Offset, 0), len(result.Matches))
+	result.Matches = append([]model.FileGrepMatch{}, result.Matches[offset:]...)
+	return &result, nil
+}
+
+// GrepCancel 取消搜索并丢弃结果
+func (f *FileService) GrepCancel(req model.FileGrepQuery) error {
+	task, ok := greps.remove(req.Key)
+	if !ok {
+		return ErrGrepNotFound
+	}
+	task.cancel()
+	return nil
+}
+
+var errGrepLimit = errors.New("result limit reached")
+
+func (g *grepper) walk(ctx context.Context, root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if err != nil {
+			// 无法读取的目录或文件跳过
+			g.skip()
+			if d != nil && d.IsDir() && path != root {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			if path != root && (grepSkipDirs[path] || matchAny(g.req.Excludes, d.Name())) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		// 不跟随符号链接，跳过设备等特殊文件
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		if matchAny(g.req.Excludes, d.Name()) {
+			return nil
+		}
+		if len(g.req.Includes) > 0 && !matchAny(g.req.Includes, d.Name()) {
+			return nil
+		}
+		return g.grepFile(ctx, path, d)
+	})
+}
+
+// grepFile 逐行读取文件，只保留前文所需的最近几行，避免将整个文件读入内存
+func (g *grepper) grepFile(ctx context.Context, path string, d fs.DirEntry) error {
+	info, err := d.Info()
+	if err != nil || info.Size() > g.maxSize {
+		g.skip()
+		return nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		g.skip()
+		return nil
+	}
+	defer file.Close()
+	r := bufio.NewReaderSize(file, grepBinaryCheckSize)
+	head, err := r.Peek(grepBinaryCheckSize)
+	if (err != nil && err != io.EOF) || bytes.IndexByte(head, 0) >= 0 {
+		g.skip()
+		return nil
+	}
+
+	n := g.req.Context
+	before := &lineRing{lines: make([]string, n)}
+	var matches []model.FileGrepMatch
+	var pending []int // 仍在收集后文的匹配在 matches 中的下标
+	for lineNo := 1; len(matches) <= g.limit || len(pending) > 0; lineNo++ {
+		if lineNo%1024 == 0 && ctx.Err() != nil {
+			return ctx.Err()
+		}
+		line, err := readLine(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			g.skip()
+			return nil
+		}
+
+		trimmed := trimLine(line)
+		for _, i := range pending {
+			matches[i].After = append(matches[i].After, trimmed)
+		}
+		if len(pending) > 0 && len(matches[pending[0]].After) == n {
+			pending = pending[1:]
+		}
+		if g.re.MatchString(line) {
+			matches = append(matches, model.FileGrepMatch{Path: path, Line: lineNo, Content: trimmed, Before: before.list()})
+			if n > 0 {
+				pending = append(pending, len(matches)-1)
+			}
+		}
+		before.push(trimmed)
+	}
+
+	g.task.mu.Lock()
+	defer g.task.mu.Unlock()
+	g.task.result.Scanned++
+	for _, match := range matches {
+		if len(g.task.result.Matches) >= g.limit {
+			g.task.result.Truncated = true
+			return errGrepLimit
+		}
+		g.task.result.Matches = append(g.task.result.Matches, match)
+	}
+	return nil
+}
+
+// readLine 读取一行，不含换行符，超过 grepMaxLineSize 的部分丢弃，文件结束时返回 io.EOF
+func readLine(r *bufio.Reader) (string, error) {
+	var line []byte
+	read := false
+	for {
+		chunk, err := r.ReadSlice('\n')
+		read = read || len(chunk) > 0
+		chunk = bytes.TrimSuffix(chunk, []byte("\n"))
+		if room := grepMaxLineSize - len(line); room > 0 {
+			line = append(line, chunk[:min(len(chunk), room)]...)
+		}
+		switch {
+		case err == bufio.ErrBufferFull:
+			continue
+		case err == io.EOF && read:
+			return string(line), nil
+		case err != nil:
+			return "", err
+		}
+		return string(line), nil
+	}
+}
+
+// lineRing 保存最近的 len(lines) 行，作为匹配行的前文
+type lineRing struct {
+	lines []string
+	next  int
+	count int
+}
+
+func (r *lineRing) push(line string) {
+	if len(r.lines) == 0 {
+		return
+	}
+	r.lines[r.next] = line
+	r.next = (r.next + 1) % len(r.lines)
+	r.count = min(r.count+1, len(r.lines))
+}
+
+// list 按从旧到新的顺序返回保存的行
+func (r *lineRing) list() []string {
+	if r.count == 0 {
+		return nil
+	}
+	start := (r.next - r.count + len(r.lines)) % len(r.lines)
+	list := make([]string, 0, r.count)
+	for i := 0; i < r.count; i++ {
+		list = append(list, r.lines[(start+i)%len(r.lines)])
+	}
+	return list
+}
+
+func (g *grepper) skip() {
+	g.task.mu.Lock()
+	g.task.result.Skipped++
+	g.task.mu.Unlock()
+}
+
+func (m *grepManager) add(key string, task *grepTask) {
+	m.cleaner.Do(func() { go m.cleanup() })
+	m.mu.Lock()
+	m.tasks[key] = task
+	m.mu.Unlock()
+}
+
+func (m *grepManager) get(key string) (*grepTask, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	task, ok := m.tasks[key]
+	return task, ok
+}
+
+func (m *grepManager) remove(key string) (*grepTask, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	task, ok := m.tasks[key]
+	delete(m.tasks, key)
+	return task, ok
+}
+
+// cleanup 取消长时间未被查询的搜索，删除过期的结果
+func (m *grepManager) cleanup() {
+	ticker := time.NewTicker(grepCleanInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		m.mu.Lock()
+		for key, task := range m.tasks {
+			task.mu.Lock()
+			expired := time.Since(task.lastActive) > grepIdleTimeout
+			if !task.finished.IsZero() {
+				expired = time.Since(task.finished) > grepDoneExpire
+			}
+			task.mu.Unlock()
+			if expired {
+				task.cancel()
+				delete(m.tasks, key)
+			}
+		}
+		m.mu.Unlock()
+	}
+}
+
+func matchAny(globs []string, name string) bool {
+	for _, glob := range globs {
+		if ok, _ := filepath.Match(glob, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func trimLine(line string) string {
+	line = strings.TrimSuffix(line, "\r")
+	if len(line) > grepMaxLineLength {
+		line = strings.ToValidUTF8(line[:grepMaxLineLength], "")
+	}
+	return line
+}
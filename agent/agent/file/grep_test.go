@@ -0,0 +1,86 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sensdata/idb/core/model"
+)
+
+// runGrep 执行搜索并等待结束
+func runGrep(t *testing.T, req model.FileGrep) *model.FileGrepResult {
+	t.Helper()
+	f := &FileService{}
+	res, err := f.Grep(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		result, err := f.GrepResult(model.FileGrepQuery{Key: res.Key})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Done {
+			return result
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("grep did not finish")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestGrepContext(t *testing.T) {
+	dir := t.TempDir()
+	content := "l1\nl2 match\nl3\nl4 match\nl5\nl6\nl7\nl8 match"
+	if err := os.WriteFile(filepath.Join(dir, "a.txt"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "b.bin"), []byte("match\x00"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result := runGrep(t, model.FileGrep{Path: dir, Pattern: "MATCH", IgnoreCase: true, Context: 2})
+	path := filepath.Join(dir, "a.txt")
+	want := []model.FileGrepMatch{
+		{Path: path, Line: 2, Content: "l2 match", Before: []string{"l1"}, After: []string{"l3", "l4 match"}},
+		{Path: path, Line: 4, Content: "l4 match", Before: []string{"l2 match", "l3"}, After: []string{"l5", "l6"}},
+		{Path: path, Line: 8, Content: "l8 match", Before: []string{"l6", "l7"}},
+	}
+	if !reflect.DeepEqual(result.Matches, want) {
+		t.Errorf("matches = %+v, want %+v", result.Matches, want)
+	}
+	if result.Scanned != 1 || result.Skipped != 1 || result.Error != "" {
+		t.Errorf("result = %+v, want 1 scanned and binary skipped", result)
+	}
+}
+
+func TestGrepLimits(t *testing.T) {
+	dir := t.TempDir()
+	// 超长行只保留前 grepMaxLineLength 字节返回
+	long := strings.Repeat("x", grepMaxLineLength*4) + "needle"
+	if err := os.WriteFile(filepath.Join(dir, "long.txt"), []byte("needle "+long+"\r\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "many.txt"), []byte(strings.Repeat("needle\n", 20)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "big.txt"), []byte(strings.Repeat("needle\n", 200)), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result := runGrep(t, model.FileGrep{Path: dir, Pattern: "needle", Includes: []string{"long.txt"}})
+	if len(result.Matches) != 1 || len(result.Matches[0].Content) != grepMaxLineLength {
+		t.Errorf("matches = %+v, want one trimmed line", result.Matches)
+	}
+
+	result = runGrep(t, model.FileGrep{Path: dir, Pattern: "needle", Includes: []string{"*.txt"}, Excludes: []string{"long.txt"}, MaxResults: 10, MaxFileSize: 1000})
+	if len(result.Matches) != 10 || !result.Truncated || result.Skipped != 1 {
+		t.Errorf("result = %d matches, truncated %v, skipped %d", len(result.Matches), result.Truncated, result.Skipped)
+	}
+}
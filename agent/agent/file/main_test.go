@@ -0,0 +1,27 @@
+package file
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/sensdata/idb/agent/global"
+	"github.com/sensdata/idb/core/log"
+)
+
+// TestMain 将日志写入临时目录
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "idb-agent-file")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	global.LOG, err = log.InitLogger(dir, "test.log")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
@@ -1,210 +1,529 @@
 package file
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
-	"math"
+	"io"
+	"io/fs"
 	"os"
-	"path"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
+	"github.com/sensdata/idb/agent/global"
 	"github.com/sensdata/idb/core/constant"
 	"github.com/sensdata/idb/core/files"
 	"github.com/sensdata/idb/core/model"
+	"github.com/sensdata/idb/core/utils"
 	"github.com/shirou/gopsutil/v4/disk"
 )
 
-type RecycleBinService struct {
+const (
+	TrashDelete = "delete"
+	TrashModify = "modify"
+
+	trashMetaFile      = "meta.json"
+	trashDataFile      = "data"
+	trashMountDir      = ".idb-trash" // 非根分区上的回收站目录，保证删除时只需重命名
+	trashCleanInterval = time.Hour
+)
+
+var ErrTrashNotFound = errors.New("trash item not found")
+
+// errTrashOversize 大于回收站容量上限，移入后会被立即清理，因此不移入
+var errTrashOversize = errors.New("larger than the trash size limit")
+
+var defaultTrashSettings = model.TrashSettings{
+	Enabled:         true,
+	MaxSize:         10 << 30,
+	MaxAge:          30,
+	MaxSnapshotSize: 10 << 20,
 }
 
-type IRecycleBinService interface {
-	Page(search model.PageInfo) (int64, []model.RecycleBinDTO, error)
-	Create(create model.RecycleBinCreate) error
-	Reduce(reduce model.RecycleBinReduce) error
-	Clear() error
+// trash 回收站，删除的文件及修改前的内容保存在所在分区的回收站目录下，每项一个目录：
+// <回收站目录>/<ID>/meta.json 保存原路径、权限、属主等信息，<回收站目录>/<ID>/data 为文件内容
+var trash = newRecycleBin()
+
+type recycleBin struct {
+	mu sync.Mutex
+}
+
+func newRecycleBin() *recycleBin {
+	r := &recycleBin{}
+	go func() {
+		r.migrateLegacy()
+		r.cleanup()
+	}()
+	return r
 }
 
-func NewIRecycleBinService() IRecycleBinService {
-	return &RecycleBinService{}
+func trashSettingsPath() string {
+	return filepath.Join(constant.AgentDataDir, "trash.json")
 }
 
-func (r RecycleBinService) Page(search model.PageInfo) (int64, []model.RecycleBinDTO, error) {
-	var (
-		result []model.RecycleBinDTO
-	)
-	partitions, err := disk.Partitions(false)
+// TrashSettings 获取回收站设置，未设置时返回默认值
+func (f *FileService) TrashSettings() (*model.TrashSettings, error) {
+	settings := defaultTrashSettings
+	data, err := os.ReadFile(trashSettingsPath())
+	if os.IsNotExist(err) {
+		return &settings, nil
+	}
 	if err != nil {
-		return 0, nil, err
+		return nil, err
 	}
-	op := files.NewFileOp()
-	for _, p := range partitions {
-		dir := path.Join(p.Mountpoint, ".", constant.ClashDir)
-		if !op.Stat(dir) {
-			continue
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, fmt.Errorf("invalid trash settings: %v", err)
+	}
+	return &settings, nil
+}
+
+// SetTrashSettings 保存回收站设置，并按新的限制清理
+func (f *FileService) SetTrashSettings(req model.TrashSettings) error {
+	if req.MaxSize < 0 || req.MaxAge < 0 || req.MaxSnapshotSize < 0 {
+		return errors.New("invalid trash settings")
+	}
+	data, err := json.MarshalIndent(req, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(constant.AgentDataDir, 0700); err != nil {
+		return err
+	}
+	if err := os.WriteFile(trashSettingsPath(), data, 0600); err != nil {
+		return err
+	}
+	trash.enforce(req)
+	return nil
+}
+
+// TrashList 按移入时间倒序列出回收站
+func (f *FileService) TrashList(req model.TrashList) (*model.PageResult, error) {
+	items := trash.items()
+	if req.Path != "" {
+		prefix := filepath.Clean(req.Path)
+		filtered := items[:0]
+		for _, item := range items {
+			if item.SourcePath == prefix || strings.HasPrefix(item.SourcePath, strings.TrimSuffix(prefix, "/")+"/") {
+				filtered = append(filtered, item)
+			}
 		}
-		clashFiles, err := os.ReadDir(dir)
-		if err != nil {
-			return 0, nil, err
-		}
-		for _, file := range clashFiles {
-			if strings.HasPrefix(file.Name(), "_idb_") {
-				recycleDTO, err := getRecycleBinDTOFromName(file.Name())
-				recycleDTO.IsDir = file.IsDir()
-				recycleDTO.From = dir
-				if err == nil {
-					result = append(result, *recycleDTO)
+		items = filtered
+	}
+
+	result := &model.PageResult{Total: int64(len(items)), Items: []model.TrashItem{}}
+	start := (req.Page - 1) * req.PageSize
+	if start < 0 || start >= len(items) {
+		return result, nil
+	}
+	result.Items = items[start:min(start+req.PageSize, len(items))]
+	return result, nil
+}
+
+// TrashRestore 恢复到原位置，缺少的上级目录会被创建
+func (f *FileService) TrashRestore(req model.TrashRestore) (*model.TrashItem, error) {
+	trash.mu.Lock()
+	defer trash.mu.Unlock()
+
+	dir, item, err := trash.find(req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Lstat(item.SourcePath); err == nil {
+		if !req.Overwrite {
+			return nil, fmt.Errorf("%s already exists", item.SourcePath)
+		}
+		// 被覆盖的内容同样移入回收站，可以再次恢复
+		if _, err := trash.put(item.SourcePath, TrashDelete, 0); err != nil {
+			return nil, err
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(item.SourcePath), 0755); err != nil {
+		return nil, err
+	}
+	if err := move(filepath.Join(dir, trashDataFile), item.SourcePath); err != nil {
+		return nil, err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		global.LOG.Error("Failed to remove trash item %s: %v", dir, err)
+	}
+	global.LOG.Info("Restored %s from trash %s", item.SourcePath, item.ID)
+	return item, nil
+}
+
+// TrashPurge 彻底删除回收站中的项
+func (f *FileService) TrashPurge(req model.TrashPurge) error {
+	trash.mu.Lock()
+	defer trash.mu.Unlock()
+
+	if req.All {
+		for _, root := range trashRoots() {
+			entries, _ := os.ReadDir(root)
+			for _, entry := range entries {
+				if err := os.RemoveAll(filepath.Join(root, entry.Name())); err != nil {
+					return err
 				}
 			}
 		}
+		return nil
 	}
-	startIndex := (search.Page - 1) * search.PageSize
-	endIndex := startIndex + search.PageSize
+	for _, id := range req.IDs {
+		dir, _, err := trash.find(id)
+		if err != nil {
+			return err
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	if startIndex > len(result) {
-		return int64(len(result)), result, nil
+// moveToTrash 回收站启用时将 path 移入回收站，返回是否已移入，操作结束后由调用方执行一次 enforceTrash；
+// 大于回收站容量上限时返回 errTrashOversize，由调用方直接删除
+func (f *FileService) moveToTrash(path string) (bool, error) {
+	settings, err := f.TrashSettings()
+	if err != nil || !settings.Enabled {
+		return false, err
 	}
-	if endIndex > len(result) {
-		endIndex = len(result)
+	trash.mu.Lock()
+	_, err = trash.put(path, TrashDelete, settings.MaxSize)
+	trash.mu.Unlock()
+	if err != nil {
+		return false, err
 	}
-	return int64(len(result)), result[startIndex:endIndex], nil
+	return true, nil
 }
 
-func (r RecycleBinService) Create(create model.RecycleBinCreate) error {
-	op := files.NewFileOp()
-	if !op.Stat(create.SourcePath) {
-		return errors.New(constant.ErrLinkPathNotFound)
+// snapshot 回收站启用时保存文件修改或覆盖前的内容，目录、不存在的文件及超过快照大小上限的文件不保存，
+// 操作结束后由调用方执行一次 enforceTrash
+func (f *FileService) snapshot(path string) error {
+	settings, err := f.TrashSettings()
+	if err != nil || !settings.Enabled || settings.MaxSnapshotSize == 0 {
+		return err
+	}
+	info, err := os.Lstat(path)
+	if err != nil || !info.Mode().IsRegular() || info.Size() > settings.MaxSnapshotSize {
+		return nil
 	}
-	clashDir, err := getClashDir(create.SourcePath)
+	trash.mu.Lock()
+	_, err = trash.put(path, TrashModify, 0)
+	trash.mu.Unlock()
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to snapshot %s: %v", path, err)
 	}
-	paths := strings.Split(create.SourcePath, "/")
-	rNamePre := strings.Join(paths, "_idb_")
-	deleteTime := time.Now()
-	openFile, err := op.OpenFile(create.SourcePath)
+	return nil
+}
+
+// enforceTrash 按设置清理回收站，在一次删除、修改操作的所有项移入后调用
+func (f *FileService) enforceTrash() {
+	settings, err := f.TrashSettings()
 	if err != nil {
-		return err
+		global.LOG.Error("Failed to load trash settings: %v", err)
+		return
+	}
+	if settings.Enabled {
+		trash.enforce(*settings)
+	}
+}
+
+// put 将 path 移入（delete）或复制到（modify）回收站，maxSize 大于 0 时大于该值的不移入，调用方持有 r.mu
+func (r *recycleBin) put(path string, kind string, maxSize int64) (*model.TrashItem, error) {
+	path = filepath.Clean(path)
+	if !filepath.IsAbs(path) || path == "/" {
+		return nil, fmt.Errorf("invalid path %q", path)
+	}
+	root := trashRoot(path)
+	if path == root || strings.HasPrefix(path, root+"/") || strings.HasPrefix(root, path+"/") {
+		return nil, fmt.Errorf("%s contains or is inside the trash", path)
 	}
-	fileInfo, err := openFile.Stat()
+	info, err := os.Lstat(path)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	size := 0
-	if fileInfo.IsDir() {
-		sizeF, err := op.GetDirSize(create.SourcePath)
+
+	item := newTrashItem(path, kind, info, time.Now())
+	if info.IsDir() {
+		size, err := files.NewFileOp().GetDirSize(path)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		size = int(sizeF)
-	} else {
-		size = int(fileInfo.Size())
+		item.Size = int64(size)
 	}
-
-	rName := fmt.Sprintf("_idb_%s%s_p_%d_%d", "file", rNamePre, size, deleteTime.Unix())
-	return op.Mv(create.SourcePath, path.Join(clashDir, rName))
+	if maxSize > 0 && item.Size > maxSize {
+		return nil, errTrashOversize
+	}
+	if err := r.store(root, item, path, info); err != nil {
+		return nil, err
+	}
+	global.LOG.Info("Moved %s to trash %s (%s)", path, item.ID, kind)
+	return item, nil
 }
 
-func (r RecycleBinService) Reduce(reduce model.RecycleBinReduce) error {
-	filePath := path.Join(reduce.From, reduce.RName)
-	op := files.NewFileOp()
-	if !op.Stat(filePath) {
-		return errors.New(constant.ErrLinkPathNotFound)
+// store 在 root 下写入 item 的 meta.json，并将 src 移入（delete）或复制到（modify）该项，调用方持有 r.mu
+func (r *recycleBin) store(root string, item *model.TrashItem, src string, info fs.FileInfo) error {
+	dir := filepath.Join(root, item.ID)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	meta, err := json.Marshal(item)
+	if err == nil {
+		err = os.WriteFile(filepath.Join(dir, trashMetaFile), meta, 0600)
+	}
+	if err == nil {
+		if item.Kind == TrashModify {
+			err = copyFile(src, filepath.Join(dir, trashDataFile), info, item.Uid, item.Gid)
+		} else {
+			err = move(src, filepath.Join(dir, trashDataFile))
+		}
 	}
-	recycleBinDTO, err := getRecycleBinDTOFromName(reduce.RName)
 	if err != nil {
-		return err
+		os.RemoveAll(dir)
 	}
-	if !op.Stat(path.Dir(recycleBinDTO.SourcePath)) {
-		return errors.New("ErrSourcePathNotFound")
+	return err
+}
+
+func newTrashItem(path string, kind string, info fs.FileInfo, trashedAt time.Time) *model.TrashItem {
+	item := &model.TrashItem{
+		ID:         fmt.Sprintf("%s-%s", trashedAt.Format("20060102150405"), utils.GenerateUuid()[:8]),
+		Kind:       kind,
+		SourcePath: path,
+		Name:       filepath.Base(path),
+		IsDir:      info.IsDir(),
+		Size:       info.Size(),
+		Mode:       info.Mode(),
+		ModTime:    info.ModTime(),
+		TrashedAt:  trashedAt,
 	}
-	if op.Stat(recycleBinDTO.SourcePath) {
-		if err = op.RmRf(recycleBinDTO.SourcePath); err != nil {
-			return err
+	if st, ok := info.Sys().(*syscall.Stat_t); ok {
+		item.Uid, item.Gid = int(st.Uid), int(st.Gid)
+	}
+	return item
+}
+
+// find 在各回收站目录中查找 id，返回项的目录
+func (r *recycleBin) find(id string) (string, *model.TrashItem, error) {
+	if !uploadIDPattern.MatchString(id) {
+		return "", nil, fmt.Errorf("invalid trash id %q", id)
+	}
+	for _, root := range trashRoots() {
+		dir := filepath.Join(root, id)
+		if item, err := readTrashMeta(dir); err == nil {
+			return dir, item, nil
 		}
 	}
-	return op.Mv(filePath, recycleBinDTO.SourcePath)
+	return "", nil, ErrTrashNotFound
 }
 
-func (r RecycleBinService) Clear() error {
-	partitions, err := disk.Partitions(false)
-	if err != nil {
-		return err
+type trashEntry struct {
+	dir  string
+	item model.TrashItem
+}
+
+// items 按移入时间倒序返回所有项
+func (r *recycleBin) items() []model.TrashItem {
+	entries := r.entries()
+	items := make([]model.TrashItem, 0, len(entries))
+	for _, entry := range entries {
+		items = append(items, entry.item)
 	}
-	op := files.NewFileOp()
-	for _, p := range partitions {
-		dir := path.Join(p.Mountpoint, ".", constant.ClashDir)
-		if !op.Stat(dir) {
+	return items
+}
+
+// entries 按移入时间倒序返回所有项及其目录
+func (r *recycleBin) entries() []trashEntry {
+	var result []trashEntry
+	for _, root := range trashRoots() {
+		dirs, err := os.ReadDir(root)
+		if err != nil {
 			continue
 		}
-		newDir := path.Join(p.Mountpoint, constant.ClashDir)
-		if err := op.Mv(dir, newDir); err != nil {
-			return err
+		for _, d := range dirs {
+			dir := filepath.Join(root, d.Name())
+			if item, err := readTrashMeta(dir); err == nil {
+				result = append(result, trashEntry{dir: dir, item: *item})
+			}
 		}
-		go func() {
-			_ = op.DeleteDir(newDir)
-		}()
 	}
-	return nil
+	sort.Slice(result, func(i, j int) bool { return result[i].item.TrashedAt.After(result[j].item.TrashedAt) })
+	return result
 }
 
-func getClashDir(realPath string) (string, error) {
-	partitions, err := disk.Partitions(false)
-	if err != nil {
-		return "", err
+// enforce 删除超过保留天数的项，总大小超过上限时从最早的项开始删除
+func (r *recycleBin) enforce(settings model.TrashSettings) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var total int64
+	for _, entry := range r.entries() {
+		item := entry.item
+		total += item.Size
+		expired := settings.MaxAge > 0 && time.Since(item.TrashedAt) > time.Duration(settings.MaxAge)*24*time.Hour
+		oversize := settings.MaxSize > 0 && total > settings.MaxSize
+		if !expired && !oversize {
+			continue
+		}
+		global.LOG.Info("Purge trash %s: %s", item.ID, item.SourcePath)
+		if err := os.RemoveAll(entry.dir); err != nil {
+			global.LOG.Error("Failed to purge trash %s: %v", entry.dir, err)
+		}
+		total -= item.Size
 	}
-	for _, p := range partitions {
-		if p.Mountpoint == "/" {
+}
+
+// legacyTrashName 旧版回收站的文件名，_idb_file 后为以 _idb_ 替换 / 的原路径，_p_ 后为大小及删除时间
+var legacyTrashName = regexp.MustCompile(`^_idb_file_idb_(.+)_p_(\d+)_(\d+)$`)
+
+// migrateLegacy 将旧版回收站（各分区下的 idb_clash 目录）中的项导入当前回收站，保留原路径及删除时间
+func (r *recycleBin) migrateLegacy() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, dir := range legacyTrashDirs() {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
 			continue
 		}
-		if strings.HasPrefix(realPath, p.Mountpoint) {
-			clashDir := path.Join(p.Mountpoint, ".", constant.ClashDir)
-			if err = createClashDir(path.Join(p.Mountpoint, ".", constant.ClashDir)); err != nil {
-				return "", err
+		for _, entry := range entries {
+			matches := legacyTrashName.FindStringSubmatch(entry.Name())
+			if matches == nil {
+				continue
+			}
+			src := filepath.Join(dir, entry.Name())
+			info, err := os.Lstat(src)
+			if err != nil {
+				continue
+			}
+			sourcePath := "/" + strings.ReplaceAll(matches[1], "_idb_", "/")
+			deletedAt, _ := strconv.ParseInt(matches[3], 10, 64)
+			item := newTrashItem(sourcePath, TrashDelete, info, time.Unix(deletedAt, 0))
+			if size, err := strconv.ParseInt(matches[2], 10, 64); err == nil {
+				item.Size = size
+			}
+			if err := r.store(trashRoot(src), item, src, info); err != nil {
+				global.LOG.Error("Failed to migrate legacy trash %s: %v", src, err)
+				continue
 			}
-			return clashDir, nil
+			global.LOG.Info("Migrated legacy trash %s to %s", src, item.ID)
 		}
+		// 仅在已全部导入时删除旧目录
+		os.Remove(dir)
 	}
-	return constant.ClashDir, createClashDir(constant.ClashDir)
 }
 
-func createClashDir(clashDir string) error {
-	op := files.NewFileOp()
-	if !op.Stat(clashDir) {
-		if err := op.CreateDir(clashDir, 0755); err != nil {
-			return err
+// legacyTrashDirs 旧版回收站目录，位于各分区挂载点下，根分区的位于 agent 工作目录下
+func legacyTrashDirs() []string {
+	var dirs []string
+	seen := map[string]bool{}
+	add := func(dir string) {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() && !seen[dir] {
+			seen[dir] = true
+			dirs = append(dirs, dir)
 		}
 	}
-	return nil
+	if partitions, err := disk.Partitions(false); err == nil {
+		for _, p := range partitions {
+			add(filepath.Join(p.Mountpoint, constant.ClashDir))
+		}
+	}
+	if dir, err := filepath.Abs(constant.ClashDir); err == nil {
+		add(dir)
+	}
+	return dirs
 }
 
-func getRecycleBinDTOFromName(filename string) (*model.RecycleBinDTO, error) {
-	r := regexp.MustCompile(`_idb_file_idb_(.+)_p_(\d+)_(\d+)`)
-	matches := r.FindStringSubmatch(filename)
-	if len(matches) != 4 {
-		return nil, fmt.Errorf("invalid filename format")
+func (r *recycleBin) cleanup() {
+	ticker := time.NewTicker(trashCleanInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		settings, err := (&FileService{}).TrashSettings()
+		if err != nil {
+			global.LOG.Error("Failed to load trash settings: %v", err)
+			continue
+		}
+		r.enforce(*settings)
 	}
-	sourcePath := "/" + strings.ReplaceAll(matches[1], "_idb_", "/")
-	size, err := strconv.ParseInt(matches[2], 10, 64)
+}
+
+func readTrashMeta(dir string) (*model.TrashItem, error) {
+	data, err := os.ReadFile(filepath.Join(dir, trashMetaFile))
 	if err != nil {
 		return nil, err
 	}
-	if size < math.MinInt || size > math.MaxInt {
-		return nil, fmt.Errorf("size out of int range")
+	var item model.TrashItem
+	if err := json.Unmarshal(data, &item); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// trashRoot 返回 path 所在分区的回收站目录，根分区使用 agent 数据目录
+func trashRoot(path string) string {
+	mount := "/"
+	if partitions, err := disk.Partitions(false); err == nil {
+		for _, p := range partitions {
+			if p.Mountpoint != "/" && len(p.Mountpoint) > len(mount) &&
+				(path == p.Mountpoint || strings.HasPrefix(path, p.Mountpoint+"/")) {
+				mount = p.Mountpoint
+			}
+		}
+	}
+	if mount == "/" {
+		return filepath.Join(constant.AgentDataDir, "trash")
+	}
+	return filepath.Join(mount, trashMountDir)
+}
+
+// trashRoots 返回所有已存在的回收站目录
+func trashRoots() []string {
+	roots := []string{filepath.Join(constant.AgentDataDir, "trash")}
+	if partitions, err := disk.Partitions(false); err == nil {
+		for _, p := range partitions {
+			if p.Mountpoint == "/" {
+				continue
+			}
+			root := filepath.Join(p.Mountpoint, trashMountDir)
+			if info, err := os.Stat(root); err == nil && info.IsDir() {
+				roots = append(roots, root)
+			}
+		}
 	}
+	return roots
+}
+
+// move 重命名，跨分区时使用 mv 复制
+func move(src string, dst string) error {
+	err := os.Rename(src, dst)
+	if errors.Is(err, syscall.EXDEV) {
+		return files.NewFileOp().Mv(src, dst)
+	}
+	return err
+}
 
-	deleteTime, err := strconv.ParseInt(matches[3], 10, 64)
+// copyFile 复制文件内容，保留权限、属主及修改时间
+func copyFile(src string, dst string, info fs.FileInfo, uid int, gid int) error {
+	in, err := os.Open(src)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
 	}
-	return &model.RecycleBinDTO{
-		Name:       path.Base(sourcePath),
-		Size:       int(size),
-		Type:       "file",
-		DeleteTime: time.Unix(deleteTime, 0),
-		SourcePath: sourcePath,
-		RName:      filename,
-	}, nil
+	os.Chown(dst, uid, gid)
+	os.Chmod(dst, info.Mode())
+	return os.Chtimes(dst, info.ModTime(), info.ModTime())
 }
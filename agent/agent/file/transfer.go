@@ -0,0 +1,327 @@
+package file
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/sensdata/idb/agent/global"
+	"github.com/sensdata/idb/core/constant"
+	"github.com/sensdata/idb/core/files"
+	"github.com/sensdata/idb/core/model"
+)
+
+const (
+	TransferRunning = "running"
+	TransferDone    = "done"
+	TransferFailed  = "failed"
+
+	transferExpire = time.Hour // 结束的打包、解包任务保留一段时间，供查询结果
+)
+
+var ErrTransferNotFound = errors.New("transfer job not found")
+
+// transfers 打包及解包任务，tar 及哈希计算耗时较长，在后台执行，由 center 查询进度
+var transfers = &transferManager{jobs: map[string]*transferJob{}}
+
+type transferManager struct {
+	mu   sync.Mutex
+	jobs map[string]*transferJob
+}
+
+type transferJob struct {
+	id       string
+	mu       sync.Mutex
+	status   model.FileTransferStatus
+	cancel   context.CancelFunc
+	finished time.Time
+}
+
+// transferDir 跨设备传输时打包及接收的临时目录
+func transferDir() string {
+	return filepath.Join(constant.AgentDataDir, "transfer")
+}
+
+// TransferPack 在后台将文件或目录打包为 tar，保留权限及属主，完成后可查询包的大小及 SHA-256
+func (f *FileService) TransferPack(req model.FileTransferPack) error {
+	if !uploadIDPattern.MatchString(req.ID) {
+		return fmt.Errorf("invalid transfer id %q", req.ID)
+	}
+	src := filepath.Clean(req.Source)
+	if !filepath.IsAbs(src) || src == "/" {
+		return fmt.Errorf("invalid source %q", req.Source)
+	}
+	if _, err := os.Lstat(src); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(transferDir(), 0700); err != nil {
+		return err
+	}
+
+	return transfers.start("pack", req.ID, func(ctx context.Context, status *model.FileTransferStatus) error {
+		archive, err := pack(ctx, req.ID, src)
+		status.Archive = archive
+		return err
+	})
+}
+
+// TransferUnpack 在后台将接收到的包解到 Dest 下，先解到同一目录下的临时目录，处理冲突后再重命名
+func (f *FileService) TransferUnpack(req model.FileTransferUnpack) error {
+	if !uploadIDPattern.MatchString(req.ID) {
+		return fmt.Errorf("invalid transfer id %q", req.ID)
+	}
+	archive := filepath.Clean(req.Archive)
+	if filepath.Dir(archive) != transferDir() {
+		return fmt.Errorf("invalid archive %q", req.Archive)
+	}
+	dest := filepath.Clean(req.Dest)
+	info, err := os.Stat(dest)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", dest)
+	}
+
+	return transfers.start("unpack", req.ID, func(ctx context.Context, status *model.FileTransferStatus) error {
+		unpacked, err := unpack(ctx, archive, dest, req.Conflict)
+		status.Unpacked = unpacked
+		return err
+	})
+}
+
+// TransferStatus 查询打包或解包的进度及结果
+func (f *FileService) TransferStatus(req model.FileTransferQuery) (*model.FileTransferStatus, error) {
+	transfers.mu.Lock()
+	job, ok := transfers.jobs[req.Op+":"+req.ID]
+	transfers.mu.Unlock()
+	if !ok {
+		return nil, ErrTransferNotFound
+	}
+	job.mu.Lock()
+	defer job.mu.Unlock()
+	status := job.status
+	return &status, nil
+}
+
+// TransferClean 终止该传输进行中的打包、解包，删除传输的临时文件，包括未完成上传的临时文件
+func (f *FileService) TransferClean(req model.FileTransferClean) error {
+	if !uploadIDPattern.MatchString(req.ID) {
+		return fmt.Errorf("invalid transfer id %q", req.ID)
+	}
+	transfers.stop(req.ID)
+	for _, pattern := range []string{req.ID + "*", "." + req.ID + "*"} {
+		matches, _ := filepath.Glob(filepath.Join(transferDir(), pattern))
+		for _, match := range matches {
+			if err := os.Remove(match); err != nil && !os.IsNotExist(err) {
+				global.LOG.Error("Failed to remove %s: %v", match, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (m *transferManager) start(op string, id string, run func(ctx context.Context, status *model.FileTransferStatus) error) error {
+	key := op + ":" + id
+	m.mu.Lock()
+	for k, job := range m.jobs {
+		job.mu.Lock()
+		expired := !job.finished.IsZero() && time.Since(job.finished) > transferExpire
+		job.mu.Unlock()
+		if expired {
+			delete(m.jobs, k)
+		}
+	}
+	if job, ok := m.jobs[key]; ok {
+		job.mu.Lock()
+		running := job.status.State == TransferRunning
+		job.mu.Unlock()
+		if running {
+			m.mu.Unlock()
+			return fmt.Errorf("%s %s is running", op, id)
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &transferJob{id: id, status: model.FileTransferStatus{State: TransferRunning}, cancel: cancel}
+	m.jobs[key] = job
+	m.mu.Unlock()
+
+	go func() {
+		defer cancel()
+		var status model.FileTransferStatus
+		err := run(ctx, &status)
+		job.mu.Lock()
+		defer job.mu.Unlock()
+		job.finished = time.Now()
+		if err != nil {
+			global.LOG.Error("Transfer %s %s failed: %v", op, id, err)
+			job.status = model.FileTransferStatus{State: TransferFailed, Error: err.Error()}
+			return
+		}
+		status.State = TransferDone
+		job.status = status
+	}()
+	return nil
+}
+
+// stop 终止 id 的所有任务，传输 ID 以 center 的任务 ID 为前缀，因此按前缀匹配
+func (m *transferManager) stop(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, job := range m.jobs {
+		if strings.HasPrefix(job.id, id) {
+			job.cancel()
+			delete(m.jobs, key)
+		}
+	}
+}
+
+func pack(ctx context.Context, id string, src string) (*model.FileTransferArchive, error) {
+	// tar 包大小与源文件相近，打包前检查临时目录的剩余空间
+	size, err := pathSize(src)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkFreeSpace(transferDir(), size); err != nil {
+		return nil, err
+	}
+
+	archive := filepath.Join(transferDir(), id+".tar")
+	// -- 之后的参数不会被解析为选项，避免以 - 开头的文件名被当作 tar 的参数
+	cmd := exec.CommandContext(ctx, "tar", "-cf", archive, "-C", filepath.Dir(src), "--", filepath.Base(src))
+	if output, err := cmd.CombinedOutput(); err != nil {
+		os.Remove(archive)
+		return nil, fmt.Errorf("failed to pack %s: %v %s", src, err, strings.TrimSpace(string(output)))
+	}
+
+	file, err := os.Open(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	hash := sha256.New()
+	written, err := io.Copy(hash, &contextReader{ctx: ctx, r: file})
+	if err != nil {
+		return nil, err
+	}
+	global.LOG.Info("Packed %s to %s, %d bytes", src, archive, written)
+	return &model.FileTransferArchive{
+		Path: archive,
+		Size: written,
+		Hash: hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
+
+func unpack(ctx context.Context, archive string, dest string, conflict string) (*model.FileTransferUnpacked, error) {
+	info, err := os.Stat(archive)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkFreeSpace(dest, info.Size()); err != nil {
+		return nil, err
+	}
+
+	staging := filepath.Join(dest, fmt.Sprintf(".idb-transfer-%d", time.Now().UnixNano()))
+	if err := os.Mkdir(staging, 0700); err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(staging)
+
+	cmd := exec.CommandContext(ctx, "tar", "-xpf", archive, "--same-owner", "-C", staging)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("failed to unpack %s: %v %s", archive, err, strings.TrimSpace(string(output)))
+	}
+	entries, err := os.ReadDir(staging)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) != 1 {
+		return nil, fmt.Errorf("unexpected archive content in %s", archive)
+	}
+
+	name := entries[0].Name()
+	target := filepath.Join(dest, name)
+	if _, err := os.Lstat(target); err == nil {
+		switch conflict {
+		case "skip":
+			os.Remove(archive)
+			return &model.FileTransferUnpacked{Path: target, Skipped: true}, nil
+		case "overwrite":
+			if err := os.RemoveAll(target); err != nil {
+				return nil, err
+			}
+		case "rename":
+			target = availableName(dest, name)
+		default:
+			return nil, fmt.Errorf("%s already exists", target)
+		}
+	}
+	if err := os.Rename(filepath.Join(staging, name), target); err != nil {
+		return nil, err
+	}
+	os.Remove(archive)
+	return &model.FileTransferUnpacked{Path: target}, nil
+}
+
+// pathSize 返回文件或目录的大小
+func pathSize(path string) (int64, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return 0, err
+	}
+	if !info.IsDir() {
+		return info.Size(), nil
+	}
+	size, err := files.NewFileOp().GetDirSize(path)
+	return int64(size), err
+}
+
+// checkFreeSpace 检查 dir 所在分区的剩余空间是否足够写入 size 字节
+func checkFreeSpace(dir string, size int64) error {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return err
+	}
+	if free := int64(stat.Bavail) * int64(stat.Bsize); free < size {
+		return fmt.Errorf("not enough space in %s: %d bytes needed, %d bytes free", dir, size, free)
+	}
+	return nil
+}
+
+// contextReader 在 ctx 取消后停止读取
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
+
+// availableName 返回 dir 下不存在的名称，如 a_1.txt、a_2.txt
+func availableName(dir string, name string) string {
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	if base == "" {
+		base, ext = name, ""
+	}
+	for i := 1; ; i++ {
+		target := filepath.Join(dir, fmt.Sprintf("%s_%d%s", base, i, ext))
+		if _, err := os.Lstat(target); os.IsNotExist(err) {
+			return target
+		}
+	}
+}
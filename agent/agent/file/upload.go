@@ -0,0 +1,292 @@
+package file
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/sensdata/idb/agent/global"
+	"github.com/sensdata/idb/core/message"
+)
+
+const (
+	uploadCleanInterval = 10 * time.Minute
+	uploadDoneExpire    = 10 * time.Minute // 已完成的会话保留一段时间，用于应答重发的块
+	uploadPartExpire    = 24 * time.Hour   // 未完成的会话空闲超过该时间后删除临时文件
+	maxPendingChunks    = 64               // 乱序到达、等待写入的最大块数
+)
+
+var (
+	ErrUploadNotFound     = errors.New("upload session not found")
+	ErrChunkChecksum      = errors.New("chunk checksum mismatch")
+	ErrUploadFileChecksum = errors.New("file checksum mismatch")
+	ErrUploadTooManyChunk = errors.New("too many pending chunks")
+
+	uploadIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+)
+
+// UploadManager 管理可续传的上传会话
+//
+// 数据按偏移量顺序写入 <目录>/.<文件名>.<会话ID>.part，乱序到达的块暂存在内存中，
+// 因此临时文件的大小即为已连续接收的字节数，agent 重启后也可以据此续传。
+// 全部接收后校验整个文件的 SHA-256，通过后重命名为目标文件。
+type UploadManager struct {
+	mu       sync.Mutex
+	sessions map[string]*uploadSession
+}
+
+type uploadSession struct {
+	mu         sync.Mutex
+	dst        string
+	part       string
+	total      int64
+	fileHash   string
+	file       *os.File
+	hash       hash.Hash
+	received   int64
+	pending    map[int64][]byte
+	done       bool
+	lastActive time.Time
+}
+
+// UploadState 会话的接收进度
+type UploadState struct {
+	Received int64
+	Done     bool
+}
+
+func NewUploadManager() *UploadManager {
+	m := &UploadManager{sessions: map[string]*uploadSession{}}
+	go m.cleanup()
+	return m
+}
+
+// Open 创建或恢复上传会话，返回已接收的字节数
+func (m *UploadManager) Open(id string, dir string, name string, total int64, fileHash string) (*UploadState, error) {
+	if !uploadIDPattern.MatchString(id) {
+		return nil, fmt.Errorf("invalid upload id %q", id)
+	}
+	if name == "" || name != filepath.Base(name) {
+		return nil, fmt.Errorf("invalid file name %q", name)
+	}
+	if total < 0 {
+		return nil, fmt.Errorf("invalid total size %d", total)
+	}
+	dst := filepath.Join(dir, name)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if s, ok := m.sessions[id]; ok {
+		if s.dst != dst || s.total != total || s.fileHash != fileHash {
+			return nil, fmt.Errorf("upload id %s is used by another file", id)
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.lastActive = time.Now()
+		return s.state(), nil
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory %s: %v", dir, err)
+	}
+	s := &uploadSession{
+		dst:        dst,
+		part:       filepath.Join(dir, fmt.Sprintf(".%s.%s.part", name, id)),
+		total:      total,
+		fileHash:   fileHash,
+		hash:       sha256.New(),
+		pending:    map[int64][]byte{},
+		lastActive: time.Now(),
+	}
+	if err := s.resume(); err != nil {
+		return nil, err
+	}
+	if s.received == s.total {
+		if err := s.finish(); err != nil {
+			return nil, err
+		}
+	}
+	m.sessions[id] = s
+	return s.state(), nil
+}
+
+// Write 写入一个文件块，chunkHash 不为空时校验块的 SHA-256
+func (m *UploadManager) Write(id string, offset int64, chunk []byte, chunkHash string) (*UploadState, error) {
+	m.mu.Lock()
+	s, ok := m.sessions[id]
+	m.mu.Unlock()
+	if !ok {
+		return nil, ErrUploadNotFound
+	}
+	if chunkHash != "" && message.FileChecksum(chunk) != chunkHash {
+		return nil, ErrChunkChecksum
+	}
+
+	state, closed, err := s.write(offset, chunk)
+	if closed {
+		// 释放 s.mu 后再移除会话，与 Open、cleanup 保持先 m.mu 后 s.mu 的加锁顺序
+		m.remove(id, s)
+	}
+	return state, err
+}
+
+func (m *UploadManager) remove(id string, s *uploadSession) {
+	m.mu.Lock()
+	if m.sessions[id] == s {
+		delete(m.sessions, id)
+	}
+	m.mu.Unlock()
+}
+
+func (m *UploadManager) cleanup() {
+	ticker := time.NewTicker(uploadCleanInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		m.mu.Lock()
+		for id, s := range m.sessions {
+			s.mu.Lock()
+			idle := time.Since(s.lastActive)
+			if s.done && idle > uploadDoneExpire {
+				delete(m.sessions, id)
+			} else if !s.done && idle > uploadPartExpire {
+				global.LOG.Info("Remove expired upload %s: %s", id, s.part)
+				s.close()
+				os.Remove(s.part)
+				delete(m.sessions, id)
+			}
+			s.mu.Unlock()
+		}
+		m.mu.Unlock()
+	}
+}
+
+// write 写入或暂存文件块，closed 为 true 时会话因校验失败等原因已关闭，需要移除
+func (s *uploadSession) write(offset int64, chunk []byte) (*UploadState, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastActive = time.Now()
+	if s.done || offset < s.received {
+		// 重发的块，已写入
+		return s.state(), false, nil
+	}
+	if offset+int64(len(chunk)) > s.total {
+		return nil, false, fmt.Errorf("chunk at %d exceeds total size %d", offset, s.total)
+	}
+	if offset > s.received {
+		if len(s.pending) >= maxPendingChunks {
+			return nil, false, ErrUploadTooManyChunk
+		}
+		s.pending[offset] = chunk
+		return s.state(), false, nil
+	}
+
+	if err := s.append(chunk); err != nil {
+		return nil, false, err
+	}
+	for {
+		next, ok := s.pending[s.received]
+		if !ok {
+			break
+		}
+		delete(s.pending, s.received)
+		if err := s.append(next); err != nil {
+			return nil, false, err
+		}
+	}
+	if s.received == s.total {
+		if err := s.finish(); err != nil {
+			return nil, true, err
+		}
+	}
+	return s.state(), false, nil
+}
+
+// resume 打开临时文件，已有内容视为已接收并计入哈希
+func (s *uploadSession) resume() error {
+	file, err := os.OpenFile(s.part, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open file %s: %v", s.part, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	size := info.Size()
+	if size > s.total {
+		if err := file.Truncate(0); err != nil {
+			file.Close()
+			return err
+		}
+		size = 0
+	}
+	if _, err := io.Copy(s.hash, io.LimitReader(file, size)); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to read file %s: %v", s.part, err)
+	}
+	if _, err := file.Seek(size, io.SeekStart); err != nil {
+		file.Close()
+		return err
+	}
+	if size > 0 {
+		global.LOG.Info("Resume upload %s from %d", s.part, size)
+	}
+	s.file = file
+	s.received = size
+	return nil
+}
+
+func (s *uploadSession) append(chunk []byte) error {
+	if s.file == nil {
+		// 会话已因校验失败等原因关闭
+		return ErrUploadNotFound
+	}
+	if _, err := s.file.Write(chunk); err != nil {
+		// 丢弃写入了一部分的数据，保证文件大小与已接收的字节数一致
+		s.file.Truncate(s.received)
+		s.file.Seek(s.received, io.SeekStart)
+		return fmt.Errorf("failed to write file %s: %v", s.part, err)
+	}
+	s.hash.Write(chunk)
+	s.received += int64(len(chunk))
+	return nil
+}
+
+// finish 校验整个文件后重命名为目标文件，校验失败时删除临时文件
+func (s *uploadSession) finish() error {
+	err := s.file.Sync()
+	s.close()
+	if err != nil {
+		return fmt.Errorf("failed to sync file %s: %v", s.part, err)
+	}
+	if s.fileHash != "" && hex.EncodeToString(s.hash.Sum(nil)) != s.fileHash {
+		os.Remove(s.part)
+		return ErrUploadFileChecksum
+	}
+	if err := os.Rename(s.part, s.dst); err != nil {
+		return fmt.Errorf("failed to rename %s to %s: %v", s.part, s.dst, err)
+	}
+	s.done = true
+	s.pending = nil
+	return nil
+}
+
+func (s *uploadSession) close() {
+	if s.file != nil {
+		s.file.Close()
+		s.file = nil
+	}
+}
+
+func (s *uploadSession) state() *UploadState {
+	return &UploadState{Received: s.received, Done: s.done}
+}
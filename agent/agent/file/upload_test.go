@@ -0,0 +1,165 @@
+package file
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sensdata/idb/core/message"
+)
+
+func uploadContent() ([]byte, string) {
+	content := []byte(strings.Repeat("0123456789abcdef", 64))
+	sum := sha256.Sum256(content)
+	return content, hex.EncodeToString(sum[:])
+}
+
+func TestUploadOutOfOrder(t *testing.T) {
+	content, fileHash := uploadContent()
+	dir := t.TempDir()
+	m := NewUploadManager()
+	if _, err := m.Open("u1", dir, "a.bin", int64(len(content)), fileHash); err != nil {
+		t.Fatal(err)
+	}
+
+	// 按 3、1、0、2 的顺序发送 4 个块，其中块 0 重发一次
+	const size = 256
+	for _, i := range []int{3, 1, 0, 0, 2} {
+		chunk := content[i*size : (i+1)*size]
+		state, err := m.Write("u1", int64(i*size), chunk, message.FileChecksum(chunk))
+		if err != nil {
+			t.Fatalf("write chunk %d: %v", i, err)
+		}
+		if i == 3 && state.Received != 0 {
+			t.Errorf("received = %d after out-of-order chunk, want 0", state.Received)
+		}
+		if i == 0 && state.Received != 2*size {
+			t.Errorf("received = %d after chunk 0, want %d", state.Received, 2*size)
+		}
+		if i == 2 && (!state.Done || state.Received != int64(len(content))) {
+			t.Errorf("state = %+v after last chunk, want done", state)
+		}
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "a.bin"))
+	if err != nil || string(got) != string(content) {
+		t.Fatalf("content mismatch, err = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, ".a.bin.u1.part")); !os.IsNotExist(err) {
+		t.Errorf("part file left: %v", err)
+	}
+	// 完成后重新连接，返回已完成
+	state, err := m.Open("u1", dir, "a.bin", int64(len(content)), fileHash)
+	if err != nil || !state.Done {
+		t.Errorf("reopen = %+v, %v", state, err)
+	}
+}
+
+func TestUploadChunkErrors(t *testing.T) {
+	content, fileHash := uploadContent()
+	dir := t.TempDir()
+	m := NewUploadManager()
+	if _, err := m.Open("u1", dir, "a.bin", int64(len(content)), fileHash); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := m.Write("missing", 0, content, ""); !errors.Is(err, ErrUploadNotFound) {
+		t.Errorf("unknown session err = %v", err)
+	}
+	if _, err := m.Write("u1", 0, content[:10], message.FileChecksum(content[10:20])); !errors.Is(err, ErrChunkChecksum) {
+		t.Errorf("bad chunk err = %v", err)
+	}
+	if _, err := m.Write("u1", int64(len(content)-5), content[:10], ""); err == nil {
+		t.Error("expected error for chunk beyond total size")
+	}
+	for i := 1; i <= maxPendingChunks; i++ {
+		if _, err := m.Write("u1", int64(i), content[:1], ""); err != nil {
+			t.Fatalf("pending chunk %d: %v", i, err)
+		}
+	}
+	if _, err := m.Write("u1", int64(maxPendingChunks+1), content[:1], ""); !errors.Is(err, ErrUploadTooManyChunk) {
+		t.Errorf("too many pending err = %v", err)
+	}
+	if _, err := m.Open("u1", dir, "b.bin", int64(len(content)), fileHash); err == nil {
+		t.Error("expected error when reusing upload id for another file")
+	}
+	if _, err := m.Open("../u1", dir, "a.bin", 1, ""); err == nil {
+		t.Error("expected error for invalid upload id")
+	}
+}
+
+func TestUploadResume(t *testing.T) {
+	content, fileHash := uploadContent()
+	dir := t.TempDir()
+	half := len(content) / 2
+	// agent 重启前已接收前一半
+	if err := os.WriteFile(filepath.Join(dir, ".a.bin.u1.part"), content[:half], 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewUploadManager()
+	state, err := m.Open("u1", dir, "a.bin", int64(len(content)), fileHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.Received != int64(half) || state.Done {
+		t.Fatalf("state = %+v, want resumed from %d", state, half)
+	}
+	state, err = m.Write("u1", int64(half), content[half:], "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !state.Done {
+		t.Fatalf("state = %+v, want done", state)
+	}
+	got, err := os.ReadFile(filepath.Join(dir, "a.bin"))
+	if err != nil || string(got) != string(content) {
+		t.Fatalf("content mismatch, err = %v", err)
+	}
+}
+
+func TestUploadResumeComplete(t *testing.T) {
+	content, fileHash := uploadContent()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ".a.bin.u1.part"), content, 0644); err != nil {
+		t.Fatal(err)
+	}
+	state, err := NewUploadManager().Open("u1", dir, "a.bin", int64(len(content)), fileHash)
+	if err != nil || !state.Done {
+		t.Fatalf("state = %+v, %v, want done", state, err)
+	}
+	if got, _ := os.ReadFile(filepath.Join(dir, "a.bin")); string(got) != string(content) {
+		t.Error("content mismatch")
+	}
+}
+
+func TestUploadFileChecksum(t *testing.T) {
+	content, _ := uploadContent()
+	dir := t.TempDir()
+	m := NewUploadManager()
+	badHash := strings.Repeat("0", 64)
+	if _, err := m.Open("u1", dir, "a.bin", int64(len(content)), badHash); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Write("u1", 0, content, ""); !errors.Is(err, ErrUploadFileChecksum) {
+		t.Fatalf("err = %v, want %v", err, ErrUploadFileChecksum)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "a.bin")); !os.IsNotExist(err) {
+		t.Error("destination created despite checksum mismatch")
+	}
+	if _, err := os.Stat(filepath.Join(dir, ".a.bin.u1.part")); !os.IsNotExist(err) {
+		t.Error("part file left after checksum mismatch")
+	}
+	// 会话已移除，需要重新打开后从头上传
+	if _, err := m.Write("u1", 0, content, ""); !errors.Is(err, ErrUploadNotFound) {
+		t.Errorf("err = %v, want %v", err, ErrUploadNotFound)
+	}
+	state, err := m.Open("u1", dir, "a.bin", int64(len(content)), badHash)
+	if err != nil || state.Received != 0 {
+		t.Errorf("reopen = %+v, %v, want fresh session", state, err)
+	}
+}
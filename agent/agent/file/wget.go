@@ -0,0 +1,251 @@
+package file
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sensdata/idb/agent/global"
+	"github.com/sensdata/idb/core/model"
+	"github.com/sensdata/idb/core/utils"
+)
+
+const (
+	WgetRunning  = "running"
+	WgetDone     = "done"
+	WgetFailed   = "failed"
+	WgetCanceled = "canceled"
+
+	wgetExpire = 30 * time.Minute // 结束的下载保留一段时间，供查询结果
+)
+
+var ErrWgetNotFound = errors.New("download not found")
+
+// wgets 进行中及最近结束的下载
+var wgets = &wgetManager{tasks: map[string]*wgetTask{}}
+
+type wgetManager struct {
+	mu    sync.Mutex
+	tasks map[string]*wgetTask
+}
+
+type wgetTask struct {
+	mu       sync.Mutex
+	status   model.FileWgetStatus
+	cancel   context.CancelFunc
+	finished time.Time
+}
+
+// Wget 在后台下载文件，先写入同目录下的临时文件，校验通过后重命名，返回用于查询进度的 key
+func (f *FileService) Wget(w model.FileWget) (string, error) {
+	u, err := url.Parse(w.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("invalid url %q", w.Url)
+	}
+	if w.Name == "" || w.Name != filepath.Base(w.Name) || w.Name == "." || w.Name == ".." {
+		return "", fmt.Errorf("invalid file name %q", w.Name)
+	}
+	newHash, sum, err := parseChecksum(w.Checksum)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Stat(w.Path)
+	if err != nil {
+		return "", err
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("%s is not a directory", w.Path)
+	}
+	dst := filepath.Join(w.Path, w.Name)
+	if _, err := os.Lstat(dst); err == nil {
+		return "", fmt.Errorf("%s already exists", dst)
+	}
+
+	key := "file-wget-" + utils.GenerateUuid()
+	ctx, cancel := context.WithCancel(context.Background())
+	task := &wgetTask{
+		status: model.FileWgetStatus{Key: key, Path: dst, State: WgetRunning, Total: -1},
+		cancel: cancel,
+	}
+	wgets.add(key, task)
+
+	go func() {
+		defer cancel()
+		err := task.download(ctx, w, dst, newHash, sum)
+		task.mu.Lock()
+		defer task.mu.Unlock()
+		task.finished = time.Now()
+		switch {
+		case err == nil:
+			task.status.State = WgetDone
+			global.LOG.Info("Downloaded %s to %s, %d bytes", w.Url, dst, task.status.Written)
+		case ctx.Err() != nil:
+			task.status.State = WgetCanceled
+			global.LOG.Info("Download %s canceled", w.Url)
+		default:
+			task.status.State = WgetFailed
+			task.status.Error = err.Error()
+			global.LOG.Error("Failed to download %s: %v", w.Url, err)
+		}
+	}()
+	return key, nil
+}
+
+// WgetStatus 查询下载进度
+func (f *FileService) WgetStatus(req model.FileWgetQuery) (*model.FileWgetStatus, error) {
+	task, ok := wgets.get(req.Key)
+	if !ok {
+		return nil, ErrWgetNotFound
+	}
+	task.mu.Lock()
+	defer task.mu.Unlock()
+	status := task.status
+	return &status, nil
+}
+
+// WgetCancel 取消下载，已结束的下载不受影响
+func (f *FileService) WgetCancel(req model.FileWgetQuery) error {
+	task, ok := wgets.get(req.Key)
+	if !ok {
+		return ErrWgetNotFound
+	}
+	task.cancel()
+	return nil
+}
+
+func (m *wgetManager) add(key string, task *wgetTask) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for k, t := range m.tasks {
+		t.mu.Lock()
+		expired := !t.finished.IsZero() && time.Since(t.finished) > wgetExpire
+		t.mu.Unlock()
+		if expired {
+			delete(m.tasks, k)
+		}
+	}
+	m.tasks[key] = task
+}
+
+func (m *wgetManager) get(key string) (*wgetTask, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	task, ok := m.tasks[key]
+	return task, ok
+}
+
+func (t *wgetTask) download(ctx context.Context, w model.FileWget, dst string, newHash func() hash.Hash, sum string) error {
+	client := &http.Client{}
+	if w.IgnoreCertificate {
+		client.Transport = &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, w.Url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept-Encoding", "identity")
+	resp, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response: %s", resp.Status)
+	}
+	t.mu.Lock()
+	t.status.Total = resp.ContentLength
+	t.mu.Unlock()
+
+	tmp := filepath.Join(filepath.Dir(dst), fmt.Sprintf(".%s.%s.wget", filepath.Base(dst), t.status.Key))
+	out, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+
+	var h hash.Hash
+	writers := []io.Writer{out, t}
+	if newHash != nil {
+		h = newHash()
+		writers = append(writers, h)
+	}
+	_, err = io.Copy(io.MultiWriter(writers...), resp.Body)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if resp.ContentLength >= 0 && t.written() != resp.ContentLength {
+		return fmt.Errorf("incomplete download: %d/%d bytes", t.written(), resp.ContentLength)
+	}
+	if h != nil {
+		if actual := hex.EncodeToString(h.Sum(nil)); actual != sum {
+			return fmt.Errorf("checksum mismatch: expected %s, got %s", sum, actual)
+		}
+	}
+	if _, err := os.Lstat(dst); err == nil {
+		return fmt.Errorf("%s already exists", dst)
+	}
+	return os.Rename(tmp, dst)
+}
+
+// Write 统计已下载的字节数
+func (t *wgetTask) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	t.status.Written += int64(len(p))
+	t.mu.Unlock()
+	return len(p), nil
+}
+
+func (t *wgetTask) written() int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.status.Written
+}
+
+// parseChecksum 解析 算法:十六进制值 格式的校验值，为空时不校验
+func parseChecksum(checksum string) (func() hash.Hash, string, error) {
+	checksum = strings.TrimSpace(checksum)
+	if checksum == "" {
+		return nil, "", nil
+	}
+	algo, sum := "sha256", checksum
+	if i := strings.Index(checksum, ":"); i >= 0 {
+		algo, sum = strings.ToLower(checksum[:i]), checksum[i+1:]
+	}
+	sum = strings.ToLower(sum)
+	var newHash func() hash.Hash
+	var size int
+	switch algo {
+	case "md5":
+		newHash, size = md5.New, md5.Size
+	case "sha1":
+		newHash, size = sha1.New, sha1.Size
+	case "sha256":
+		newHash, size = sha256.New, sha256.Size
+	default:
+		return nil, "", fmt.Errorf("unsupported checksum algorithm %q", algo)
+	}
+	if b, err := hex.DecodeString(sum); err != nil || len(b) != size {
+		return nil, "", fmt.Errorf("invalid %s checksum %q", algo, sum)
+	}
+	return newHash, sum, nil
+}
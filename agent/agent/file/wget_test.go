@@ -0,0 +1,194 @@
+package file
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sensdata/idb/core/model"
+)
+
+// waitWget 轮询直到下载结束，返回最终状态
+func waitWget(t *testing.T, f *FileService, key string) *model.FileWgetStatus {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		status, err := f.WgetStatus(model.FileWgetQuery{Key: key})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status.State != WgetRunning {
+			return status
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("download still running: %+v", status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// assertNoTemp 下载结束后目录下不应残留临时文件
+func assertNoTemp(t *testing.T, dir string) {
+	t.Helper()
+	matches, _ := filepath.Glob(filepath.Join(dir, ".*.wget"))
+	if len(matches) > 0 {
+		t.Errorf("temp files left: %v", matches)
+	}
+}
+
+func TestWget(t *testing.T) {
+	content := []byte(strings.Repeat("idb-wget-", 10000))
+	sum := sha256.Sum256(content)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/file":
+			w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+			w.Write(content)
+		case "/short":
+			// 声明的长度大于实际内容，服务端写完后断开连接
+			w.Header().Set("Content-Length", strconv.Itoa(len(content)+100))
+			w.Write(content)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		path     string
+		checksum string
+		state    string
+		wantErr  string
+	}{
+		{name: "success", path: "/file", state: WgetDone},
+		{name: "checksum match", path: "/file", checksum: "sha256:" + hex.EncodeToString(sum[:]), state: WgetDone},
+		{name: "checksum without algorithm", path: "/file", checksum: strings.ToUpper(hex.EncodeToString(sum[:])), state: WgetDone},
+		{name: "checksum mismatch", path: "/file", checksum: "md5:" + strings.Repeat("0", 32), state: WgetFailed, wantErr: "checksum mismatch"},
+		{name: "not found", path: "/missing", state: WgetFailed, wantErr: "404"},
+		{name: "short body", path: "/short", state: WgetFailed, wantErr: "EOF"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			f := &FileService{}
+			key, err := f.Wget(model.FileWget{Url: server.URL + tt.path, Path: dir, Name: "out.bin", Checksum: tt.checksum})
+			if err != nil {
+				t.Fatal(err)
+			}
+			status := waitWget(t, f, key)
+			if status.State != tt.state || !strings.Contains(status.Error, tt.wantErr) {
+				t.Fatalf("status = %+v, want state %s error %q", status, tt.state, tt.wantErr)
+			}
+			assertNoTemp(t, dir)
+
+			dst := filepath.Join(dir, "out.bin")
+			got, err := os.ReadFile(dst)
+			if tt.state != WgetDone {
+				if err == nil {
+					t.Error("destination created for failed download")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(content) {
+				t.Errorf("downloaded %d bytes, want %d", len(got), len(content))
+			}
+			if status.Path != dst || status.Total != int64(len(content)) || status.Written != int64(len(content)) {
+				t.Errorf("status = %+v", status)
+			}
+		})
+	}
+}
+
+func TestWgetCancel(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "2048")
+		w.Write(make([]byte, 1024))
+		w.(http.Flusher).Flush()
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	f := &FileService{}
+	key, err := f.Wget(model.FileWget{Url: server.URL, Path: dir, Name: "out.bin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 等待部分内容写入临时文件后取消
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		status, _ := f.WgetStatus(model.FileWgetQuery{Key: key})
+		if status.Written == 1024 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("download did not start: %+v", status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if matches, _ := filepath.Glob(filepath.Join(dir, ".*.wget")); len(matches) != 1 {
+		t.Fatalf("temp files = %v, want one in progress", matches)
+	}
+	if err := f.WgetCancel(model.FileWgetQuery{Key: key}); err != nil {
+		t.Fatal(err)
+	}
+
+	status := waitWget(t, f, key)
+	if status.State != WgetCanceled || status.Total != 2048 {
+		t.Errorf("status = %+v, want canceled", status)
+	}
+	assertNoTemp(t, dir)
+	if _, err := os.Stat(filepath.Join(dir, "out.bin")); err == nil {
+		t.Error("destination created for canceled download")
+	}
+	if err := f.WgetCancel(model.FileWgetQuery{Key: "unknown"}); err != ErrWgetNotFound {
+		t.Errorf("cancel unknown = %v, want %v", err, ErrWgetNotFound)
+	}
+}
+
+func TestWgetInvalidRequest(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "exists.bin"), []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		req     model.FileWget
+		wantErr string
+	}{
+		{"existing destination", model.FileWget{Url: "http://127.0.0.1/x", Path: dir, Name: "exists.bin"}, "already exists"},
+		{"unsupported scheme", model.FileWget{Url: "file:///etc/passwd", Path: dir, Name: "a"}, "invalid url"},
+		{"name with path", model.FileWget{Url: "http://127.0.0.1/x", Path: dir, Name: "../a"}, "invalid file name"},
+		{"missing directory", model.FileWget{Url: "http://127.0.0.1/x", Path: filepath.Join(dir, "missing"), Name: "a"}, "no such file"},
+		{"bad checksum", model.FileWget{Url: "http://127.0.0.1/x", Path: dir, Name: "a", Checksum: "sha256:abc"}, "invalid sha256 checksum"},
+		{"unsupported algorithm", model.FileWget{Url: "http://127.0.0.1/x", Path: dir, Name: "a", Checksum: "crc32:abcd"}, "unsupported checksum"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := (&FileService{}).Wget(tt.req)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+	if got, _ := os.ReadFile(filepath.Join(dir, "exists.bin")); string(got) != "old" {
+		t.Errorf("existing file overwritten: %q", got)
+	}
+}
@@ -2,9 +2,12 @@ package rsync
 
 import (
 	"errors"
+	"math"
 	"path/filepath"
+	"time"
 
 	"github.com/sensdata/idb/agent/agent/rsync/pkg"
+	"github.com/sensdata/idb/agent/global"
 	"github.com/sensdata/idb/core/constant"
 	"github.com/sensdata/idb/core/model"
 )
@@ -21,7 +24,11 @@ func NewRsyncLib() *RsyncLib {
 	if err != nil {
 		panic(err)
 	}
-	m := pkg.NewManager(storage, 1, 100)
+	history, err := pkg.NewRunHistory(filepath.Join(constant.AgentDataDir, "rsync", "history"))
+	if err != nil {
+		panic(err)
+	}
+	m := pkg.NewManager(storage, history, 1, 100)
 	return &RsyncLib{m: m}
 }
 
@@ -43,6 +50,13 @@ func (api *RsyncLib) Create(req model.RsyncClientCreateTaskRequest) (*model.Rsyn
 		SSHPrivateKey: req.SSHPrivateKey,
 		RemotePath:    req.RemotePath,
 		Module:        req.Module,
+		SyncOptions:   toSyncOptions(req.RsyncOptions),
+	}
+	if err := t.SyncOptions.Validate(); err != nil {
+		return &rsp, err
+	}
+	if err := pkg.ApplySchedule(t, req.Schedule, time.Now()); err != nil {
+		return &rsp, err
 	}
 	id, err := api.m.CreateTask(t, req.Enqueue)
 	if err != nil {
@@ -60,6 +74,13 @@ func (api *RsyncLib) Update(req model.RsyncClientUpdateTaskRequest) error {
 	if err != nil {
 		return err
 	}
+	options := toSyncOptions(req.RsyncOptions)
+	if err := options.Validate(); err != nil {
+		return err
+	}
+	if err := pkg.ApplySchedule(t, req.Schedule, time.Now()); err != nil {
+		return err
+	}
 
 	t.Name = req.Name
 	t.Direction = pkg.SyncDirection(req.Direction)
@@ -73,6 +94,7 @@ func (api *RsyncLib) Update(req model.RsyncClientUpdateTaskRequest) error {
 	t.SSHPrivateKey = req.SSHPrivateKey
 	t.RemotePath = req.RemotePath
 	t.Module = req.Module
+	t.SyncOptions = options
 	return api.m.UpdateTask(t)
 }
 
@@ -83,23 +105,7 @@ func (api *RsyncLib) List(req model.RsyncListTaskRequest) (*model.RsyncClientLis
 		return &resp, err
 	}
 	for _, t := range tasks {
-		resp.Tasks = append(resp.Tasks, &model.RsyncClientTask{
-			ID:            t.ID,
-			Name:          t.Name,
-			Direction:     string(t.Direction),
-			LocalPath:     t.LocalPath,
-			RemoteType:    string(t.RemoteType),
-			RemoteHost:    t.RemoteHost,
-			RemotePort:    t.RemotePort,
-			Username:      t.Username,
-			AuthMode:      string(t.AuthMode),
-			Password:      t.Password,
-			SSHPrivateKey: t.SSHPrivateKey,
-			RemotePath:    t.RemotePath,
-			Module:        t.Module,
-			State:         string(t.State),
-			Attempt:       t.Attempt,
-		})
+		resp.Tasks = append(resp.Tasks, api.toClientTask(t))
 	}
 
 	resp.Total = len(tasks)
@@ -113,23 +119,7 @@ func (api *RsyncLib) All() (*model.RsyncClientListTaskResponse, error) {
 		return &resp, err
 	}
 	for _, t := range tasks {
-		resp.Tasks = append(resp.Tasks, &model.RsyncClientTask{
-			ID:            t.ID,
-			Name:          t.Name,
-			Direction:     string(t.Direction),
-			LocalPath:     t.LocalPath,
-			RemoteType:    string(t.RemoteType),
-			RemoteHost:    t.RemoteHost,
-			RemotePort:    t.RemotePort,
-			Username:      t.Username,
-			AuthMode:      string(t.AuthMode),
-			Password:      t.Password,
-			SSHPrivateKey: t.SSHPrivateKey,
-			RemotePath:    t.RemotePath,
-			Module:        t.Module,
-			State:         string(t.State),
-			Attempt:       t.Attempt,
-		})
+		resp.Tasks = append(resp.Tasks, api.toClientTask(t))
 	}
 	resp.Total = len(tasks)
 	return &resp, nil
@@ -141,7 +131,11 @@ func (api *RsyncLib) Detail(id string) (*model.RsyncClientTask, error) {
 	if err != nil {
 		return &resp, err
 	}
-	return &model.RsyncClientTask{
+	return api.toClientTask(t), nil
+}
+
+func (api *RsyncLib) toClientTask(t *pkg.RsyncTask) *model.RsyncClientTask {
+	task := &model.RsyncClientTask{
 		ID:            t.ID,
 		Name:          t.Name,
 		Direction:     string(t.Direction),
@@ -157,7 +151,68 @@ func (api *RsyncLib) Detail(id string) (*model.RsyncClientTask, error) {
 		Module:        t.Module,
 		State:         string(t.State),
 		Attempt:       t.Attempt,
-	}, nil
+		Schedule:      t.Schedule,
+		NextRunAt:     formatNextRun(t.NextRunAt),
+		RsyncOptions: model.RsyncOptions{
+			BwLimit:        t.BwLimit,
+			Includes:       t.Includes,
+			Excludes:       t.Excludes,
+			FilterFile:     t.FilterFile,
+			DeleteMode:     t.DeleteMode,
+			Checksum:       t.Checksum,
+			NoCompress:     t.NoCompress,
+			PreserveACLs:   t.PreserveACLs,
+			PreserveXattrs: t.PreserveXattrs,
+		},
+	}
+	if progress, path := api.m.GetProgress(t.ID); progress != nil {
+		task.Progress = &model.RsyncProgress{
+			Percent:          progress.Percent,
+			Bytes:            progress.Bytes,
+			Rate:             progress.Rate,
+			ETA:              progress.ETA,
+			FilesTransferred: progress.FilesTransferred,
+			FilesRemaining:   progress.FilesRemaining,
+			FilesTotal:       progress.FilesTotal,
+			Done:             progress.Done,
+			UpdatedAt:        progress.UpdatedAt,
+		}
+		task.ProgressPath = path
+	}
+	if t.LastStats != nil {
+		task.LastStats = &model.RsyncTransferStats{
+			FilesTotal:       t.LastStats.FilesTotal,
+			FilesCreated:     t.LastStats.FilesCreated,
+			FilesDeleted:     t.LastStats.FilesDeleted,
+			FilesTransferred: t.LastStats.FilesTransferred,
+			TotalSize:        t.LastStats.TotalSize,
+			TransferredSize:  t.LastStats.TransferredSize,
+			BytesSent:        t.LastStats.BytesSent,
+			BytesReceived:    t.LastStats.BytesReceived,
+		}
+	}
+	return task
+}
+
+func toSyncOptions(o model.RsyncOptions) pkg.SyncOptions {
+	return pkg.SyncOptions{
+		BwLimit:        o.BwLimit,
+		Includes:       o.Includes,
+		Excludes:       o.Excludes,
+		FilterFile:     o.FilterFile,
+		DeleteMode:     o.DeleteMode,
+		Checksum:       o.Checksum,
+		NoCompress:     o.NoCompress,
+		PreserveACLs:   o.PreserveACLs,
+		PreserveXattrs: o.PreserveXattrs,
+	}
+}
+
+func formatNextRun(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02 15:04:05")
 }
 
 func (api *RsyncLib) Stop(id string) error {
@@ -180,22 +235,77 @@ func (api *RsyncLib) TestSync(id string) (*model.RsyncTaskLog, error) {
 	}
 	taskLog.ID = id
 	taskLog.Path = path
+
+	// 设置了删除策略时，从试运行日志中预览将被删除的文件
+	t, err := api.m.GetTask(id)
+	if err == nil && t.DeleteMode != pkg.DeleteModeNone && path != "" {
+		count, preview, err := pkg.DeletePreview(path)
+		if err != nil {
+			global.LOG.Warn("[rsyncmgr] failed to preview deletions of task %s: %v", id, err)
+		}
+		taskLog.DeleteCount = count
+		taskLog.DeletePreview = preview
+	}
 	return &taskLog, nil
 }
 
+// LogList 返回执行记录（按时间倒序），之后附加没有执行记录的早期日志文件
 func (api *RsyncLib) LogList(req model.RsyncTaskLogListRequest) (*model.RsyncTaskLogListResponse, error) {
 	var resp model.RsyncTaskLogListResponse
-	total, logs, err := api.m.GetTaskLogs(req.ID, req.Page, req.PageSize)
+	runs, err := api.m.GetTaskRuns(req.ID)
 	if err != nil {
 		return &resp, err
 	}
-	for _, l := range logs {
-		resp.Logs = append(resp.Logs, &model.RsyncTaskLog{
+	_, files, err := api.m.GetTaskLogs(req.ID, 1, math.MaxInt32)
+	if err != nil {
+		return &resp, err
+	}
+
+	logs := make([]*model.RsyncTaskLog, 0, len(runs)+len(files))
+	recorded := make(map[string]bool, len(runs))
+	for _, r := range runs {
+		startedAt, endedAt := r.StartedAt, r.EndedAt
+		logs = append(logs, &model.RsyncTaskLog{
+			ID:               req.ID,
+			Path:             r.LogPath,
+			ProgressPath:     r.ProgressPath,
+			RunID:            r.ID,
+			Trigger:          r.Trigger,
+			State:            string(r.State),
+			StartedAt:        &startedAt,
+			EndedAt:          &endedAt,
+			ExitCode:         r.ExitCode,
+			BytesTransferred: r.BytesTransferred,
+			FilesChanged:     r.FilesChanged,
+			Error:            r.Error,
+		})
+		if r.LogPath != "" {
+			recorded[r.LogPath] = true
+		}
+	}
+	for i := len(files) - 1; i >= 0; i-- {
+		if recorded[files[i]] {
+			continue
+		}
+		logs = append(logs, &model.RsyncTaskLog{
 			ID:   req.ID,
-			Path: l,
+			Path: files[i],
 		})
 	}
-	resp.Total = total
+
+	// 分页
+	resp.Total = len(logs)
+	if req.Page <= 0 || req.PageSize <= 0 {
+		resp.Logs = logs
+		return &resp, nil
+	}
+	start := (req.Page - 1) * req.PageSize
+	if start >= len(logs) {
+		resp.Logs = []*model.RsyncTaskLog{}
+		return &resp, nil
+	}
+	end := min(start+req.PageSize, len(logs))
+	resp.Logs = logs[start:end]
 	return &resp, nil
 }
 
@@ -3,6 +3,7 @@ package pkg
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"regexp"
@@ -20,7 +21,7 @@ var (
 	rsyncArgsOnce sync.Once
 )
 
-// getRsyncBaseArgs 根据rsync版本选择最佳参数
+// getRsyncBaseArgs 根据rsync版本选择最佳参数，压缩等任务级参数见 SyncOptions
 func getRsyncBaseArgs() []string {
 	rsyncArgsOnce.Do(func() {
 		cmd := exec.Command("rsync", "--version")
@@ -36,13 +37,14 @@ func getRsyncBaseArgs() []string {
 				minor, _ := strconv.Atoi(m[2])
 				if major > 3 || (major == 3 && minor >= 1) {
 					// >= 3.1 支持 --info=progress2
-					rsyncArgs = []string{"-azv", "--info=progress2", "--partial", "--stats"}
+					// --no-inc-recursive 预先扫描完整文件列表，使整体进度准确
+					rsyncArgs = []string{"-av", "--info=progress2,stats2", "--no-inc-recursive", "--partial"}
 					return
 				}
 			}
 		}
 		// fallback - 对于旧版本使用传统进度显示
-		rsyncArgs = []string{"-azv", "--progress", "--partial", "--stats"}
+		rsyncArgs = []string{"-av", "--progress", "--partial", "--stats"}
 	})
 	return rsyncArgs
 }
@@ -56,6 +58,15 @@ func shQuote(s string) string {
 	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
 }
 
+// shQuoteArgs 逐个引用参数后拼接，用于经由 bash -c 执行的命令
+func shQuoteArgs(args []string) string {
+	quoted := make([]string, 0, len(args))
+	for _, a := range args {
+		quoted = append(quoted, shQuote(a))
+	}
+	return strings.Join(quoted, " ")
+}
+
 // pickSSHCommand 构建SSH命令并返回可选的包装器
 func pickSSHCommand(t *RsyncTask) (sshCmd string, wrapper string) {
 	if t.RemoteType != RemoteTypeSSH {
@@ -92,9 +103,13 @@ func pickSSHCommand(t *RsyncTask) (sshCmd string, wrapper string) {
 
 // ExecProcess wraps a running command so we can stop it
 type ExecProcess struct {
-	cmd    *exec.Cmd
-	lock   sync.Mutex
-	cancel context.CancelFunc
+	cmd      *exec.Cmd
+	lock     sync.Mutex
+	cancel   context.CancelFunc
+	stopped  bool
+	progress *progressWriter
+	// progressPath 进度文件路径，可通过日志流追踪
+	progressPath string
 }
 
 func (p *ExecProcess) Stop() error {
@@ -103,6 +118,7 @@ func (p *ExecProcess) Stop() error {
 	if p.cmd == nil || p.cmd.Process == nil {
 		return fmt.Errorf("no process")
 	}
+	p.stopped = true
 
 	// 先取消context
 	if p.cancel != nil {
@@ -125,10 +141,23 @@ func (p *ExecProcess) Stop() error {
 	return err
 }
 
+// Progress 返回当前的传输进度及进度文件路径
+func (p *ExecProcess) Progress() (Progress, string) {
+	return p.progress.Current(), p.progressPath
+}
+
+// Stopped 进程是否由 Stop 主动结束
+func (p *ExecProcess) Stopped() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.stopped
+}
+
 // buildRsyncCommand builds rsync command according to task
 func buildRsyncCommand(t *RsyncTask) ([]string, string, string, error) {
 	// 使用优化的基础参数
 	args := append([]string{}, getRsyncBaseArgs()...)
+	args = append(args, t.SyncOptions.args()...)
 	var src, dst string
 	var sshCmd, wrapper string
 
@@ -201,17 +230,16 @@ func StartRsync(t *RsyncTask, logHandler *LogHandler) (*ExecProcess, error) {
 	}
 	global.LOG.Info("[rsyncmgr] args: %s, sshCmd: %s, wrapper: %s", strings.Join(args, " "), sshCmd, wrapper)
 
-	// prepare command
+	// prepare command，cancel 由 ExecProcess 持有，进程结束或停止时调用
 	var cmd *exec.Cmd
 	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel() // Ensure cancel is always called to prevent context leaks
 
 	// 根据远程类型决定执行方式
 	if t.RemoteType == RemoteTypeSSH && sshCmd != "" {
 		// SSH模式：需要特殊处理
 		if wrapper != "" {
 			// 密码认证模式：使用sshpass包装器
-			fullCmd := fmt.Sprintf("%s rsync %s", wrapper, strings.Join(args, " "))
+			fullCmd := fmt.Sprintf("%s rsync %s", wrapper, shQuoteArgs(args))
 			global.LOG.Info("[rsyncmgr] fullCmd: %s", fullCmd)
 			cmd = exec.CommandContext(ctx, "bash", "-c", fullCmd)
 		} else {
@@ -237,12 +265,23 @@ func StartRsync(t *RsyncTask, logHandler *LogHandler) (*ExecProcess, error) {
 	// 使用日志处理器直接写入文件
 	logWriter, err := logHandler.GetExecutionLogWriter()
 	if err != nil {
+		cancel()
 		global.LOG.Error("[rsyncmgr] failed to get log writer for task %s: %v", t.ID, err)
 		return nil, err
 	}
-	// 同时重定向标准输出和标准错误到同一个日志文件
-	cmd.Stdout = logWriter
-	cmd.Stderr = logWriter
+	progressFile, err := logHandler.GetProgressWriter()
+	if err != nil {
+		cancel()
+		global.LOG.Error("[rsyncmgr] failed to get progress writer for task %s: %v", t.ID, err)
+		return nil, err
+	}
+	progress := newProgressWriter(progressFile)
+	// 同时重定向标准输出和标准错误到同一个日志文件，并解析其中的进度及统计
+	output := io.MultiWriter(logWriter, progress)
+	cmd.Stdout = output
+	cmd.Stderr = output
+	// 输出经由管道转发，子进程未退出时避免 Wait 一直阻塞
+	cmd.WaitDelay = 5 * time.Second
 
 	// 设置进程组属性，便于进程管理
 	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
@@ -256,7 +295,7 @@ func StartRsync(t *RsyncTask, logHandler *LogHandler) (*ExecProcess, error) {
 	global.LOG.Error("[rsyncmgr] rsync process started for task %s, PID: %d", t.ID, cmd.Process.Pid)
 
 	// 输出捕获器已经通过cmd.Stdout和cmd.Stderr关联，runTask可以直接访问
-	proc := &ExecProcess{cmd: cmd, cancel: cancel}
+	proc := &ExecProcess{cmd: cmd, cancel: cancel, progress: progress, progressPath: progressFile.Name()}
 	return proc, nil
 }
 
@@ -280,7 +319,7 @@ func TestRsync(t *RsyncTask, logHandler *LogHandler) (string, error) {
 	if t.RemoteType == RemoteTypeSSH && sshCmd != "" {
 		if wrapper != "" {
 			// 密码认证模式：使用sshpass包装器
-			fullCmd := fmt.Sprintf("%s rsync %s", wrapper, strings.Join(testArgs, " "))
+			fullCmd := fmt.Sprintf("%s rsync %s", wrapper, shQuoteArgs(testArgs))
 			global.LOG.Error("[rsyncmgr] test fullCmd: %s", fullCmd)
 			cmd = exec.CommandContext(ctx, "bash", "-c", fullCmd)
 		} else {
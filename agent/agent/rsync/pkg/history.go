@@ -0,0 +1,105 @@
+package pkg
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/sensdata/idb/agent/global"
+)
+
+// maxRunHistory 每个任务保留的执行记录数，超出的记录连同其日志文件一并清理
+const maxRunHistory = 100
+
+// RunHistory 按任务保存执行记录，每个任务一个 JSON 文件
+type RunHistory struct {
+	dir string
+	mu  sync.Mutex
+}
+
+func NewRunHistory(dir string) (*RunHistory, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create history dir: %w", err)
+	}
+	return &RunHistory{dir: dir}, nil
+}
+
+func (h *RunHistory) path(taskID string) string {
+	return filepath.Join(h.dir, taskID+".json")
+}
+
+func (h *RunHistory) loadLocked(taskID string) ([]*RunRecord, error) {
+	b, err := os.ReadFile(h.path(taskID))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var records []*RunRecord
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, &records); err != nil {
+			global.LOG.Warn("[rsyncmgr] history of task %s corrupted, resetting: %v", taskID, err)
+			return nil, nil
+		}
+	}
+	return records, nil
+}
+
+// Append 追加一条执行记录，并按保留数量清理最早的记录
+func (h *RunHistory) Append(taskID string, r *RunRecord) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	records, err := h.loadLocked(taskID)
+	if err != nil {
+		return err
+	}
+	records = append(records, r)
+	if len(records) > maxRunHistory {
+		for _, old := range records[:len(records)-maxRunHistory] {
+			for _, path := range []string{old.LogPath, old.ProgressPath} {
+				if path == "" || path == r.LogPath || path == r.ProgressPath {
+					continue
+				}
+				if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+					global.LOG.Warn("[rsyncmgr] failed to remove log %s: %v", path, err)
+				}
+			}
+		}
+		records = records[len(records)-maxRunHistory:]
+	}
+
+	b, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(h.path(taskID), b, 0640)
+}
+
+// List 按时间倒序返回执行记录
+func (h *RunHistory) List(taskID string) ([]*RunRecord, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	records, err := h.loadLocked(taskID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*RunRecord, 0, len(records))
+	for i := len(records) - 1; i >= 0; i-- {
+		out = append(out, records[i])
+	}
+	return out, nil
+}
+
+func (h *RunHistory) Delete(taskID string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if err := os.Remove(h.path(taskID)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -19,6 +19,7 @@ type LogHandler struct {
 	mu          sync.Mutex
 	execLogFile *os.File
 	testLogFile *os.File
+	progressLog *os.File
 }
 
 // NewLogHandler 创建新的日志处理器
@@ -82,6 +83,32 @@ func (lh *LogHandler) GetExecutionLogWriter() (*os.File, error) {
 	return lh.execLogFile, nil
 }
 
+// GetProgressWriter 获取进度文件的写入器，进度文件与执行日志同名，扩展名为 .progress
+func (lh *LogHandler) GetProgressWriter() (*os.File, error) {
+	lh.mu.Lock()
+	defer lh.mu.Unlock()
+
+	if lh.progressLog == nil {
+		logPath := progressPathOf(lh.getExecLogPath())
+		if lh.execLogFile != nil {
+			logPath = progressPathOf(lh.execLogFile.Name())
+		}
+		file, err := os.Create(logPath)
+		if err != nil {
+			global.LOG.Error("[rsyncmgr] failed to create progress file %s: %v", logPath, err)
+			return nil, err
+		}
+		lh.progressLog = file
+	}
+
+	return lh.progressLog, nil
+}
+
+// progressPathOf 执行日志对应的进度文件路径
+func progressPathOf(execLogPath string) string {
+	return strings.TrimSuffix(execLogPath, ".log") + ".progress"
+}
+
 // GetTestLogWriter 获取测试日志的写入器，用于直接将测试命令输出重定向到日志文件
 func (lh *LogHandler) GetTestLogWriter() (*os.File, error) {
 	lh.mu.Lock()
@@ -148,16 +175,43 @@ func (lh *LogHandler) Close() error {
 		lh.testLogFile = nil
 	}
 
+	if lh.progressLog != nil {
+		if closeErr := lh.progressLog.Close(); closeErr != nil {
+			err = closeErr
+		}
+		lh.progressLog = nil
+	}
+
 	return err
 }
 
-// GetExecLogPath 获取执行日志文件路径
+// GetExecLogPath 获取执行日志文件路径，已打开时返回实际写入的文件
 func (lh *LogHandler) GetExecLogPath() string {
+	lh.mu.Lock()
+	defer lh.mu.Unlock()
+	if lh.execLogFile != nil {
+		return lh.execLogFile.Name()
+	}
 	return lh.getExecLogPath()
 }
 
-// GetTestLogPath 获取测试日志文件路径
+// GetProgressPath 获取进度文件路径，未创建时返回空
+func (lh *LogHandler) GetProgressPath() string {
+	lh.mu.Lock()
+	defer lh.mu.Unlock()
+	if lh.progressLog != nil {
+		return lh.progressLog.Name()
+	}
+	return ""
+}
+
+// GetTestLogPath 获取测试日志文件路径，已打开时返回实际写入的文件
 func (lh *LogHandler) GetTestLogPath() string {
+	lh.mu.Lock()
+	defer lh.mu.Unlock()
+	if lh.testLogFile != nil {
+		return lh.testLogFile.Name()
+	}
 	return lh.getTestLogPath()
 }
 
@@ -3,6 +3,7 @@ package pkg
 import (
 	"errors"
 	"fmt"
+	"os/exec"
 	"sync"
 	"time"
 
@@ -10,12 +11,20 @@ import (
 	"github.com/sensdata/idb/agent/global"
 )
 
+// scheduleTick 定时任务的检查间隔
+const scheduleTick = 10 * time.Second
+
+// ErrTaskBusy 任务正在执行或已在队列中
+var ErrTaskBusy = errors.New("task is already running or queued")
+
 // Manager controls tasks and execution
 type Manager struct {
 	storage        Storage
+	history        *RunHistory
 	mu             sync.RWMutex
 	runtimeProcs   map[string]*ExecProcess
-	queue          chan string // task IDs
+	queue          chan string       // task IDs
+	queued         map[string]string // 已入队的任务 ID -> 触发方式
 	maxConcurrency int
 	sem            chan struct{} // semaphore to limit concurrency
 	stopCh         chan struct{}
@@ -23,14 +32,16 @@ type Manager struct {
 }
 
 // NewManager creates a manager; maxConcurrency default 1 if <=0
-func NewManager(storage Storage, maxConcurrency int, queueSize int) *Manager {
+func NewManager(storage Storage, history *RunHistory, maxConcurrency int, queueSize int) *Manager {
 	if maxConcurrency <= 0 {
 		maxConcurrency = 1
 	}
 	m := &Manager{
 		storage:        storage,
+		history:        history,
 		runtimeProcs:   map[string]*ExecProcess{},
 		queue:          make(chan string, queueSize),
+		queued:         map[string]string{},
 		maxConcurrency: maxConcurrency,
 		sem:            make(chan struct{}, maxConcurrency),
 		stopCh:         make(chan struct{}),
@@ -39,6 +50,7 @@ func NewManager(storage Storage, maxConcurrency int, queueSize int) *Manager {
 	// 状态修复已在FileJSONStorage初始化时完成，无需重复处理
 
 	go m.dispatcher()
+	go m.scheduler()
 	return m
 }
 
@@ -48,17 +60,21 @@ func (m *Manager) dispatcher() {
 		case id := <-m.queue:
 			// acquire semaphore
 			m.sem <- struct{}{}
+			m.mu.Lock()
+			trigger := m.queued[id]
+			delete(m.queued, id)
+			m.mu.Unlock()
 			m.wg.Add(1)
-			go func(taskID string) {
+			go func(taskID string, trigger string) {
 				defer func() {
 					<-m.sem
 					m.wg.Done()
 				}()
-				if err := m.runTask(taskID); err != nil {
+				if err := m.runTask(taskID, trigger); err != nil {
 					// log
 					global.LOG.Error("[rsyncmgr] runTask %s error: %v", taskID, err)
 				}
-			}(id)
+			}(id, trigger)
 		case <-m.stopCh:
 			return
 		}
@@ -86,7 +102,7 @@ func (m *Manager) CreateTask(t *RsyncTask, enqueue bool) (string, error) {
 		return "", err
 	}
 	if enqueue {
-		if err := m.enqueueTask(t.ID); err != nil {
+		if err := m.enqueueTask(t.ID, TriggerManual); err != nil {
 			global.LOG.Error("[rsyncmgr] failed to enqueue task %s: %v", t.ID, err)
 			return "", err
 		}
@@ -112,14 +128,21 @@ func (m *Manager) UpdateTask(t *RsyncTask) error {
 	return nil
 }
 
-func (m *Manager) enqueueTask(id string) error {
+func (m *Manager) enqueueTask(id string, trigger string) error {
 	// validate exists
 	if _, err := m.storage.GetTask(id); err != nil {
 		global.LOG.Error("[rsyncmgr] failed to get task %s: %v", id, err)
 		return err
 	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// 同一任务在队列中只保留一份
+	if _, ok := m.queued[id]; ok {
+		return ErrTaskBusy
+	}
 	select {
 	case m.queue <- id:
+		m.queued[id] = trigger
 		return nil
 	default:
 		// queue full, return error or block; we return error for now
@@ -144,6 +167,9 @@ func (m *Manager) DeleteTask(id string) error {
 	if proc := m.getProc(id); proc != nil {
 		_ = proc.Stop()
 	}
+	if err := m.history.Delete(id); err != nil {
+		global.LOG.Warn("[rsyncmgr] failed to delete history of task %s: %v", id, err)
+	}
 	return m.storage.DeleteTask(id)
 }
 
@@ -192,16 +218,21 @@ func (m *Manager) RetryTask(id string) error {
 		global.LOG.Error("[rsyncmgr] failed to update task %s: %v", id, err)
 		return err
 	}
-	return m.enqueueTask(id)
+	return m.enqueueTask(id, TriggerManual)
 }
 
 // runTask executes one task lifecycle
-func (m *Manager) runTask(id string) error {
+func (m *Manager) runTask(id string, trigger string) error {
 	t, err := m.storage.GetTask(id)
 	if err != nil {
 		global.LOG.Error("[rsyncmgr] failed to get task %s: %v", id, err)
 		return err
 	}
+	record := &RunRecord{
+		ID:        uuid.New().String(),
+		Trigger:   trigger,
+		StartedAt: time.Now(),
+	}
 
 	// 创建日志处理器
 	logHandler := NewLogHandler(id)
@@ -214,6 +245,12 @@ func (m *Manager) runTask(id string) error {
 	// 原子性操作：先启动进程，再更新状态和注册进程
 	proc, err := StartRsync(t, logHandler)
 	if err != nil {
+		record.State = StateFailed
+		record.ExitCode = -1
+		record.EndedAt = time.Now()
+		record.Error = err.Error()
+		m.appendRecord(id, record)
+
 		t.State = StateFailed
 		t.LastError = err.Error()
 		t.UpdatedAt = time.Now()
@@ -260,6 +297,15 @@ func (m *Manager) runTask(id string) error {
 
 	// wait for process finish
 	err = proc.cmd.Wait()
+	proc.cancel()
+	stats, hasStats := proc.progress.Finish(err == nil)
+	record.LogPath = logHandler.GetExecLogPath()
+	record.ProgressPath = proc.progressPath
+	if hasStats {
+		record.FilesChanged = stats.FilesTransferred
+		record.BytesTransferred = stats.TransferredSize
+	}
+	m.appendRecord(id, finishRecord(record, proc, err))
 
 	// 原子性清理：在Manager锁保护下同时更新状态和注销进程
 	m.mu.Lock()
@@ -277,6 +323,9 @@ func (m *Manager) runTask(id string) error {
 			t.LastError = ""
 			global.LOG.Info("[rsyncmgr] rsync process completed successfully for task %s", id)
 		}
+		if hasStats {
+			t.LastStats = &stats
+		}
 		t.UpdatedAt = time.Now()
 		if updateErr := m.storage.UpdateTask(t); updateErr != nil {
 			// 记录错误但不影响主流程
@@ -321,6 +370,52 @@ func (m *Manager) TestSync(id string) (string, error) {
 	return logPath, nil
 }
 
+// finishRecord 根据进程退出状态补全执行记录
+func finishRecord(record *RunRecord, proc *ExecProcess, waitErr error) *RunRecord {
+	record.EndedAt = time.Now()
+	if waitErr == nil {
+		record.State = StateSucceeded
+		return record
+	}
+
+	record.ExitCode = -1
+	var exitErr *exec.ExitError
+	if errors.As(waitErr, &exitErr) {
+		record.ExitCode = exitErr.ExitCode()
+	}
+	record.Error = waitErr.Error()
+	if proc.Stopped() {
+		record.State = StateStopped
+	} else {
+		record.State = StateFailed
+	}
+	return record
+}
+
+func (m *Manager) appendRecord(id string, record *RunRecord) {
+	if err := m.history.Append(id, record); err != nil {
+		global.LOG.Error("[rsyncmgr] failed to append run record for task %s: %v", id, err)
+	}
+}
+
+// GetProgress 返回正在执行的任务的进度及进度文件路径，未在执行时返回 nil
+func (m *Manager) GetProgress(id string) (*Progress, string) {
+	proc := m.getProc(id)
+	if proc == nil {
+		return nil, ""
+	}
+	progress, path := proc.Progress()
+	return &progress, path
+}
+
+// GetTaskRuns 按时间倒序返回任务的执行记录
+func (m *Manager) GetTaskRuns(id string) ([]*RunRecord, error) {
+	if _, err := m.storage.GetTask(id); err != nil {
+		return nil, err
+	}
+	return m.history.List(id)
+}
+
 func (m *Manager) GetTaskLogs(id string, page, pageSize int) (int, []string, error) {
 	logHandler := NewLogHandler(id)
 	if logHandler == nil {
@@ -362,7 +457,7 @@ func (m *Manager) RunTask(name string) error {
 
 	case StatePending:
 		// 直接入队
-		return m.enqueueTask(t.ID)
+		return m.enqueueTask(t.ID, TriggerManual)
 
 	case StateFailed, StateStopped:
 		t.State = StatePending
@@ -371,7 +466,7 @@ func (m *Manager) RunTask(name string) error {
 		if err := m.storage.UpdateTask(t); err != nil {
 			return err
 		}
-		return m.enqueueTask(t.ID)
+		return m.enqueueTask(t.ID, TriggerManual)
 
 	case StateSucceeded:
 		// 显式重跑
@@ -382,9 +477,101 @@ func (m *Manager) RunTask(name string) error {
 		if err := m.storage.UpdateTask(t); err != nil {
 			return err
 		}
-		return m.enqueueTask(t.ID)
+		return m.enqueueTask(t.ID, TriggerManual)
 
 	default:
 		return fmt.Errorf("unknown task state: %s", t.State)
 	}
 }
+
+// scheduler 按定时配置将到期的任务入队
+func (m *Manager) scheduler() {
+	ticker := time.NewTicker(scheduleTick)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			m.runDueTasks(now)
+		case <-m.stopCh:
+			return
+		}
+	}
+}
+
+// runDueTasks 上一次执行尚未结束时跳过本次并记录，下次执行时间始终从当前时间重新计算
+func (m *Manager) runDueTasks(now time.Time) {
+	tasks, err := m.storage.AllTasks()
+	if err != nil {
+		global.LOG.Error("[rsyncmgr] failed to list tasks for schedule: %v", err)
+		return
+	}
+	for _, t := range tasks {
+		if t.Schedule == "" {
+			continue
+		}
+		sched, err := ParseSchedule(t.Schedule)
+		if err != nil {
+			global.LOG.Error("[rsyncmgr] invalid schedule of task %s: %v", t.ID, err)
+			continue
+		}
+		if t.NextRunAt != nil && now.Before(*t.NextRunAt) {
+			continue
+		}
+
+		due := t.NextRunAt != nil
+		// 列表是锁外读取的快照，执行可能已在此期间结束，需重新读取后再写回，避免覆盖最新状态
+		m.mu.Lock()
+		cur, err := m.storage.GetTask(t.ID)
+		if err != nil {
+			m.mu.Unlock()
+			continue
+		}
+		next := sched.Next(now)
+		cur.NextRunAt = &next
+		if err := m.storage.UpdateTask(cur); err != nil {
+			global.LOG.Error("[rsyncmgr] failed to update next run of task %s: %v", t.ID, err)
+		}
+		m.mu.Unlock()
+		if !due {
+			continue
+		}
+
+		if err := m.runScheduled(t.ID); err != nil {
+			if errors.Is(err, ErrTaskBusy) {
+				global.LOG.Info("[rsyncmgr] skip scheduled run of task %s: previous run not finished", t.ID)
+				m.appendRecord(t.ID, &RunRecord{
+					ID:        uuid.New().String(),
+					Trigger:   TriggerSchedule,
+					State:     StateSkipped,
+					StartedAt: now,
+					EndedAt:   now,
+					Error:     err.Error(),
+				})
+				continue
+			}
+			global.LOG.Error("[rsyncmgr] failed to run scheduled task %s: %v", t.ID, err)
+		}
+	}
+}
+
+func (m *Manager) runScheduled(id string) error {
+	m.mu.Lock()
+	t, err := m.storage.GetTask(id)
+	if err != nil {
+		m.mu.Unlock()
+		return err
+	}
+	_, queued := m.queued[t.ID]
+	if queued || t.State == StateRunning || m.runtimeProcs[t.ID] != nil {
+		m.mu.Unlock()
+		return ErrTaskBusy
+	}
+	t.State = StatePending
+	t.UpdatedAt = time.Now()
+	err = m.storage.UpdateTask(t)
+	m.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return m.enqueueTask(t.ID, TriggerSchedule)
+}
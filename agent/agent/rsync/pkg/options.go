@@ -0,0 +1,127 @@
+package pkg
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	DeleteModeNone   = ""
+	DeleteModeDuring = "delete"
+	DeleteModeAfter  = "delete-after"
+
+	// maxDeletePreview 试运行时返回的待删除文件数量上限
+	maxDeletePreview = 100
+)
+
+// SyncOptions 任务级的 rsync 参数
+type SyncOptions struct {
+	BwLimit        int      `json:"bw_limit,omitempty"` // 带宽限制（KiB/s），0 表示不限制
+	Includes       []string `json:"includes,omitempty"`
+	Excludes       []string `json:"excludes,omitempty"`
+	FilterFile     string   `json:"filter_file,omitempty"` // 本机上的过滤规则文件，以 merge 方式加载
+	DeleteMode     string   `json:"delete_mode,omitempty"` // 为空表示不删除接收端多余的文件
+	Checksum       bool     `json:"checksum,omitempty"`
+	NoCompress     bool     `json:"no_compress,omitempty"`
+	PreserveACLs   bool     `json:"preserve_acls,omitempty"`
+	PreserveXattrs bool     `json:"preserve_xattrs,omitempty"`
+}
+
+// Validate 校验任务参数，过滤规则按单个参数传递，只需拒绝换行及空规则
+func (o *SyncOptions) Validate() error {
+	if o.BwLimit < 0 {
+		return fmt.Errorf("invalid bandwidth limit %d", o.BwLimit)
+	}
+	switch o.DeleteMode {
+	case DeleteModeNone, DeleteModeDuring, DeleteModeAfter:
+	default:
+		return fmt.Errorf("invalid delete mode %q", o.DeleteMode)
+	}
+	for _, patterns := range [][]string{o.Includes, o.Excludes} {
+		for _, p := range patterns {
+			if strings.TrimSpace(p) == "" || strings.ContainsAny(p, "\r\n") {
+				return fmt.Errorf("invalid filter pattern %q", p)
+			}
+		}
+	}
+	if o.FilterFile != "" {
+		if !filepath.IsAbs(o.FilterFile) {
+			return fmt.Errorf("filter file must be an absolute path")
+		}
+		info, err := os.Stat(o.FilterFile)
+		if err != nil {
+			return fmt.Errorf("filter file: %v", err)
+		}
+		if info.IsDir() {
+			return fmt.Errorf("filter file %s is a directory", o.FilterFile)
+		}
+	}
+	return nil
+}
+
+// args 生成任务参数，include 规则须在 exclude 之前才能生效
+func (o *SyncOptions) args() []string {
+	var args []string
+	if !o.NoCompress {
+		args = append(args, "-z")
+	}
+	if o.Checksum {
+		args = append(args, "--checksum")
+	}
+	if o.PreserveACLs {
+		args = append(args, "--acls")
+	}
+	if o.PreserveXattrs {
+		args = append(args, "--xattrs")
+	}
+	if o.BwLimit > 0 {
+		args = append(args, "--bwlimit="+strconv.Itoa(o.BwLimit))
+	}
+	if o.DeleteMode != DeleteModeNone {
+		args = append(args, "--"+o.DeleteMode)
+	}
+	for _, p := range o.Includes {
+		args = append(args, "--include="+p)
+	}
+	for _, p := range o.Excludes {
+		args = append(args, "--exclude="+p)
+	}
+	if o.FilterFile != "" {
+		args = append(args, "--filter=merge "+o.FilterFile)
+	}
+	return args
+}
+
+// DeletePreview 从试运行日志中统计将被删除的文件，最多返回 maxDeletePreview 条
+func DeletePreview(logPath string) (int, []string, error) {
+	f, err := os.Open(logPath)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer f.Close()
+
+	var (
+		count   int
+		preview []string
+	)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if idx := strings.LastIndexByte(line, '\r'); idx >= 0 {
+			line = line[idx+1:]
+		}
+		if !strings.HasPrefix(line, "deleting ") {
+			continue
+		}
+		count++
+		if len(preview) < maxDeletePreview {
+			preview = append(preview, strings.TrimPrefix(line, "deleting "))
+		}
+	}
+	return count, preview, scanner.Err()
+}
@@ -0,0 +1,154 @@
+package pkg
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sensdata/idb/agent/global"
+)
+
+const (
+	// progressInterval 写入进度文件的最小间隔
+	progressInterval = time.Second
+	// maxPendingLine 未遇到换行时缓存的最大长度，防止异常输出占用内存
+	maxPendingLine = 64 * 1024
+)
+
+// progressPattern 匹配进度行，如 "1,234,567  45%   10.50MB/s    0:01:23 (xfr#12, to-chk=34/100)"
+var progressPattern = regexp.MustCompile(`^([\d,]+)\s+(\d+)%\s+([\d.]+)([kMGT]?B)/s\s+(\d+):(\d{2}):(\d{2})(?:\s+\(xf(?:e)?r#(\d+),\s*(?:ir-chk|to-chk|to-check)=(\d+)/(\d+)\))?`)
+
+var rateUnits = map[string]float64{
+	"B":  1,
+	"kB": 1 << 10,
+	"MB": 1 << 20,
+	"GB": 1 << 30,
+	"TB": 1 << 40,
+}
+
+// Progress 传输进度，rsync 3.1 及以上为整体进度，旧版本只能得到当前文件的进度
+type Progress struct {
+	Percent          int       `json:"percent"`
+	Bytes            int64     `json:"bytes"`
+	Rate             float64   `json:"rate"` // 字节/秒
+	ETA              int       `json:"eta"`  // 剩余秒数，完成后为已用时间
+	FilesTransferred int64     `json:"files_transferred"`
+	FilesRemaining   int64     `json:"files_remaining"`
+	FilesTotal       int64     `json:"files_total"`
+	Done             bool      `json:"done"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// progressWriter 解析 rsync 输出中的进度行与统计汇总，并按行写入 JSON 格式的进度文件
+type progressWriter struct {
+	mu       sync.Mutex
+	out      io.Writer
+	pending  []byte
+	progress Progress
+	stats    TransferStats
+	hasStats bool
+	lastEmit time.Time
+}
+
+func newProgressWriter(out io.Writer) *progressWriter {
+	return &progressWriter{out: out}
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.pending = append(w.pending, p...)
+	rest := w.pending
+	for {
+		idx := bytes.IndexAny(rest, "\r\n")
+		if idx < 0 {
+			break
+		}
+		w.parseLine(strings.TrimSpace(string(rest[:idx])))
+		rest = rest[idx+1:]
+	}
+	if len(rest) > maxPendingLine {
+		rest = nil
+	}
+	w.pending = append(w.pending[:0], rest...)
+	return len(p), nil
+}
+
+func (w *progressWriter) parseLine(line string) {
+	if line == "" {
+		return
+	}
+	if w.stats.parseStatsLine(line) {
+		w.hasStats = true
+		return
+	}
+	m := progressPattern.FindStringSubmatch(line)
+	if m == nil {
+		return
+	}
+
+	w.progress.Bytes, _ = strconv.ParseInt(strings.ReplaceAll(m[1], ",", ""), 10, 64)
+	w.progress.Percent, _ = strconv.Atoi(m[2])
+	rate, _ := strconv.ParseFloat(m[3], 64)
+	w.progress.Rate = rate * rateUnits[m[4]]
+	hours, _ := strconv.Atoi(m[5])
+	minutes, _ := strconv.Atoi(m[6])
+	seconds, _ := strconv.Atoi(m[7])
+	w.progress.ETA = hours*3600 + minutes*60 + seconds
+	if m[8] != "" {
+		w.progress.FilesTransferred, _ = strconv.ParseInt(m[8], 10, 64)
+		w.progress.FilesRemaining, _ = strconv.ParseInt(m[9], 10, 64)
+		w.progress.FilesTotal, _ = strconv.ParseInt(m[10], 10, 64)
+	}
+	w.progress.UpdatedAt = time.Now()
+
+	if time.Since(w.lastEmit) >= progressInterval {
+		w.emitLocked()
+	}
+}
+
+func (w *progressWriter) emitLocked() {
+	w.lastEmit = time.Now()
+	if w.out == nil {
+		return
+	}
+	b, err := json.Marshal(w.progress)
+	if err != nil {
+		return
+	}
+	if _, err := w.out.Write(append(b, '\n')); err != nil {
+		global.LOG.Warn("[rsyncmgr] failed to write progress: %v", err)
+	}
+}
+
+// Current 返回最近一次解析到的进度
+func (w *progressWriter) Current() Progress {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.progress
+}
+
+// Finish 写入最终进度并返回统计汇总，成功结束时进度视为 100%
+func (w *progressWriter) Finish(succeeded bool) (TransferStats, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.pending) > 0 {
+		w.parseLine(strings.TrimSpace(string(w.pending)))
+		w.pending = nil
+	}
+	if succeeded {
+		w.progress.Percent = 100
+		w.progress.FilesRemaining = 0
+	}
+	w.progress.Done = true
+	w.progress.UpdatedAt = time.Now()
+	w.emitLocked()
+	return w.stats, w.hasStats
+}
@@ -0,0 +1,49 @@
+package pkg
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// minScheduleInterval 定时执行的最小间隔
+const minScheduleInterval = time.Minute
+
+// ParseSchedule 解析定时配置，支持标准 5 段 cron 表达式、@daily 等描述符、@every 1h 以及 30m 形式的间隔
+func ParseSchedule(spec string) (cron.Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if d, err := time.ParseDuration(spec); err == nil {
+		spec = "@every " + d.String()
+	}
+	sched, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: %v", spec, err)
+	}
+	if every, ok := sched.(cron.ConstantDelaySchedule); ok && every.Delay < minScheduleInterval {
+		return nil, fmt.Errorf("schedule interval must be at least %s", minScheduleInterval)
+	}
+	return sched, nil
+}
+
+// ApplySchedule 设置任务的定时配置并计算下次执行时间，配置未变化时保留原有的下次执行时间
+func ApplySchedule(t *RsyncTask, spec string, now time.Time) error {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		t.Schedule = ""
+		t.NextRunAt = nil
+		return nil
+	}
+	sched, err := ParseSchedule(spec)
+	if err != nil {
+		return err
+	}
+	if spec == t.Schedule && t.NextRunAt != nil {
+		return nil
+	}
+	next := sched.Next(now)
+	t.Schedule = spec
+	t.NextRunAt = &next
+	return nil
+}
@@ -0,0 +1,67 @@
+package pkg
+
+import (
+	"strconv"
+	"strings"
+)
+
+// TransferStats 从 --stats 汇总中解析的传输统计
+type TransferStats struct {
+	FilesTotal       int64 `json:"files_total"`
+	FilesCreated     int64 `json:"files_created"`
+	FilesDeleted     int64 `json:"files_deleted"`
+	FilesTransferred int64 `json:"files_transferred"`
+	TotalSize        int64 `json:"total_size"`
+	TransferredSize  int64 `json:"transferred_size"`
+	BytesSent        int64 `json:"bytes_sent"`
+	BytesReceived    int64 `json:"bytes_received"`
+}
+
+// parseStatsLine 解析汇总中的一行，3.1 之前的版本没有 regular 字样
+func (s *TransferStats) parseStatsLine(line string) bool {
+	var field *int64
+	switch {
+	case strings.HasPrefix(line, "Number of files:"):
+		field = &s.FilesTotal
+	case strings.HasPrefix(line, "Number of created files:"):
+		field = &s.FilesCreated
+	case strings.HasPrefix(line, "Number of deleted files:"):
+		field = &s.FilesDeleted
+	case strings.HasPrefix(line, "Number of regular files transferred:"),
+		strings.HasPrefix(line, "Number of files transferred:"):
+		field = &s.FilesTransferred
+	case strings.HasPrefix(line, "Total file size:"):
+		field = &s.TotalSize
+	case strings.HasPrefix(line, "Total transferred file size:"):
+		field = &s.TransferredSize
+	case strings.HasPrefix(line, "Total bytes sent:"):
+		field = &s.BytesSent
+	case strings.HasPrefix(line, "Total bytes received:"):
+		field = &s.BytesReceived
+	default:
+		return false
+	}
+	n, ok := parseStatsNumber(line)
+	if !ok {
+		return false
+	}
+	*field = n
+	return true
+}
+
+// parseStatsNumber 解析 "label: 1,234 bytes" 形式的数值
+func parseStatsNumber(line string) (int64, bool) {
+	idx := strings.Index(line, ":")
+	if idx < 0 {
+		return 0, false
+	}
+	fields := strings.Fields(line[idx+1:])
+	if len(fields) == 0 {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(strings.ReplaceAll(fields[0], ",", ""), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
@@ -19,6 +19,10 @@ const (
 	StateSucceeded TaskState = "succeeded"
 	StateFailed    TaskState = "failed"
 	StateStopped   TaskState = "stopped"
+	StateSkipped   TaskState = "skipped" // 仅用于运行记录，表示定时触发时上一次执行尚未结束
+
+	TriggerManual   = "manual"
+	TriggerSchedule = "schedule"
 
 	AuthModePassword   AuthMode = "password"
 	AuthModeAnonymous  AuthMode = "anonymous"
@@ -27,23 +31,42 @@ const (
 
 // RsyncTask is the core persisted task model
 type RsyncTask struct {
-	ID            string        `json:"id"`
-	Name          string        `json:"name"`
-	Direction     SyncDirection `json:"direction"`
-	LocalPath     string        `json:"local_path"`
-	RemoteType    RemoteType    `json:"remote_type"`
-	RemoteHost    string        `json:"remote_host"`
-	RemotePort    int           `json:"remote_port"`
-	Username      string        `json:"username"`
-	Password      string        `json:"password,omitempty"`
-	SSHPrivateKey string        `json:"ssh_private_key,omitempty"` // path to key or empty
-	AuthMode      AuthMode      `json:"auth_mode"`                 // 认证模式：密码、匿名、私钥
-	RemotePath    string        `json:"remote_path"`
-	Module        string        `json:"module,omitempty"` // rsync daemon module
-	CreatedAt     time.Time     `json:"created_at"`
-	UpdatedAt     time.Time     `json:"updated_at"`
-	State         TaskState     `json:"state"`
-	LastError     string        `json:"last_error,omitempty"`
-	Attempt       int           `json:"attempt"`
+	ID            string         `json:"id"`
+	Name          string         `json:"name"`
+	Direction     SyncDirection  `json:"direction"`
+	LocalPath     string         `json:"local_path"`
+	RemoteType    RemoteType     `json:"remote_type"`
+	RemoteHost    string         `json:"remote_host"`
+	RemotePort    int            `json:"remote_port"`
+	Username      string         `json:"username"`
+	Password      string         `json:"password,omitempty"`
+	SSHPrivateKey string         `json:"ssh_private_key,omitempty"` // path to key or empty
+	AuthMode      AuthMode       `json:"auth_mode"`                 // 认证模式：密码、匿名、私钥
+	RemotePath    string         `json:"remote_path"`
+	Module        string         `json:"module,omitempty"` // rsync daemon module
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	State         TaskState      `json:"state"`
+	LastError     string         `json:"last_error,omitempty"`
+	Attempt       int            `json:"attempt"`
+	Schedule      string         `json:"schedule,omitempty"`    // cron 表达式或执行间隔，为空表示不定时执行
+	NextRunAt     *time.Time     `json:"next_run_at,omitempty"` // 下次定时执行时间
+	LastStats     *TransferStats `json:"last_stats,omitempty"`  // 最近一次执行的统计汇总
+	SyncOptions
 	// internal runtime fields (not persisted) could be omitted in JSON if desired
 }
+
+// RunRecord 单次执行记录，传输统计来自 rsync --stats 输出
+type RunRecord struct {
+	ID               string    `json:"id"`
+	Trigger          string    `json:"trigger"`
+	State            TaskState `json:"state"`
+	StartedAt        time.Time `json:"started_at"`
+	EndedAt          time.Time `json:"ended_at"`
+	ExitCode         int       `json:"exit_code"`
+	BytesTransferred int64     `json:"bytes_transferred"`
+	FilesChanged     int64     `json:"files_changed"`
+	LogPath          string    `json:"log_path,omitempty"`
+	ProgressPath     string    `json:"progress_path,omitempty"`
+	Error            string    `json:"error,omitempty"`
+}
@@ -0,0 +1,438 @@
+package alert
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sensdata/idb/center/core/conn"
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/db/repo"
+	"github.com/sensdata/idb/center/global"
+	"github.com/sensdata/idb/core/constant"
+	core "github.com/sensdata/idb/core/model"
+	"github.com/sensdata/idb/core/utils"
+)
+
+const (
+	// evaluateInterval 评估告警规则的间隔
+	evaluateInterval = 15 * time.Second
+	// containerCheckInterval 查询容器健康状态的间隔
+	containerCheckInterval = time.Minute
+	// unhealthyContainersCommand 列出健康检查失败的容器，未安装 docker 时视为没有
+	unhealthyContainersCommand = "docker ps --filter health=unhealthy --format '{{.Names}}' 2>/dev/null || true"
+)
+
+var (
+	AlertRuleRepo    = repo.NewAlertRuleRepo()
+	AlertChannelRepo = repo.NewAlertChannelRepo()
+	AlertSilenceRepo = repo.NewAlertSilenceRepo()
+	AlertEventRepo   = repo.NewAlertEventRepo()
+	HostRepo         = repo.NewHostRepo()
+
+	ENGINE IEngine
+)
+
+type IEngine interface {
+	Start() error
+	Stop() error
+}
+
+type Engine struct {
+	done chan struct{}
+
+	// pending 规则及设备首次满足条件的时间，仅由评估协程访问
+	pending map[string]time.Time
+
+	mu         sync.Mutex
+	containers map[uint]*containerCheck
+}
+
+// containerCheck 缓存的容器健康状态，由后台协程刷新
+type containerCheck struct {
+	checkedAt time.Time
+	checking  bool
+	valid     bool
+	unhealthy []string
+}
+
+func NewEngine() IEngine {
+	return &Engine{
+		done:       make(chan struct{}),
+		pending:    make(map[string]time.Time),
+		containers: make(map[uint]*containerCheck),
+	}
+}
+
+func (e *Engine) Start() error {
+	global.LOG.Info("Alert engine starting")
+	go e.run()
+	return nil
+}
+
+func (e *Engine) Stop() error {
+	close(e.done)
+	return nil
+}
+
+func (e *Engine) run() {
+	ticker := time.NewTicker(evaluateInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.done:
+			global.LOG.Info("Stop alert engine")
+			return
+		case now := <-ticker.C:
+			e.evaluate(now)
+		}
+	}
+}
+
+// sample 一次评估的结果，ok 为 false 表示暂无数据，保持原状态
+type sample struct {
+	ok       bool
+	value    float64
+	breached bool
+	message  string
+}
+
+// evaluate 评估全部启用的规则，同一规则及设备只保留一条 firing 记录
+func (e *Engine) evaluate(now time.Time) {
+	rules, err := AlertRuleRepo.GetList(AlertRuleRepo.WithByEnabled())
+	if err != nil {
+		global.LOG.Error("Failed to load alert rules: %v", err)
+		return
+	}
+	hosts, err := HostRepo.GetList()
+	if err != nil {
+		global.LOG.Error("Failed to load hosts: %v", err)
+		return
+	}
+	silences, err := AlertSilenceRepo.GetList(AlertSilenceRepo.WithByActive(now))
+	if err != nil {
+		global.LOG.Error("Failed to load alert silences: %v", err)
+		return
+	}
+	events, err := AlertEventRepo.GetList(AlertEventRepo.WithByState(constant.AlertStateFiring))
+	if err != nil {
+		global.LOG.Error("Failed to load alert events: %v", err)
+		return
+	}
+	firing := make(map[string]model.AlertEvent, len(events))
+	for _, event := range events {
+		firing[fingerprint(event.RuleID, event.HostID)] = event
+	}
+
+	seen := make(map[string]bool)
+	for _, rule := range rules {
+		targets := utils.SplitIDs(rule.HostIDs)
+		for _, host := range hosts {
+			if len(targets) > 0 && !containsID(targets, host.ID) {
+				continue
+			}
+			fp := fingerprint(rule.ID, host.ID)
+			seen[fp] = true
+
+			s := e.sample(rule, host, now)
+			if !s.ok {
+				continue
+			}
+			event, isFiring := firing[fp]
+			silenced := isSilenced(silences, rule.ID, host.ID)
+
+			if !s.breached {
+				delete(e.pending, fp)
+				if isFiring {
+					e.resolve(rule, event, now, silenced, true)
+				}
+				continue
+			}
+
+			since, ok := e.pending[fp]
+			if !ok {
+				since = now
+				e.pending[fp] = now
+			}
+			if isFiring {
+				e.refire(rule, event, s, now, silenced)
+				continue
+			}
+			if now.Sub(since) >= time.Duration(rule.Duration)*time.Second {
+				e.fire(rule, host, s, since, now, silenced)
+			}
+		}
+	}
+
+	// 规则被禁用、删除或设备不再匹配时，直接恢复且不通知
+	for fp := range e.pending {
+		if !seen[fp] {
+			delete(e.pending, fp)
+		}
+	}
+	for fp, event := range firing {
+		if !seen[fp] {
+			e.resolve(model.AlertRule{}, event, now, true, false)
+		}
+	}
+}
+
+// sample 读取设备当前指标，设备离线时仅 agent_offline 规则有数据
+func (e *Engine) sample(rule model.AlertRule, host model.Host, now time.Time) sample {
+	status := global.GetHostStatus(host.ID)
+	online := status != nil && status.Connected == "online"
+
+	if rule.Metric == constant.AlertMetricAgentOffline {
+		// 从未上报过版本号的设备尚未安装 agent
+		if host.AgentVersion == "" {
+			return sample{}
+		}
+		if online {
+			return sample{ok: true}
+		}
+		message := "agent disconnected"
+		if status != nil && status.LastHeartbeat > 0 {
+			message = fmt.Sprintf("agent disconnected, last heartbeat at %s", time.Unix(status.LastHeartbeat, 0).Format(time.RFC3339))
+		}
+		return sample{ok: true, value: 1, breached: true, message: message}
+	}
+	if !online {
+		return sample{}
+	}
+
+	var value float64
+	message := ""
+	switch rule.Metric {
+	case constant.AlertMetricCpu:
+		value = status.Cpu
+	case constant.AlertMetricMemory:
+		value = status.Memory
+	case constant.AlertMetricDisk:
+		value = status.Disk
+	case constant.AlertMetricRx:
+		value = status.Rx
+	case constant.AlertMetricTx:
+		value = status.Tx
+	case constant.AlertMetricContainerUnhealthy:
+		unhealthy, ok := e.unhealthyContainers(host, now)
+		if !ok {
+			return sample{}
+		}
+		value = float64(len(unhealthy))
+		if len(unhealthy) > 0 {
+			message = "unhealthy containers: " + strings.Join(unhealthy, ", ")
+		}
+	default:
+		return sample{}
+	}
+
+	breached := compare(rule.Operator, value, rule.Threshold)
+	if breached && message == "" {
+		message = fmt.Sprintf("%s %.2f %s %.2f", rule.Metric, value, operator(rule.Operator), rule.Threshold)
+	}
+	return sample{ok: true, value: value, breached: breached, message: message}
+}
+
+// unhealthyContainers 返回缓存的结果，过期时在后台刷新，避免阻塞评估
+func (e *Engine) unhealthyContainers(host model.Host, now time.Time) ([]string, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	check := e.containers[host.ID]
+	if check == nil {
+		check = &containerCheck{}
+		e.containers[host.ID] = check
+	}
+	if !check.checking && now.Sub(check.checkedAt) >= containerCheckInterval {
+		check.checking = true
+		go e.refreshContainers(host.ID)
+	}
+	return check.unhealthy, check.valid
+}
+
+func (e *Engine) refreshContainers(hostID uint) {
+	output, err := conn.CENTER.ExecuteSystemCommand(core.Command{HostID: hostID, Command: unhealthyContainersCommand})
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	check := e.containers[hostID]
+	check.checking = false
+	check.checkedAt = time.Now()
+	if err != nil || output == "error" {
+		global.LOG.Warn("Failed to check containers of host %d: %v", hostID, err)
+		check.valid = false
+		return
+	}
+	check.valid = true
+	check.unhealthy = nil
+	for _, name := range strings.Split(output, "\n") {
+		if name = strings.TrimSpace(name); name != "" {
+			check.unhealthy = append(check.unhealthy, name)
+		}
+	}
+}
+
+func (e *Engine) fire(rule model.AlertRule, host model.Host, s sample, since time.Time, now time.Time, silenced bool) {
+	event := model.AlertEvent{
+		RuleID:   rule.ID,
+		RuleName: rule.Name,
+		HostID:   host.ID,
+		HostName: host.Name,
+		Metric:   rule.Metric,
+		Severity: rule.Severity,
+		Value:    s.value,
+		Message:  truncate(s.message, 1024),
+		State:    constant.AlertStateFiring,
+		Silenced: silenced,
+		StartsAt: since,
+	}
+	if !silenced {
+		event.LastNotifiedAt = &now
+	}
+	if err := AlertEventRepo.Create(&event); err != nil {
+		global.LOG.Error("Failed to create alert event for rule %d host %d: %v", rule.ID, host.ID, err)
+		return
+	}
+	global.LOG.Warn("Alert firing: rule %s host %s: %s", rule.Name, host.Name, s.message)
+	if !silenced {
+		e.notify(rule, event)
+	}
+}
+
+// refire 持续告警时更新当前值，静默结束后补发通知，并按间隔重复通知
+func (e *Engine) refire(rule model.AlertRule, event model.AlertEvent, s sample, now time.Time, silenced bool) {
+	notify := false
+	if !silenced {
+		if event.LastNotifiedAt == nil {
+			notify = true
+		} else if rule.RepeatInterval > 0 && now.Sub(*event.LastNotifiedAt) >= time.Duration(rule.RepeatInterval)*time.Second {
+			notify = true
+		}
+	}
+	if !notify && event.Silenced == silenced {
+		return
+	}
+
+	event.Value = s.value
+	event.Message = truncate(s.message, 1024)
+	event.Silenced = silenced
+	vars := map[string]interface{}{
+		"value":    event.Value,
+		"message":  event.Message,
+		"silenced": silenced,
+	}
+	if notify {
+		event.LastNotifiedAt = &now
+		vars["last_notified_at"] = &now
+	}
+	if err := AlertEventRepo.Update(event.ID, vars); err != nil {
+		global.LOG.Error("Failed to update alert event %d: %v", event.ID, err)
+		return
+	}
+	if notify {
+		e.notify(rule, event)
+	}
+}
+
+// resolve 已通知过的告警恢复时发送恢复通知
+func (e *Engine) resolve(rule model.AlertRule, event model.AlertEvent, now time.Time, silenced bool, notify bool) {
+	event.State = constant.AlertStateResolved
+	event.ResolvedAt = &now
+	if err := AlertEventRepo.Update(event.ID, map[string]interface{}{
+		"state":       event.State,
+		"resolved_at": &now,
+	}); err != nil {
+		global.LOG.Error("Failed to resolve alert event %d: %v", event.ID, err)
+		return
+	}
+	global.LOG.Info("Alert resolved: rule %s host %s", event.RuleName, event.HostName)
+	if notify && !silenced && event.LastNotifiedAt != nil {
+		e.notify(rule, event)
+	}
+}
+
+// notify 在后台向规则关联的全部启用渠道发送通知
+func (e *Engine) notify(rule model.AlertRule, event model.AlertEvent) {
+	ids := utils.SplitIDs(rule.ChannelIDs)
+	if len(ids) == 0 {
+		return
+	}
+	channels, err := AlertChannelRepo.GetList(AlertChannelRepo.WithByIDs(ids))
+	if err != nil {
+		global.LOG.Error("Failed to load alert channels: %v", err)
+		return
+	}
+
+	n := core.AlertNotification{
+		State:     event.State,
+		RuleName:  event.RuleName,
+		Severity:  event.Severity,
+		HostID:    event.HostID,
+		HostName:  event.HostName,
+		Metric:    event.Metric,
+		Value:     event.Value,
+		Threshold: rule.Threshold,
+		Message:   event.Message,
+		StartsAt:  event.StartsAt,
+		EndsAt:    event.ResolvedAt,
+	}
+	for _, channel := range channels {
+		if !channel.Enabled {
+			continue
+		}
+		go func(channel model.AlertChannel) {
+			var config core.AlertChannelConfig
+			if err := utils.FromJSONString(channel.Config, &config); err != nil {
+				global.LOG.Error("Invalid config of alert channel %d: %v", channel.ID, err)
+				return
+			}
+			if err := Send(channel.Type, config, n); err != nil {
+				global.LOG.Error("Failed to send alert to channel %s: %v", channel.Name, err)
+			}
+		}(channel)
+	}
+}
+
+func fingerprint(ruleID uint, hostID uint) string {
+	return fmt.Sprintf("%d:%d", ruleID, hostID)
+}
+
+func isSilenced(silences []model.AlertSilence, ruleID uint, hostID uint) bool {
+	for _, s := range silences {
+		if (s.RuleID == 0 || s.RuleID == ruleID) && (s.HostID == 0 || s.HostID == hostID) {
+			return true
+		}
+	}
+	return false
+}
+
+// operator 未设置时默认为 >
+func operator(op string) string {
+	if op == "" {
+		return ">"
+	}
+	return op
+}
+
+func compare(op string, value float64, threshold float64) bool {
+	switch operator(op) {
+	case ">=":
+		return value >= threshold
+	case "<":
+		return value < threshold
+	case "<=":
+		return value <= threshold
+	default:
+		return value > threshold
+	}
+}
+
+func containsID(ids []uint, id uint) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,288 @@
+package alert
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/sensdata/idb/core/constant"
+	core "github.com/sensdata/idb/core/model"
+)
+
+// notifyTimeout 单次发送通知的超时时间
+const notifyTimeout = 10 * time.Second
+
+// smtpRootCAs 校验 SMTP 服务器证书的根证书，nil 时使用系统根证书
+var smtpRootCAs *x509.CertPool
+
+// Send 通过指定类型的渠道发送通知
+func Send(channelType string, config core.AlertChannelConfig, n core.AlertNotification) error {
+	switch channelType {
+	case constant.AlertChannelEmail:
+		return sendEmail(config, n)
+	case constant.AlertChannelWebhook:
+		return postJSON(config.URL, config.Headers, n, nil)
+	case constant.AlertChannelSlack:
+		return postJSON(config.URL, nil, map[string]string{"text": notificationTitle(n) + "\n" + notificationText(n)}, nil)
+	case constant.AlertChannelDingTalk:
+		return sendDingTalk(config, n)
+	case constant.AlertChannelFeishu:
+		return sendFeishu(config, n)
+	case constant.AlertChannelWeCom:
+		body := map[string]interface{}{
+			"msgtype":  "markdown",
+			"markdown": map[string]string{"content": "**" + notificationTitle(n) + "**\n" + notificationText(n)},
+		}
+		return postJSON(config.URL, nil, body, checkRobotResponse)
+	default:
+		return fmt.Errorf("unsupported channel type %s", channelType)
+	}
+}
+
+// ValidateConfig 校验渠道配置是否完整
+func ValidateConfig(channelType string, config core.AlertChannelConfig) error {
+	if channelType != constant.AlertChannelEmail {
+		u, err := url.Parse(config.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid url %q", config.URL)
+		}
+		return nil
+	}
+
+	if config.Host == "" {
+		return fmt.Errorf("smtp host is required")
+	}
+	switch config.Encryption {
+	case "", "none", "starttls", "tls":
+	default:
+		return fmt.Errorf("invalid encryption %q", config.Encryption)
+	}
+	if _, err := mail.ParseAddress(config.From); err != nil {
+		return fmt.Errorf("invalid from address: %v", err)
+	}
+	if len(config.To) == 0 {
+		return fmt.Errorf("at least one recipient is required")
+	}
+	for _, to := range config.To {
+		if _, err := mail.ParseAddress(to); err != nil {
+			return fmt.Errorf("invalid recipient %q: %v", to, err)
+		}
+	}
+	return nil
+}
+
+func notificationTitle(n core.AlertNotification) string {
+	return fmt.Sprintf("[%s] %s on %s", strings.ToUpper(n.State), n.RuleName, n.HostName)
+}
+
+func notificationText(n core.AlertNotification) string {
+	lines := []string{
+		"Severity: " + n.Severity,
+		fmt.Sprintf("Host: %s (%d)", n.HostName, n.HostID),
+		"Metric: " + n.Metric,
+		fmt.Sprintf("Value: %.2f", n.Value),
+		fmt.Sprintf("Threshold: %.2f", n.Threshold),
+		"Started: " + n.StartsAt.Format(time.RFC3339),
+	}
+	if n.EndsAt != nil {
+		lines = append(lines, "Resolved: "+n.EndsAt.Format(time.RFC3339))
+	}
+	if n.Message != "" {
+		lines = append(lines, n.Message)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// postJSON 以 JSON 发送请求，check 不为空时用于校验机器人接口返回的业务错误码
+func postJSON(target string, headers map[string]string, body interface{}, check func([]byte) error) error {
+	resp, err := resty.New().
+		SetTimeout(notifyTimeout).
+		R().
+		SetHeaders(headers).
+		SetHeader("Content-Type", "application/json").
+		SetBody(body).
+		Post(target)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("%s: %s", resp.Status(), truncate(resp.String(), 256))
+	}
+	if check != nil {
+		return check(resp.Body())
+	}
+	return nil
+}
+
+// robotResponse 钉钉、企业微信返回 errcode，飞书返回 code
+type robotResponse struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+	Code    int    `json:"code"`
+	Msg     string `json:"msg"`
+}
+
+func checkRobotResponse(body []byte) error {
+	var resp robotResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return fmt.Errorf("invalid response: %s", truncate(string(body), 256))
+	}
+	if resp.ErrCode != 0 {
+		return fmt.Errorf("errcode %d: %s", resp.ErrCode, resp.ErrMsg)
+	}
+	if resp.Code != 0 {
+		return fmt.Errorf("code %d: %s", resp.Code, resp.Msg)
+	}
+	return nil
+}
+
+// sendDingTalk 配置了加签密钥时，在地址上附加 timestamp 及 sign
+func sendDingTalk(config core.AlertChannelConfig, n core.AlertNotification) error {
+	target := config.URL
+	if config.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
+		mac := hmac.New(sha256.New, []byte(config.Secret))
+		mac.Write([]byte(timestamp + "\n" + config.Secret))
+		sign := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+		u, err := url.Parse(target)
+		if err != nil {
+			return err
+		}
+		query := u.Query()
+		query.Set("timestamp", timestamp)
+		query.Set("sign", sign)
+		u.RawQuery = query.Encode()
+		target = u.String()
+	}
+
+	title := notificationTitle(n)
+	body := map[string]interface{}{
+		"msgtype": "markdown",
+		"markdown": map[string]string{
+			"title": title,
+			"text":  "### " + title + "\n\n" + strings.ReplaceAll(notificationText(n), "\n", "\n\n"),
+		},
+	}
+	return postJSON(target, nil, body, checkRobotResponse)
+}
+
+// sendFeishu 配置了加签密钥时，在消息体中附加 timestamp 及 sign
+func sendFeishu(config core.AlertChannelConfig, n core.AlertNotification) error {
+	body := map[string]interface{}{
+		"msg_type": "text",
+		"content":  map[string]string{"text": notificationTitle(n) + "\n" + notificationText(n)},
+	}
+	if config.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		mac := hmac.New(sha256.New, []byte(timestamp+"\n"+config.Secret))
+		body["timestamp"] = timestamp
+		body["sign"] = base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	}
+	return postJSON(config.URL, nil, body, checkRobotResponse)
+}
+
+// sendEmail 以纯文本邮件发送，tls 为直接建立 TLS 连接，starttls 为连接后升级
+func sendEmail(config core.AlertChannelConfig, n core.AlertNotification) error {
+	if err := ValidateConfig(constant.AlertChannelEmail, config); err != nil {
+		return err
+	}
+	port := config.Port
+	if port == 0 {
+		switch config.Encryption {
+		case "tls":
+			port = 465
+		case "starttls":
+			port = 587
+		default:
+			port = 25
+		}
+	}
+	addr := net.JoinHostPort(config.Host, strconv.Itoa(port))
+	tlsConfig := &tls.Config{ServerName: config.Host, RootCAs: smtpRootCAs}
+
+	dialer := &net.Dialer{Timeout: notifyTimeout}
+	var conn net.Conn
+	var err error
+	if config.Encryption == "tls" {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, tlsConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	_ = conn.SetDeadline(time.Now().Add(notifyTimeout))
+
+	client, err := smtp.NewClient(conn, config.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if config.Encryption == "starttls" {
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return err
+		}
+	}
+	if config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", config.Username, config.Password, config.Host)); err != nil {
+			return err
+		}
+	}
+
+	from, _ := mail.ParseAddress(config.From)
+	if err := client.Mail(from.Address); err != nil {
+		return err
+	}
+	for _, to := range config.To {
+		addr, _ := mail.ParseAddress(to)
+		if err := client.Rcpt(addr.Address); err != nil {
+			return err
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	headers := []string{
+		"From: " + from.String(),
+		"To: " + strings.Join(config.To, ", "),
+		"Subject: " + mime.QEncoding.Encode("utf-8", notificationTitle(n)),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+	}
+	message := strings.Join(headers, "\r\n") + "\r\n\r\n" + strings.ReplaceAll(notificationText(n), "\n", "\r\n") + "\r\n"
+	if _, err := w.Write([]byte(message)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// truncate 按字符截断，避免截断多字节字符
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "..."
+}
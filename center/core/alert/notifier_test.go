@@ -0,0 +1,419 @@
+package alert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sensdata/idb/core/constant"
+	core "github.com/sensdata/idb/core/model"
+)
+
+func testNotification() core.AlertNotification {
+	return core.AlertNotification{
+		State:     "firing",
+		RuleName:  "CPU 过高",
+		Severity:  "critical",
+		HostID:    3,
+		HostName:  "web-1",
+		Metric:    "cpu",
+		Value:     95.5,
+		Threshold: 90,
+		Message:   "CPU 使用率持续超过阈值",
+		StartsAt:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+// capturedRequest 桩服务收到的请求
+type capturedRequest struct {
+	query  map[string][]string
+	header http.Header
+	body   map[string]interface{}
+}
+
+func TestSendHTTPChannels(t *testing.T) {
+	n := testNotification()
+	title := notificationTitle(n)
+	text := notificationText(n)
+
+	tests := []struct {
+		name        string
+		channelType string
+		config      core.AlertChannelConfig
+		check       func(t *testing.T, req capturedRequest)
+	}{
+		{
+			name:        "webhook",
+			channelType: constant.AlertChannelWebhook,
+			config:      core.AlertChannelConfig{Headers: map[string]string{"Authorization": "Bearer abc"}},
+			check: func(t *testing.T, req capturedRequest) {
+				if req.header.Get("Authorization") != "Bearer abc" {
+					t.Errorf("authorization = %q", req.header.Get("Authorization"))
+				}
+				if req.body["rule_name"] != n.RuleName || req.body["state"] != n.State || req.body["host_name"] != n.HostName {
+					t.Errorf("body = %v", req.body)
+				}
+			},
+		},
+		{
+			name:        "slack",
+			channelType: constant.AlertChannelSlack,
+			check: func(t *testing.T, req capturedRequest) {
+				if req.body["text"] != title+"\n"+text {
+					t.Errorf("text = %v", req.body["text"])
+				}
+			},
+		},
+		{
+			name:        "dingtalk",
+			channelType: constant.AlertChannelDingTalk,
+			check: func(t *testing.T, req capturedRequest) {
+				if _, ok := req.query["sign"]; ok {
+					t.Error("unexpected sign without secret")
+				}
+				markdown, _ := req.body["markdown"].(map[string]interface{})
+				if req.body["msgtype"] != "markdown" || markdown["title"] != title ||
+					!strings.HasPrefix(markdown["text"].(string), "### "+title) {
+					t.Errorf("body = %v", req.body)
+				}
+			},
+		},
+		{
+			name:        "dingtalk signed",
+			channelType: constant.AlertChannelDingTalk,
+			config:      core.AlertChannelConfig{Secret: "SEC123"},
+			check: func(t *testing.T, req capturedRequest) {
+				timestamp := first(req.query["timestamp"])
+				sign := first(req.query["sign"])
+				if timestamp == "" || sign == "" {
+					t.Fatalf("query = %v, want timestamp and sign", req.query)
+				}
+				if _, err := base64.StdEncoding.DecodeString(sign); err != nil {
+					t.Errorf("sign %q is not base64", sign)
+				}
+				if first(req.query["access_token"]) != "tok" {
+					t.Errorf("access_token lost, query = %v", req.query)
+				}
+			},
+		},
+		{
+			name:        "feishu signed",
+			channelType: constant.AlertChannelFeishu,
+			config:      core.AlertChannelConfig{Secret: "SEC123"},
+			check: func(t *testing.T, req capturedRequest) {
+				content, _ := req.body["content"].(map[string]interface{})
+				if req.body["msg_type"] != "text" || content["text"] != title+"\n"+text {
+					t.Errorf("body = %v", req.body)
+				}
+				if req.body["timestamp"] == nil || req.body["sign"] == nil {
+					t.Errorf("body = %v, want timestamp and sign", req.body)
+				}
+			},
+		},
+		{
+			name:        "wecom",
+			channelType: constant.AlertChannelWeCom,
+			check: func(t *testing.T, req capturedRequest) {
+				markdown, _ := req.body["markdown"].(map[string]interface{})
+				if req.body["msgtype"] != "markdown" || markdown["content"] != "**"+title+"**\n"+text {
+					t.Errorf("body = %v", req.body)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got capturedRequest
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got.query = r.URL.Query()
+				got.header = r.Header
+				if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+					t.Errorf("content type = %q", ct)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&got.body); err != nil {
+					t.Errorf("decode body: %v", err)
+				}
+				w.Write([]byte(`{"errcode":0,"code":0}`))
+			}))
+			defer server.Close()
+
+			tt.config.URL = server.URL + "/send?access_token=tok"
+			if err := Send(tt.channelType, tt.config, n); err != nil {
+				t.Fatal(err)
+			}
+			tt.check(t, got)
+		})
+	}
+}
+
+func TestSendHTTPErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		channelType string
+		status      int
+		response    string
+		wantErr     string
+	}{
+		{"webhook server error", constant.AlertChannelWebhook, http.StatusInternalServerError, "boom", "500"},
+		{"slack forbidden", constant.AlertChannelSlack, http.StatusForbidden, "invalid_token", "invalid_token"},
+		{"dingtalk errcode", constant.AlertChannelDingTalk, http.StatusOK, `{"errcode":310000,"errmsg":"sign not match"}`, "sign not match"},
+		{"feishu code", constant.AlertChannelFeishu, http.StatusOK, `{"code":19021,"msg":"sign match fail"}`, "sign match fail"},
+		{"wecom invalid response", constant.AlertChannelWeCom, http.StatusOK, "not json", "invalid response"},
+		{"wecom bad gateway", constant.AlertChannelWeCom, http.StatusBadGateway, "", "502"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			err := Send(tt.channelType, core.AlertChannelConfig{URL: server.URL}, testNotification())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	s := strings.Repeat("告警", 200)
+	got := truncate(s, 255)
+	if !utf8.ValidString(got) {
+		t.Fatalf("truncate produced invalid utf-8: %q", got)
+	}
+	if want := string([]rune(s)[:255]) + "..."; got != want {
+		t.Errorf("truncate = %q, want %q", got, want)
+	}
+	if got := truncate("short", 256); got != "short" {
+		t.Errorf("truncate(short) = %q", got)
+	}
+}
+
+// smtpMessage 桩服务收到的邮件
+type smtpMessage struct {
+	tls  bool
+	auth string
+	from string
+	to   []string
+	data string
+}
+
+// smtpStub 进程内的最简 SMTP 服务，支持 STARTTLS、直接 TLS 及 AUTH PLAIN
+type smtpStub struct {
+	listener net.Listener
+	tlsConf  *tls.Config
+	implicit bool
+
+	mu       sync.Mutex
+	messages []smtpMessage
+}
+
+func newSMTPStub(t *testing.T, cert tls.Certificate, implicit bool) *smtpStub {
+	tlsConf := &tls.Config{Certificates: []tls.Certificate{cert}}
+	var l net.Listener
+	var err error
+	if implicit {
+		l, err = tls.Listen("tcp", "127.0.0.1:0", tlsConf)
+	} else {
+		l, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &smtpStub{listener: l, tlsConf: tlsConf, implicit: implicit}
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *smtpStub) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpStub) received() []smtpMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]smtpMessage(nil), s.messages...)
+}
+
+func (s *smtpStub) serve(conn net.Conn) {
+	defer func() { conn.Close() }()
+	msg := smtpMessage{tls: s.implicit}
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 stub ESMTP")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(cmd) {
+		case "EHLO", "HELO":
+			if msg.tls {
+				tp.PrintfLine("250-stub\r\n250 AUTH PLAIN")
+			} else {
+				tp.PrintfLine("250-stub\r\n250-STARTTLS\r\n250 AUTH PLAIN")
+			}
+		case "STARTTLS":
+			tp.PrintfLine("220 ready")
+			tlsConn := tls.Server(conn, s.tlsConf)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			tp = textproto.NewConn(conn)
+			msg.tls = true
+		case "AUTH":
+			msg.auth = arg
+			tp.PrintfLine("235 ok")
+		case "MAIL":
+			msg.from = arg
+			tp.PrintfLine("250 ok")
+		case "RCPT":
+			msg.to = append(msg.to, arg)
+			tp.PrintfLine("250 ok")
+		case "DATA":
+			tp.PrintfLine("354 go ahead")
+			data, err := io.ReadAll(tp.DotReader())
+			if err != nil {
+				return
+			}
+			msg.data = string(data)
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			tp.PrintfLine("250 queued")
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			return
+		default:
+			tp.PrintfLine("502 unsupported")
+		}
+	}
+}
+
+// selfSignedCert 生成 127.0.0.1 的自签名证书，并将其设为 SMTP 的受信根证书
+func selfSignedCert(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(leaf)
+	smtpRootCAs = pool
+	t.Cleanup(func() { smtpRootCAs = nil })
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+func TestSendEmail(t *testing.T) {
+	cert := selfSignedCert(t)
+	n := testNotification()
+
+	for _, encryption := range []string{"none", "starttls", "tls"} {
+		t.Run(encryption, func(t *testing.T) {
+			stub := newSMTPStub(t, cert, encryption == "tls")
+			config := core.AlertChannelConfig{
+				Host:       "127.0.0.1",
+				Port:       stub.port(),
+				Username:   "alert",
+				Password:   "p@ss",
+				From:       "iDB <idb@example.com>",
+				To:         []string{"ops@example.com", "dev@example.com"},
+				Encryption: encryption,
+			}
+			if err := Send(constant.AlertChannelEmail, config, n); err != nil {
+				t.Fatal(err)
+			}
+
+			messages := stub.received()
+			if len(messages) != 1 {
+				t.Fatalf("received %d messages, want 1", len(messages))
+			}
+			msg := messages[0]
+			if msg.tls != (encryption != "none") {
+				t.Errorf("tls = %v for encryption %s", msg.tls, encryption)
+			}
+			wantAuth := "PLAIN " + base64.StdEncoding.EncodeToString([]byte("\x00alert\x00p@ss"))
+			if msg.auth != wantAuth {
+				t.Errorf("auth = %q, want %q", msg.auth, wantAuth)
+			}
+			if msg.from != "FROM:<idb@example.com>" || len(msg.to) != 2 || msg.to[1] != "TO:<dev@example.com>" {
+				t.Errorf("envelope from = %q, to = %v", msg.from, msg.to)
+			}
+			if !strings.Contains(msg.data, "Subject: =?utf-8?q?") || !strings.Contains(msg.data, "Metric: cpu\n") ||
+				!strings.Contains(msg.data, n.Message) {
+				t.Errorf("data = %q", msg.data)
+			}
+		})
+	}
+}
+
+func TestSendEmailUntrustedCert(t *testing.T) {
+	cert := selfSignedCert(t)
+	stub := newSMTPStub(t, cert, false)
+	smtpRootCAs = x509.NewCertPool()
+
+	config := core.AlertChannelConfig{
+		Host:       "127.0.0.1",
+		Port:       stub.port(),
+		From:       "idb@example.com",
+		To:         []string{"ops@example.com"},
+		Encryption: "starttls",
+	}
+	if err := Send(constant.AlertChannelEmail, config, testNotification()); err == nil {
+		t.Fatal("expected certificate verification error")
+	}
+	if len(stub.received()) != 0 {
+		t.Error("message sent over untrusted connection")
+	}
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,101 @@
+package entry
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/core/constant"
+	"github.com/sensdata/idb/core/model"
+)
+
+// @Tags User
+// @Summary list access tokens
+// @Description 获取访问令牌列表，管理员可通过 user_id 查看其他用户的令牌
+// @Accept json
+// @Produce json
+// @Param user_id query uint false "User ID"
+// @Success 200 {array} model.AccessTokenInfo
+// @Router /users/tokens [get]
+func (b *BaseApi) ListAccessToken(c *gin.Context) {
+	var req model.QueryAccessToken
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	claims, err := GetClaims(c)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeAuth, constant.ErrAuth.Error(), err)
+		return
+	}
+	userID := claims.ID
+	if req.UserID != 0 && req.UserID != claims.ID {
+		if !userService.IsAdmin(claims.ID) {
+			ErrorWithDetail(c, constant.CodeErrForbidden, constant.ErrAuth.Error(), constant.ErrAuth)
+			return
+		}
+		userID = req.UserID
+	}
+
+	result, err := accessTokenService.List(userID)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags User
+// @Summary create access token
+// @Description 创建访问令牌，令牌明文仅在本次返回
+// @Accept json
+// @Produce json
+// @Param request body model.CreateAccessToken true "request"
+// @Success 200 {object} model.AccessTokenCreated
+// @Router /users/tokens [post]
+func (b *BaseApi) CreateAccessToken(c *gin.Context) {
+	var req model.CreateAccessToken
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	claims, err := GetClaims(c)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeAuth, constant.ErrAuth.Error(), err)
+		return
+	}
+
+	result, err := accessTokenService.Create(claims.ID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags User
+// @Summary revoke access token
+// @Description 吊销访问令牌，管理员可吊销任意用户的令牌
+// @Accept json
+// @Produce json
+// @Param id query uint true "Token ID"
+// @Success 200
+// @Router /users/tokens [delete]
+func (b *BaseApi) DeleteAccessToken(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Query("id"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid token id", err)
+		return
+	}
+
+	claims, err := GetClaims(c)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeAuth, constant.ErrAuth.Error(), err)
+		return
+	}
+
+	if err := accessTokenService.Delete(claims.ID, uint(id), userService.IsAdmin(claims.ID)); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
@@ -2,6 +2,7 @@ package entry
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/center/core/api/middleware"
 	"github.com/sensdata/idb/center/global"
 	"github.com/sensdata/idb/core/constant"
 	"github.com/sensdata/idb/core/logstream/pkg/types"
@@ -13,6 +14,10 @@ func (b *BaseApi) SendAction(c *gin.Context) {
 	if err := CheckBindAndValidate(&req, c); err != nil {
 		return
 	}
+	if !middleware.HostAllowed(c, req.HostID) {
+		ErrorWithDetail(c, constant.CodeErrForbidden, "Host out of scope", nil)
+		return
+	}
 
 	result, err := actionService.SendAction(req)
 	if err != nil {
@@ -27,6 +32,10 @@ func (b *BaseApi) CreateLogStreamTask(c *gin.Context) {
 	if err := CheckBindAndValidate(&req, c); err != nil {
 		return
 	}
+	if !middleware.HostAllowed(c, req.HostID) {
+		ErrorWithDetail(c, constant.CodeErrForbidden, "Host out of scope", nil)
+		return
+	}
 
 	// 生成任务
 	metadata := map[string]interface{}{
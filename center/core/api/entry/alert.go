@@ -0,0 +1,284 @@
+package entry
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/core/constant"
+	"github.com/sensdata/idb/core/model"
+)
+
+// @Tags Alert
+// @Summary list alert rules
+// @Description 获取告警规则列表
+// @Accept json
+// @Produce json
+// @Success 200 {array} model.AlertRuleInfo
+// @Router /alerts/rules [get]
+func (b *BaseApi) ListAlertRule(c *gin.Context) {
+	result, err := alertService.ListRule()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Alert
+// @Summary create alert rule
+// @Description 创建告警规则
+// @Accept json
+// @Produce json
+// @Param request body model.CreateAlertRule true "request"
+// @Success 200 {object} model.AlertRuleInfo
+// @Router /alerts/rules [post]
+func (b *BaseApi) CreateAlertRule(c *gin.Context) {
+	var req model.CreateAlertRule
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	result, err := alertService.CreateRule(req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Alert
+// @Summary update alert rule
+// @Description 修改告警规则
+// @Accept json
+// @Produce json
+// @Param request body model.UpdateAlertRule true "request"
+// @Success 200
+// @Router /alerts/rules [put]
+func (b *BaseApi) UpdateAlertRule(c *gin.Context) {
+	var req model.UpdateAlertRule
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	if err := alertService.UpdateRule(req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
+// @Tags Alert
+// @Summary delete alert rule
+// @Description 删除告警规则
+// @Accept json
+// @Produce json
+// @Param id query uint true "Rule ID"
+// @Success 200
+// @Router /alerts/rules [delete]
+func (b *BaseApi) DeleteAlertRule(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Query("id"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid rule id", err)
+		return
+	}
+
+	if err := alertService.DeleteRule(uint(id)); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
+// @Tags Alert
+// @Summary list alert channels
+// @Description 获取通知渠道列表，不返回密钥及密码
+// @Accept json
+// @Produce json
+// @Success 200 {array} model.AlertChannelInfo
+// @Router /alerts/channels [get]
+func (b *BaseApi) ListAlertChannel(c *gin.Context) {
+	result, err := alertService.ListChannel()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Alert
+// @Summary create alert channel
+// @Description 创建通知渠道
+// @Accept json
+// @Produce json
+// @Param request body model.CreateAlertChannel true "request"
+// @Success 200 {object} model.AlertChannelInfo
+// @Router /alerts/channels [post]
+func (b *BaseApi) CreateAlertChannel(c *gin.Context) {
+	var req model.CreateAlertChannel
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	result, err := alertService.CreateChannel(req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Alert
+// @Summary update alert channel
+// @Description 修改通知渠道，密钥及密码为空时保留原值
+// @Accept json
+// @Produce json
+// @Param request body model.UpdateAlertChannel true "request"
+// @Success 200
+// @Router /alerts/channels [put]
+func (b *BaseApi) UpdateAlertChannel(c *gin.Context) {
+	var req model.UpdateAlertChannel
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	if err := alertService.UpdateChannel(req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
+// @Tags Alert
+// @Summary delete alert channel
+// @Description 删除通知渠道
+// @Accept json
+// @Produce json
+// @Param id query uint true "Channel ID"
+// @Success 200
+// @Router /alerts/channels [delete]
+func (b *BaseApi) DeleteAlertChannel(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Query("id"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid channel id", err)
+		return
+	}
+
+	if err := alertService.DeleteChannel(uint(id)); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
+// @Tags Alert
+// @Summary test alert channel
+// @Description 发送测试通知
+// @Accept json
+// @Produce json
+// @Param request body model.TestAlertChannel true "request"
+// @Success 200
+// @Router /alerts/channels/test [post]
+func (b *BaseApi) TestAlertChannel(c *gin.Context) {
+	var req model.TestAlertChannel
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	if err := alertService.TestChannel(req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
+// @Tags Alert
+// @Summary list alert silences
+// @Description 获取告警静默列表
+// @Accept json
+// @Produce json
+// @Success 200 {array} model.AlertSilenceInfo
+// @Router /alerts/silences [get]
+func (b *BaseApi) ListAlertSilence(c *gin.Context) {
+	result, err := alertService.ListSilence()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Alert
+// @Summary create alert silence
+// @Description 创建告警静默，静默期间不发送通知
+// @Accept json
+// @Produce json
+// @Param request body model.CreateAlertSilence true "request"
+// @Success 200 {object} model.AlertSilenceInfo
+// @Router /alerts/silences [post]
+func (b *BaseApi) CreateAlertSilence(c *gin.Context) {
+	var req model.CreateAlertSilence
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	claims, err := GetClaims(c)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeAuth, constant.ErrAuth.Error(), err)
+		return
+	}
+
+	result, err := alertService.CreateSilence(claims.Name, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Alert
+// @Summary delete alert silence
+// @Description 删除告警静默
+// @Accept json
+// @Produce json
+// @Param id query uint true "Silence ID"
+// @Success 200
+// @Router /alerts/silences [delete]
+func (b *BaseApi) DeleteAlertSilence(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Query("id"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid silence id", err)
+		return
+	}
+
+	if err := alertService.DeleteSilence(uint(id)); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
+// @Tags Alert
+// @Summary list alert events
+// @Description 获取告警记录
+// @Accept json
+// @Produce json
+// @Param page query int true "Page"
+// @Param page_size query int true "Page size"
+// @Param state query string false "firing or resolved"
+// @Param rule_id query uint false "Rule ID"
+// @Param host_id query uint false "Host ID"
+// @Success 200 {object} model.PageResult
+// @Router /alerts/events [get]
+func (b *BaseApi) ListAlertEvent(c *gin.Context) {
+	var req model.QueryAlertEvent
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	result, err := alertService.ListEvent(req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
@@ -0,0 +1,34 @@
+package entry
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/core/constant"
+	"github.com/sensdata/idb/core/model"
+)
+
+// @Tags Audit
+// @Summary list audit logs
+// @Description 分页查询审计日志
+// @Accept json
+// @Produce json
+// @Param page query int true "Page number"
+// @Param page_size query int true "Page size"
+// @Param user query string false "User name"
+// @Param host_id query uint false "Host ID"
+// @Param start query int false "Start time, unix seconds"
+// @Param end query int false "End time, unix seconds"
+// @Success 200 {object} model.PageResult
+// @Router /audit [get]
+func (b *BaseApi) ListAuditLog(c *gin.Context) {
+	var req model.QueryAuditLog
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	result, err := auditService.List(req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), err)
+		return
+	}
+	SuccessWithData(c, result)
+}
@@ -29,6 +29,28 @@ func (b *BaseApi) Login(c *gin.Context) {
 	SuccessWithData(c, result)
 }
 
+// @Tags Auth
+// @Summary User login with two-factor code
+// @Description 两步验证登录，提交登录返回的 challenge 及验证码或恢复码
+// @Accept json
+// @Produce json
+// @Param request body model.LoginTwoFactor true "request"
+// @Success 200 {object} model.LoginResult
+// @Router /auth/sessions/2fa [post]
+func (b *BaseApi) LoginTwoFactor(c *gin.Context) {
+	var req model.LoginTwoFactor
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	result, err := authService.LoginTwoFactor(c, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrUnauthorized, constant.ErrInternalServer.Error(), err)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
 // @Tags Auth
 // @Summary User logout
 // @Description 用户登出
@@ -0,0 +1,248 @@
+package entry
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/center/core/api/middleware"
+	"github.com/sensdata/idb/core/constant"
+	"github.com/sensdata/idb/core/model"
+)
+
+// @Tags Backup
+// @Summary List backup jobs
+// @Description 获取备份任务列表，仓库的密码及密钥不会返回
+// @Accept json
+// @Produce json
+// @Param host path string true "Host"
+// @Success 200 {object} model.BackupJobList
+// @Router /backups/{host}/jobs [get]
+func (s *BaseApi) ListBackupJobs(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	list, err := backupService.ListJobs(uint(hostID))
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, list)
+}
+
+// @Tags Backup
+// @Summary Create backup job
+// @Description 创建备份任务，sftp 仓库指定 host_id 时使用该设备的 SSH 信息
+// @Accept json
+// @Produce json
+// @Param host path string true "Host"
+// @Param request body model.CreateBackupJob true "request"
+// @Success 200 {object} model.BackupJobCreated
+// @Router /backups/{host}/jobs [post]
+func (s *BaseApi) CreateBackupJob(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.CreateBackupJob
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+	if req.Repository.HostID != 0 && !middleware.HostAllowed(c, req.Repository.HostID) {
+		ErrorWithDetail(c, constant.CodeErrForbidden, "Repository host out of scope", nil)
+		return
+	}
+
+	result, err := backupService.CreateJob(uint(hostID), req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Backup
+// @Summary Update backup job
+// @Description 修改备份任务，仓库的密码及密钥为空时保留原值
+// @Accept json
+// @Produce json
+// @Param host path string true "Host"
+// @Param request body model.UpdateBackupJob true "request"
+// @Success 200
+// @Router /backups/{host}/jobs [put]
+func (s *BaseApi) UpdateBackupJob(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.UpdateBackupJob
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+	if req.Repository.HostID != 0 && !middleware.HostAllowed(c, req.Repository.HostID) {
+		ErrorWithDetail(c, constant.CodeErrForbidden, "Repository host out of scope", nil)
+		return
+	}
+
+	if err := backupService.UpdateJob(uint(hostID), req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
+// @Tags Backup
+// @Summary Delete backup job
+// @Description 删除备份任务，仓库中已有的快照不会被删除
+// @Accept json
+// @Produce json
+// @Param host path string true "Host"
+// @Param id query string true "Job ID"
+// @Success 200
+// @Router /backups/{host}/jobs [delete]
+func (s *BaseApi) DeleteBackupJob(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.QueryBackupJob
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	if err := backupService.DeleteJob(uint(hostID), req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
+// @Tags Backup
+// @Summary Run backup job
+// @Description 立即执行备份，返回的日志可通过 /logs/{host}/follow 追踪
+// @Accept json
+// @Produce json
+// @Param host path string true "Host"
+// @Param request body model.QueryBackupJob true "request"
+// @Success 200 {object} model.BackupLog
+// @Router /backups/{host}/jobs/run [post]
+func (s *BaseApi) RunBackupJob(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.QueryBackupJob
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	result, err := backupService.RunJob(uint(hostID), req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Backup
+// @Summary List backup snapshots
+// @Description 获取备份任务的快照列表，按时间倒序
+// @Accept json
+// @Produce json
+// @Param host path string true "Host"
+// @Param id query string true "Job ID"
+// @Success 200 {object} model.BackupSnapshotList
+// @Router /backups/{host}/snapshots [get]
+func (s *BaseApi) ListBackupSnapshots(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.QueryBackupJob
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	result, err := backupService.Snapshots(uint(hostID), req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Backup
+// @Summary List snapshot files
+// @Description 浏览快照中指定目录下的文件
+// @Accept json
+// @Produce json
+// @Param host path string true "Host"
+// @Param job_id query string true "Job ID"
+// @Param snapshot query string true "Snapshot ID or latest"
+// @Param path query string false "Directory in snapshot, default /"
+// @Success 200 {object} model.BackupFileList
+// @Router /backups/{host}/snapshots/files [get]
+func (s *BaseApi) ListBackupSnapshotFiles(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.QueryBackupFiles
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	result, err := backupService.SnapshotFiles(uint(hostID), req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Backup
+// @Summary Restore snapshot
+// @Description 将快照恢复到源设备或其他设备的指定目录，本地仓库仅能恢复到源设备
+// @Accept json
+// @Produce json
+// @Param host path string true "Host"
+// @Param request body model.RestoreBackup true "request"
+// @Success 200 {object} model.BackupLog
+// @Router /backups/{host}/restore [post]
+func (s *BaseApi) RestoreBackup(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.RestoreBackup
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+	if req.TargetHostID != 0 && !middleware.HostAllowed(c, req.TargetHostID) {
+		ErrorWithDetail(c, constant.CodeErrForbidden, "Target host out of scope", nil)
+		return
+	}
+
+	result, err := backupService.Restore(uint(hostID), req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
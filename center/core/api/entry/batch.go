@@ -0,0 +1,96 @@
+package entry
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/center/core/api/middleware"
+	"github.com/sensdata/idb/core/constant"
+	"github.com/sensdata/idb/core/model"
+)
+
+// @Tags Batch
+// @Summary execute batch
+// @Description 在多台设备上批量执行命令、脚本或 action，结果异步汇总
+// @Accept json
+// @Produce json
+// @Param request body model.BatchExecute true "request"
+// @Success 200 {object} model.BatchTaskInfo
+// @Router /batch [post]
+func (b *BaseApi) ExecuteBatch(c *gin.Context) {
+	var req model.BatchExecute
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	claims, err := GetClaims(c)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeAuth, constant.ErrAuth.Error(), err)
+		return
+	}
+
+	result, err := batchService.Execute(claims, req, middleware.HostGroupScope(c))
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Batch
+// @Summary list batch
+// @Description 获取批量操作列表
+// @Accept json
+// @Produce json
+// @Param page query int true "Page"
+// @Param page_size query int true "Page size"
+// @Success 200 {object} model.PageResult
+// @Router /batch [get]
+func (b *BaseApi) ListBatchTask(c *gin.Context) {
+	var req model.PageInfo
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	claims, err := GetClaims(c)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeAuth, constant.ErrAuth.Error(), err)
+		return
+	}
+
+	result, err := batchService.List(req, claims.ID, userService.IsAdmin(claims.ID))
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Batch
+// @Summary get batch result
+// @Description 获取批量操作详情及各设备的执行结果
+// @Accept json
+// @Produce json
+// @Param id query uint true "Batch ID"
+// @Success 200 {object} model.BatchTaskInfo
+// @Router /batch/result [get]
+func (b *BaseApi) GetBatchTask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Query("id"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid batch id", err)
+		return
+	}
+
+	claims, err := GetClaims(c)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeAuth, constant.ErrAuth.Error(), err)
+		return
+	}
+
+	result, err := batchService.Get(uint(id), claims.ID, userService.IsAdmin(claims.ID))
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
@@ -2,6 +2,7 @@ package entry
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/center/core/api/middleware"
 	"github.com/sensdata/idb/core/constant"
 	"github.com/sensdata/idb/core/model"
 )
@@ -11,6 +12,10 @@ func (b *BaseApi) SendCommand(c *gin.Context) {
 	if err := CheckBindAndValidate(&req, c); err != nil {
 		return
 	}
+	if !middleware.HostAllowed(c, req.HostID) {
+		ErrorWithDetail(c, constant.CodeErrForbidden, "Host out of scope", nil)
+		return
+	}
 
 	result, err := commandService.SendCommand(req)
 	if err != nil {
@@ -25,6 +30,10 @@ func (b *BaseApi) SendCommandGroup(c *gin.Context) {
 	if err := CheckBindAndValidate(&req, c); err != nil {
 		return
 	}
+	if !middleware.HostAllowed(c, req.HostID) {
+		ErrorWithDetail(c, constant.CodeErrForbidden, "Host out of scope", nil)
+		return
+	}
 
 	result, err := commandService.SendCommandGroup(req)
 	if err != nil {
@@ -9,6 +9,7 @@ var ApiGroup = new(BaseApi)
 var (
 	authService     = service.NewIAuthService()
 	userService     = service.NewIUserService()
+	roleService     = service.NewIRoleService()
 	groupService    = service.NewIGroupService()
 	hostService     = service.NewIHostService()
 	commandService  = service.NewICommandService()
@@ -19,4 +20,14 @@ var (
 	publicService   = service.NewIPublicService()
 	logManService   = service.NewILogManService()
 	rsyncService    = service.NewIRsyncService()
+	auditService    = service.NewIAuditService()
+
+	twoFactorService   = service.NewITwoFactorService()
+	accessTokenService = service.NewIAccessTokenService()
+	sessionService     = service.NewISessionService()
+	oidcService        = service.NewIOidcService()
+	ldapService        = service.NewILdapService()
+	batchService       = service.NewIBatchService()
+	alertService       = service.NewIAlertService()
+	backupService      = service.NewIBackupService()
 )
@@ -10,6 +10,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/sensdata/idb/core/constant"
 	"github.com/sensdata/idb/core/model"
+	"github.com/sensdata/idb/core/utils"
 
 	"github.com/sensdata/idb/center/global"
 	"gorm.io/gorm"
@@ -98,6 +99,19 @@ func GetStrParamByKey(c *gin.Context, key string) (string, error) {
 	return idParam, nil
 }
 
+// GetClaims 获取 JWTAuth 中间件写入的登录信息
+func GetClaims(c *gin.Context) (*utils.Claims, error) {
+	claimsInterface, exists := c.Get("user")
+	if !exists {
+		return nil, constant.ErrAuth
+	}
+	claims, ok := claimsInterface.(*utils.Claims)
+	if !ok {
+		return nil, constant.ErrInternalServer
+	}
+	return claims, nil
+}
+
 func GetTxAndContext() (tx *gorm.DB, ctx context.Context) {
 	tx = global.DB.Begin()
 	ctx = context.WithValue(context.Background(), constant.DB, tx)
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/center/core/api/middleware"
 	"github.com/sensdata/idb/center/global"
 	"github.com/sensdata/idb/core/constant"
 	"github.com/sensdata/idb/core/model"
@@ -25,7 +26,7 @@ func (b *BaseApi) ListHostGroup(c *gin.Context) {
 		return
 	}
 
-	result, err := hostService.ListGroup(req)
+	result, err := hostService.ListGroup(req, middleware.HostGroupScope(c))
 	if err != nil {
 		ErrorWithDetail(c, constant.CodeSuccess, constant.ErrNoRecords.Error(), err)
 		return
@@ -68,6 +69,10 @@ func (b *BaseApi) UpdateHostGroup(c *gin.Context) {
 	if err := CheckBindAndValidate(&req, c); err != nil {
 		return
 	}
+	if !groupInScope(c, req.ID) {
+		ErrorWithDetail(c, constant.CodeErrForbidden, "Host group out of scope", nil)
+		return
+	}
 
 	upMap := make(map[string]interface{})
 	upMap["group_name"] = req.GroupName
@@ -92,6 +97,10 @@ func (b *BaseApi) DeleteHostGroup(c *gin.Context) {
 		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid group ID", err)
 		return
 	}
+	if !groupInScope(c, uint(groupID)) {
+		ErrorWithDetail(c, constant.CodeErrForbidden, "Host group out of scope", nil)
+		return
+	}
 	if err := hostService.DeleteGroup([]uint{uint(groupID)}); err != nil {
 		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
 		return
@@ -116,7 +125,7 @@ func (b *BaseApi) ListHost(c *gin.Context) {
 		return
 	}
 
-	result, err := hostService.List(req)
+	result, err := hostService.List(req, middleware.HostGroupScope(c))
 	if err != nil {
 		ErrorWithDetail(c, constant.CodeSuccess, constant.ErrNoRecords.Error(), err)
 		return
@@ -137,6 +146,10 @@ func (b *BaseApi) CreateHost(c *gin.Context) {
 	if err := CheckBindAndValidate(&req, c); err != nil {
 		return
 	}
+	if !groupInScope(c, req.GroupID) {
+		ErrorWithDetail(c, constant.CodeErrForbidden, "Host group out of scope", nil)
+		return
+	}
 
 	result, err := hostService.Create(req)
 	if err != nil {
@@ -160,6 +173,10 @@ func (b *BaseApi) UpdateHost(c *gin.Context) {
 	if err := CheckBindAndValidate(&req, c); err != nil {
 		return
 	}
+	if !middleware.HostAllowed(c, req.ID) || !groupInScope(c, req.GroupID) {
+		ErrorWithDetail(c, constant.CodeErrForbidden, "Host out of scope", nil)
+		return
+	}
 
 	upMap := make(map[string]interface{})
 	upMap["name"] = req.Name
@@ -185,6 +202,10 @@ func (b *BaseApi) DeleteHost(c *gin.Context) {
 		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
 		return
 	}
+	if !middleware.HostAllowed(c, uint(hostID)) {
+		ErrorWithDetail(c, constant.CodeErrForbidden, "Host out of scope", nil)
+		return
+	}
 
 	if err := hostService.Delete(uint(hostID)); err != nil {
 		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
@@ -203,7 +224,7 @@ func (b *BaseApi) DeleteHost(c *gin.Context) {
 // @Failure 400 {object} model.Response "Bad Request"
 // @Router /hosts/status/follow [get]
 func (b *BaseApi) StatusFollow(c *gin.Context) {
-	err := hostService.StatusFollow(c)
+	err := hostService.StatusFollow(c, middleware.HostGroupScope(c))
 	if err != nil {
 		global.LOG.Error("Follow all host status failed: %v", err)
 		ErrorWithDetail(c, http.StatusInternalServerError, "Failed to establish SSE connection", err)
@@ -257,6 +278,37 @@ func (b *BaseApi) HostStatus(c *gin.Context) {
 	SuccessWithData(c, status)
 }
 
+// @Tags Host
+// @Summary Get host metrics
+// @Description 查询设备的历史指标，from、to 为 unix 秒，step 为聚合间隔（秒）
+// @Accept json
+// @Produce json
+// @Param host path int true "Host ID"
+// @Param from query int false "Start time, default 1 hour before to"
+// @Param to query int false "End time, default now"
+// @Param step query int false "Step in seconds, min 60"
+// @Success 200 {object} model.HostMetrics
+// @Router /hosts/{host}/metrics [get]
+func (b *BaseApi) HostMetrics(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.QueryHostMetrics
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	metrics, err := hostService.Metrics(uint(hostID), req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), err)
+		return
+	}
+	SuccessWithData(c, metrics)
+}
+
 // @Tags Host
 // @Summary Follow host status
 // @Description Follow host status
@@ -303,6 +355,73 @@ func (b *BaseApi) UpdateHostSSH(c *gin.Context) {
 	SuccessWithData(c, nil)
 }
 
+// @Tags Host
+// @Summary Update tags of host
+// @Description 设置设备标签，用于批量操作时选择设备
+// @Accept json
+// @Produce json
+// @Param host path int true "Host ID"
+// @Param request body model.UpdateHostTags true "request"
+// @Success 200
+// @Router /hosts/{host}/conf/tags [put]
+func (b *BaseApi) UpdateHostTags(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.UpdateHostTags
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	if err := hostService.UpdateTags(uint(hostID), req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
+// @Tags Host
+// @Summary Update terminal recording of host
+// @Description Enable or disable terminal recording of host
+// @Accept json
+// @Produce json
+// @Param host path int true "Host ID"
+// @Param request body model.UpdateRecording true "request"
+// @Success 200
+// @Router /hosts/{host}/conf/recording [put]
+func (b *BaseApi) UpdateHostRecording(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.UpdateRecording
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	// 录制用于事后取证，仅管理员可修改
+	claims, err := GetClaims(c)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeAuth, constant.ErrAuth.Error(), err)
+		return
+	}
+	if !userService.IsAdmin(claims.ID) {
+		ErrorWithDetail(c, constant.CodeErrForbidden, constant.ErrAuth.Error(), constant.ErrAuth)
+		return
+	}
+
+	if err := hostService.UpdateRecording(uint(hostID), req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
 // @Tags Host
 // @Summary Update agent config of host
 // @Description Update agent config of host
@@ -496,3 +615,16 @@ func (b *BaseApi) RestartAgent(c *gin.Context) {
 	}
 	SuccessWithData(c, nil)
 }
+
+func groupInScope(c *gin.Context, groupID uint) bool {
+	groups := middleware.HostGroupScope(c)
+	if groups == nil {
+		return true
+	}
+	for _, id := range groups {
+		if id == groupID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package entry
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/core/constant"
+	"github.com/sensdata/idb/core/model"
+)
+
+// @Tags Settings
+// @Summary Get LDAP settings
+// @Description 获取 LDAP 认证配置，不返回服务账号密码
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.LdapSettings
+// @Router /settings/ldap [get]
+func (b *BaseApi) LdapSettings(c *gin.Context) {
+	result, err := ldapService.Settings()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Settings
+// @Summary Update LDAP settings
+// @Description 更新 LDAP 认证配置，服务账号密码留空表示不修改
+// @Accept json
+// @Produce json
+// @Param request body model.LdapSettings true "request"
+// @Success 200
+// @Router /settings/ldap [put]
+func (b *BaseApi) UpdateLdapSettings(c *gin.Context) {
+	var req model.LdapSettings
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	if err := ldapService.UpdateSettings(req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
@@ -437,3 +437,613 @@ func (a *BaseApi) MysqlGetConnectionInfo(c *gin.Context) {
 
 	SuccessWithData(c, resp)
 }
+
+// @Tags Mysql
+// @Summary Dump mysql
+// @Description Dump mysql in the background, progress is streamed to the returned log
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.DumpRequest true "req"
+// @Success 200 {object} model.DumpTaskResponse
+// @Router /mysql/{host}/dumps [post]
+func (a *BaseApi) MysqlDump(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.DumpRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getMysqlManager()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.Dump(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags Mysql
+// @Summary List mysql dumps
+// @Description List mysql dumps, newest first
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Param page query uint true "Page"
+// @Param page_size query uint true "Page size"
+// @Success 200 {object} model.ListDumpsResponse
+// @Router /mysql/{host}/dumps [get]
+func (a *BaseApi) MysqlListDumps(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.ListDumpsRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getMysqlManager()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.ListDumps(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags Mysql
+// @Summary Delete mysql dump
+// @Description Delete mysql dump
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Param file query string true "Dump file"
+// @Success 200
+// @Router /mysql/{host}/dumps [delete]
+func (a *BaseApi) MysqlDeleteDump(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.DeleteDumpRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getMysqlManager()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.DeleteDump(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags Mysql
+// @Summary Restore mysql dump
+// @Description Restore mysql dump into the original or another database
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.RestoreDumpRequest true "req"
+// @Success 200 {object} model.DumpTaskResponse
+// @Router /mysql/{host}/dumps/restore [post]
+func (a *BaseApi) MysqlRestoreDump(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.RestoreDumpRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getMysqlManager()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.RestoreDump(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags Mysql
+// @Summary Get mysql dump schedule
+// @Description Get mysql dump schedule
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Success 200 {object} model.DumpSchedule
+// @Router /mysql/{host}/dumps/schedule [get]
+func (a *BaseApi) MysqlGetDumpSchedule(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.GetDumpScheduleRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getMysqlManager()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.GetDumpSchedule(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags Mysql
+// @Summary Set mysql dump schedule
+// @Description Set mysql dump schedule and retention
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.DumpSchedule true "req"
+// @Success 200
+// @Router /mysql/{host}/dumps/schedule [post]
+func (a *BaseApi) MysqlSetDumpSchedule(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.DumpSchedule
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getMysqlManager()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.SetDumpSchedule(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags Mysql
+// @Summary List mysql databases
+// @Description List mysql databases
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Success 200 {object} model.ListDatabasesResponse
+// @Router /mysql/{host}/databases [get]
+func (a *BaseApi) MysqlListDatabases(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.ListDatabasesRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getMysqlManager()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.ListDatabases(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags Mysql
+// @Summary Create mysql database
+// @Description Create mysql database
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.CreateDatabaseRequest true "req"
+// @Success 200
+// @Router /mysql/{host}/databases [post]
+func (a *BaseApi) MysqlCreateDatabase(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.CreateDatabaseRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getMysqlManager()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.CreateDatabase(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags Mysql
+// @Summary Drop mysql database
+// @Description Drop mysql database
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Param database query string true "Database"
+// @Success 200
+// @Router /mysql/{host}/databases [delete]
+func (a *BaseApi) MysqlDropDatabase(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.DropDatabaseRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getMysqlManager()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.DropDatabase(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags Mysql
+// @Summary List mysql users
+// @Description List mysql users and their grants
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Success 200 {object} model.ListDatabaseUsersResponse
+// @Router /mysql/{host}/users [get]
+func (a *BaseApi) MysqlListUsers(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.ListDatabaseUsersRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getMysqlManager()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.ListUsers(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags Mysql
+// @Summary Create mysql user
+// @Description Create mysql user with optional grants
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.CreateDatabaseUserRequest true "req"
+// @Success 200
+// @Router /mysql/{host}/users [post]
+func (a *BaseApi) MysqlCreateUser(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.CreateDatabaseUserRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getMysqlManager()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.CreateUser(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags Mysql
+// @Summary Drop mysql user
+// @Description Drop mysql user
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Param user query string true "User"
+// @Param host query string false "Host, mysql only"
+// @Success 200
+// @Router /mysql/{host}/users [delete]
+func (a *BaseApi) MysqlDropUser(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.DropDatabaseUserRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getMysqlManager()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.DropUser(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags Mysql
+// @Summary Grant mysql privileges
+// @Description Grant privileges on a database to a mysql user
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.DatabasePrivilegesRequest true "req"
+// @Success 200
+// @Router /mysql/{host}/users/grant [post]
+func (a *BaseApi) MysqlGrantPrivileges(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.DatabasePrivilegesRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getMysqlManager()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.GrantPrivileges(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags Mysql
+// @Summary Revoke mysql privileges
+// @Description Revoke privileges on a database from a mysql user
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.DatabasePrivilegesRequest true "req"
+// @Success 200
+// @Router /mysql/{host}/users/revoke [post]
+func (a *BaseApi) MysqlRevokePrivileges(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.DatabasePrivilegesRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getMysqlManager()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.RevokePrivileges(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags Mysql
+// @Summary Set mysql user password
+// @Description Set mysql user password
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.SetDatabaseUserPasswordRequest true "req"
+// @Success 200
+// @Router /mysql/{host}/users/password [post]
+func (a *BaseApi) MysqlSetUserPassword(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.SetDatabaseUserPasswordRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getMysqlManager()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.SetUserPassword(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags Mysql
+// @Summary List mysql connections
+// @Description List current mysql connections
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Success 200 {object} model.ListDatabaseConnectionsResponse
+// @Router /mysql/{host}/connections [get]
+func (a *BaseApi) MysqlListConnections(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.ListDatabaseConnectionsRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getMysqlManager()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.ListConnections(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags Mysql
+// @Summary Get mysql slow query log
+// @Description Get mysql slow query log settings and recent entries
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Param lines query int false "Number of lines from the end"
+// @Success 200 {object} model.GetSlowLogResponse
+// @Router /mysql/{host}/slow_log [get]
+func (a *BaseApi) MysqlGetSlowLog(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.GetSlowLogRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getMysqlManager()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.GetSlowLog(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
@@ -0,0 +1,104 @@
+package entry
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/center/global"
+	"github.com/sensdata/idb/core/constant"
+	"github.com/sensdata/idb/core/model"
+)
+
+// @Tags Auth
+// @Summary OIDC login status
+// @Description 是否启用单点登录，供登录页显示入口
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.OidcStatus
+// @Router /auth/oidc [get]
+func (b *BaseApi) OidcStatus(c *gin.Context) {
+	SuccessWithData(c, oidcService.Status())
+}
+
+// @Tags Auth
+// @Summary OIDC login
+// @Description 跳转到身份源登录
+// @Success 302
+// @Router /auth/oidc/login [get]
+func (b *BaseApi) OidcLogin(c *gin.Context) {
+	authURL, err := oidcService.AuthURL(c)
+	if err != nil {
+		global.LOG.Error("Failed to start oidc login: %v", err)
+		redirectLoginError(c, err.Error())
+		return
+	}
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// @Tags Auth
+// @Summary OIDC callback
+// @Description 身份源登录后回调，写入 token cookie 后跳转到首页
+// @Param code query string true "Authorization code"
+// @Param state query string true "State"
+// @Success 302
+// @Router /auth/oidc/callback [get]
+func (b *BaseApi) OidcCallback(c *gin.Context) {
+	if errCode := c.Query("error"); errCode != "" {
+		redirectLoginError(c, errCode+": "+c.Query("error_description"))
+		return
+	}
+
+	result, err := oidcService.Callback(c, c.Query("code"), c.Query("state"))
+	if err != nil {
+		redirectLoginError(c, err.Error())
+		return
+	}
+	// 前端通过 document.cookie 读取 idb-token 判断登录状态并放入 Authorization 请求头，
+	// 与密码登录后前端自行写入的 cookie 相同，因此不能设置 HttpOnly
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie("idb-token", result.Token, 0, "/", "", c.Request.TLS != nil, false)
+	c.Redirect(http.StatusFound, "/")
+}
+
+// @Tags Settings
+// @Summary Get OIDC settings
+// @Description 获取单点登录配置，不返回 client secret
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.OidcSettings
+// @Router /settings/oidc [get]
+func (b *BaseApi) OidcSettings(c *gin.Context) {
+	result, err := oidcService.Settings()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Settings
+// @Summary Update OIDC settings
+// @Description 更新单点登录配置，client secret 留空表示不修改
+// @Accept json
+// @Produce json
+// @Param request body model.OidcSettings true "request"
+// @Success 200
+// @Router /settings/oidc [put]
+func (b *BaseApi) UpdateOidcSettings(c *gin.Context) {
+	var req model.OidcSettings
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	if err := oidcService.UpdateSettings(req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
+// redirectLoginError 单点登录失败时带着错误信息回到登录页
+func redirectLoginError(c *gin.Context, message string) {
+	c.Redirect(http.StatusFound, "/login?sso_error="+url.QueryEscape(message))
+}
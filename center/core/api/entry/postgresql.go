@@ -232,3 +232,613 @@ func (a *BaseApi) PostgreSqlSetConf(c *gin.Context) {
 
 	SuccessWithData(c, nil)
 }
+
+// @Tags PostgreSql
+// @Summary Dump postgresql
+// @Description Dump postgresql in the background, progress is streamed to the returned log
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.DumpRequest true "req"
+// @Success 200 {object} model.DumpTaskResponse
+// @Router /postgresql/{host}/dumps [post]
+func (a *BaseApi) PostgreSqlDump(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.DumpRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getPostgreSql()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.Dump(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags PostgreSql
+// @Summary List postgresql dumps
+// @Description List postgresql dumps, newest first
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Param page query uint true "Page"
+// @Param page_size query uint true "Page size"
+// @Success 200 {object} model.ListDumpsResponse
+// @Router /postgresql/{host}/dumps [get]
+func (a *BaseApi) PostgreSqlListDumps(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.ListDumpsRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getPostgreSql()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.ListDumps(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags PostgreSql
+// @Summary Delete postgresql dump
+// @Description Delete postgresql dump
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Param file query string true "Dump file"
+// @Success 200
+// @Router /postgresql/{host}/dumps [delete]
+func (a *BaseApi) PostgreSqlDeleteDump(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.DeleteDumpRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getPostgreSql()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.DeleteDump(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags PostgreSql
+// @Summary Restore postgresql dump
+// @Description Restore postgresql dump into the original or another database
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.RestoreDumpRequest true "req"
+// @Success 200 {object} model.DumpTaskResponse
+// @Router /postgresql/{host}/dumps/restore [post]
+func (a *BaseApi) PostgreSqlRestoreDump(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.RestoreDumpRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getPostgreSql()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.RestoreDump(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags PostgreSql
+// @Summary Get postgresql dump schedule
+// @Description Get postgresql dump schedule
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Success 200 {object} model.DumpSchedule
+// @Router /postgresql/{host}/dumps/schedule [get]
+func (a *BaseApi) PostgreSqlGetDumpSchedule(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.GetDumpScheduleRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getPostgreSql()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.GetDumpSchedule(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags PostgreSql
+// @Summary Set postgresql dump schedule
+// @Description Set postgresql dump schedule and retention
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.DumpSchedule true "req"
+// @Success 200
+// @Router /postgresql/{host}/dumps/schedule [post]
+func (a *BaseApi) PostgreSqlSetDumpSchedule(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.DumpSchedule
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getPostgreSql()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.SetDumpSchedule(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags PostgreSql
+// @Summary List postgresql databases
+// @Description List postgresql databases
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Success 200 {object} model.ListDatabasesResponse
+// @Router /postgresql/{host}/databases [get]
+func (a *BaseApi) PostgreSqlListDatabases(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.ListDatabasesRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getPostgreSql()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.ListDatabases(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags PostgreSql
+// @Summary Create postgresql database
+// @Description Create postgresql database
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.CreateDatabaseRequest true "req"
+// @Success 200
+// @Router /postgresql/{host}/databases [post]
+func (a *BaseApi) PostgreSqlCreateDatabase(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.CreateDatabaseRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getPostgreSql()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.CreateDatabase(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags PostgreSql
+// @Summary Drop postgresql database
+// @Description Drop postgresql database
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Param database query string true "Database"
+// @Success 200
+// @Router /postgresql/{host}/databases [delete]
+func (a *BaseApi) PostgreSqlDropDatabase(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.DropDatabaseRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getPostgreSql()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.DropDatabase(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags PostgreSql
+// @Summary List postgresql users
+// @Description List postgresql users and their grants
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Success 200 {object} model.ListDatabaseUsersResponse
+// @Router /postgresql/{host}/users [get]
+func (a *BaseApi) PostgreSqlListUsers(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.ListDatabaseUsersRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getPostgreSql()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.ListUsers(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags PostgreSql
+// @Summary Create postgresql user
+// @Description Create postgresql user with optional grants
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.CreateDatabaseUserRequest true "req"
+// @Success 200
+// @Router /postgresql/{host}/users [post]
+func (a *BaseApi) PostgreSqlCreateUser(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.CreateDatabaseUserRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getPostgreSql()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.CreateUser(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags PostgreSql
+// @Summary Drop postgresql user
+// @Description Drop postgresql user
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Param user query string true "User"
+// @Param host query string false "Host, mysql only"
+// @Success 200
+// @Router /postgresql/{host}/users [delete]
+func (a *BaseApi) PostgreSqlDropUser(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.DropDatabaseUserRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getPostgreSql()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.DropUser(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags PostgreSql
+// @Summary Grant postgresql privileges
+// @Description Grant privileges on a database to a postgresql user
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.DatabasePrivilegesRequest true "req"
+// @Success 200
+// @Router /postgresql/{host}/users/grant [post]
+func (a *BaseApi) PostgreSqlGrantPrivileges(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.DatabasePrivilegesRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getPostgreSql()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.GrantPrivileges(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags PostgreSql
+// @Summary Revoke postgresql privileges
+// @Description Revoke privileges on a database from a postgresql user
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.DatabasePrivilegesRequest true "req"
+// @Success 200
+// @Router /postgresql/{host}/users/revoke [post]
+func (a *BaseApi) PostgreSqlRevokePrivileges(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.DatabasePrivilegesRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getPostgreSql()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.RevokePrivileges(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags PostgreSql
+// @Summary Set postgresql user password
+// @Description Set postgresql user password
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.SetDatabaseUserPasswordRequest true "req"
+// @Success 200
+// @Router /postgresql/{host}/users/password [post]
+func (a *BaseApi) PostgreSqlSetUserPassword(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.SetDatabaseUserPasswordRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getPostgreSql()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.SetUserPassword(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags PostgreSql
+// @Summary List postgresql connections
+// @Description List current postgresql connections
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Success 200 {object} model.ListDatabaseConnectionsResponse
+// @Router /postgresql/{host}/connections [get]
+func (a *BaseApi) PostgreSqlListConnections(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.ListDatabaseConnectionsRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getPostgreSql()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.ListConnections(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags PostgreSql
+// @Summary Get postgresql slow query log
+// @Description Get postgresql slow query log settings and recent entries
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Param lines query int false "Number of lines from the end"
+// @Success 200 {object} model.GetSlowLogResponse
+// @Router /postgresql/{host}/slow_log [get]
+func (a *BaseApi) PostgreSqlGetSlowLog(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.GetSlowLogRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getPostgreSql()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.GetSlowLog(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
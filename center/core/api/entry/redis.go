@@ -394,3 +394,556 @@ func (a *BaseApi) RedisSetRootPassword(c *gin.Context) {
 
 	SuccessWithData(c, nil)
 }
+
+// @Tags Redis
+// @Summary Dump redis
+// @Description Dump redis in the background, progress is streamed to the returned log
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.DumpRequest true "req"
+// @Success 200 {object} model.DumpTaskResponse
+// @Router /redis/{host}/dumps [post]
+func (a *BaseApi) RedisDump(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.DumpRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getRedis()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.Dump(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags Redis
+// @Summary List redis dumps
+// @Description List redis dumps, newest first
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Param page query uint true "Page"
+// @Param page_size query uint true "Page size"
+// @Success 200 {object} model.ListDumpsResponse
+// @Router /redis/{host}/dumps [get]
+func (a *BaseApi) RedisListDumps(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.ListDumpsRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getRedis()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.ListDumps(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags Redis
+// @Summary Delete redis dump
+// @Description Delete redis dump
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Param file query string true "Dump file"
+// @Success 200
+// @Router /redis/{host}/dumps [delete]
+func (a *BaseApi) RedisDeleteDump(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.DeleteDumpRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getRedis()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.DeleteDump(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags Redis
+// @Summary Restore redis dump
+// @Description Restore redis from a dump, replaces all data and restarts the container
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.RestoreDumpRequest true "req"
+// @Success 200 {object} model.DumpTaskResponse
+// @Router /redis/{host}/dumps/restore [post]
+func (a *BaseApi) RedisRestoreDump(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.RestoreDumpRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getRedis()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.RestoreDump(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags Redis
+// @Summary Get redis dump schedule
+// @Description Get redis dump schedule
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Success 200 {object} model.DumpSchedule
+// @Router /redis/{host}/dumps/schedule [get]
+func (a *BaseApi) RedisGetDumpSchedule(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.GetDumpScheduleRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getRedis()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.GetDumpSchedule(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags Redis
+// @Summary Set redis dump schedule
+// @Description Set redis dump schedule and retention
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.DumpSchedule true "req"
+// @Success 200
+// @Router /redis/{host}/dumps/schedule [post]
+func (a *BaseApi) RedisSetDumpSchedule(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.DumpSchedule
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getRedis()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.SetDumpSchedule(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags Redis
+// @Summary Get redis info
+// @Description Get parsed INFO output grouped by section
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Param section query string false "INFO section, e.g. server, memory, all"
+// @Success 200 {object} model.RedisInfoResponse
+// @Router /redis/{host}/info [get]
+func (a *BaseApi) RedisInfo(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.RedisInfoRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getRedis()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.Info(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags Redis
+// @Summary Get redis keyspace
+// @Description Get key count, expires and average ttl of each database
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Success 200 {object} model.RedisKeyspaceResponse
+// @Router /redis/{host}/keyspace [get]
+func (a *BaseApi) RedisKeyspace(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.RedisKeyspaceRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getRedis()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.Keyspace(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags Redis
+// @Summary Scan redis keys
+// @Description Browse keys with SCAN, pass the returned cursor to continue, cursor 0 means finished
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Param db query int false "Database index"
+// @Param cursor query string false "Cursor"
+// @Param match query string false "Match pattern"
+// @Param type query string false "Key type"
+// @Param count query int false "SCAN count hint, max 1000"
+// @Success 200 {object} model.RedisScanKeysResponse
+// @Router /redis/{host}/keys [get]
+func (a *BaseApi) RedisScanKeys(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.RedisScanKeysRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getRedis()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.ScanKeys(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags Redis
+// @Summary Get redis key value
+// @Description Preview key value by type, large values are truncated
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Param db query int false "Database index"
+// @Param key query string true "Key"
+// @Param limit query int false "Max elements to preview, max 1000"
+// @Success 200 {object} model.RedisKeyValue
+// @Router /redis/{host}/keys/value [get]
+func (a *BaseApi) RedisGetKey(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.RedisGetKeyRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getRedis()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.GetKey(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags Redis
+// @Summary Set redis key ttl
+// @Description Set key ttl in seconds, -1 removes the expiration
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.RedisSetTTLRequest true "req"
+// @Success 200
+// @Router /redis/{host}/keys/ttl [post]
+func (a *BaseApi) RedisSetTTL(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.RedisSetTTLRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getRedis()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.SetTTL(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags Redis
+// @Summary Get redis slowlog
+// @Description Get recent slow commands
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Param count query int false "Max entries, max 1000"
+// @Success 200 {object} model.RedisSlowlogResponse
+// @Router /redis/{host}/slowlog [get]
+func (a *BaseApi) RedisSlowlog(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.RedisSlowlogRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getRedis()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.Slowlog(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags Redis
+// @Summary List redis clients
+// @Description List connected clients
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Success 200 {object} model.RedisClientsResponse
+// @Router /redis/{host}/clients [get]
+func (a *BaseApi) RedisClients(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.RedisClientsRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getRedis()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.Clients(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
+
+// @Tags Redis
+// @Summary Kill redis client
+// @Description Close a client connection by id
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param req body model.RedisKillClientRequest true "req"
+// @Success 200
+// @Router /redis/{host}/clients/kill [post]
+func (a *BaseApi) RedisKillClient(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.RedisKillClientRequest
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getRedis()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	if err := client.KillClient(hostID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, nil)
+}
+
+// @Tags Redis
+// @Summary Find redis big keys
+// @Description Sample keys with SCAN and return the largest by MEMORY USAGE
+// @Accept json
+// @Produce json
+// @Param host path string true "host"
+// @Param name query string true "name"
+// @Param db query int false "Database index"
+// @Param match query string false "Match pattern"
+// @Param sample query int false "Keys to sample, max 100000"
+// @Param top query int false "Keys to return, max 100"
+// @Success 200 {object} model.RedisBigKeysResponse
+// @Router /redis/{host}/bigkeys [get]
+func (a *BaseApi) RedisBigKeys(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.RedisBigKeysRequest
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	client, err := getRedis()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	resp, err := client.BigKeys(hostID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+
+	SuccessWithData(c, resp)
+}
@@ -0,0 +1,118 @@
+package entry
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/core/constant"
+	"github.com/sensdata/idb/core/model"
+)
+
+// @Tags User
+// @Summary get role list
+// @Description 获取角色列表
+// @Accept json
+// @Produce json
+// @Param page query int true "Page number"
+// @Param page_size query int true "Page size"
+// @Success 200 {object} model.PageResult
+// @Router /users/roles [get]
+func (b *BaseApi) ListRole(c *gin.Context) {
+	var req model.PageInfo
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	result, err := roleService.List(req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeSuccess, constant.ErrNoRecords.Error(), err)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags User
+// @Summary create role
+// @Description 新增角色
+// @Accept json
+// @Produce json
+// @Param request body model.CreateRole true "request"
+// @Success 200 {object} model.RoleDetail
+// @Router /users/roles [post]
+func (b *BaseApi) CreateRole(c *gin.Context) {
+	var req model.CreateRole
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	result, err := roleService.Create(req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags User
+// @Summary update role
+// @Description 更新角色权限
+// @Accept json
+// @Produce json
+// @Param request body model.UpdateRole true "request"
+// @Success 200
+// @Router /users/roles [put]
+func (b *BaseApi) UpdateRole(c *gin.Context) {
+	var req model.UpdateRole
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	if err := roleService.Update(req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
+// @Tags User
+// @Summary delete role
+// @Description 删除角色
+// @Accept json
+// @Produce json
+// @Param id query int true "Role ID"
+// @Success 200
+// @Router /users/roles [delete]
+func (b *BaseApi) DeleteRole(c *gin.Context) {
+	roleID, err := strconv.ParseUint(c.Query("id"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid role ID", err)
+		return
+	}
+
+	if err := roleService.Delete(uint(roleID)); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
+// @Tags User
+// @Summary set role host scope
+// @Description 设置角色可访问的设备组
+// @Accept json
+// @Produce json
+// @Param request body model.UpdateHostScope true "request"
+// @Success 200
+// @Router /users/roles/host_groups [put]
+func (b *BaseApi) SetRoleHostScope(c *gin.Context) {
+	var req model.UpdateHostScope
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	if err := roleService.SetHostScope(req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
@@ -251,7 +251,7 @@ func (s *BaseApi) RsyncTest(c *gin.Context) {
 
 // @Tags Rsync
 // @Summary Query rsync task logs
-// @Description Query rsync task logs
+// @Description Query rsync task run history, newest first. Each run carries its log path, trigger, exit code and transfer stats
 // @Accept json
 // @Produce json
 // @Param host path string true "Host"
@@ -0,0 +1,109 @@
+package entry
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/core/constant"
+	"github.com/sensdata/idb/core/model"
+)
+
+// @Tags User
+// @Summary list my sessions
+// @Description 获取当前用户的登录会话
+// @Accept json
+// @Produce json
+// @Success 200 {array} model.LoginSessionInfo
+// @Router /users/profile/sessions [get]
+func (b *BaseApi) ListProfileSession(c *gin.Context) {
+	claims, err := GetClaims(c)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeAuth, constant.ErrAuth.Error(), err)
+		return
+	}
+
+	result, err := sessionService.List(claims.ID, claims.RegisteredClaims.ID)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags User
+// @Summary revoke my session
+// @Description 吊销当前用户的登录会话
+// @Accept json
+// @Produce json
+// @Param id query uint true "Session ID"
+// @Success 200
+// @Router /users/profile/sessions [delete]
+func (b *BaseApi) RevokeProfileSession(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Query("id"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid session id", err)
+		return
+	}
+
+	claims, err := GetClaims(c)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeAuth, constant.ErrAuth.Error(), err)
+		return
+	}
+
+	if err := sessionService.Revoke(claims.ID, uint(id), false); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
+// @Tags User
+// @Summary list user sessions
+// @Description 管理员获取指定用户的登录会话
+// @Accept json
+// @Produce json
+// @Param user_id query uint true "User ID"
+// @Success 200 {array} model.LoginSessionInfo
+// @Router /users/sessions [get]
+func (b *BaseApi) ListSession(c *gin.Context) {
+	var req model.QuerySession
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	claims, err := GetClaims(c)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeAuth, constant.ErrAuth.Error(), err)
+		return
+	}
+
+	result, err := sessionService.List(req.UserID, claims.RegisteredClaims.ID)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags User
+// @Summary revoke user session
+// @Description 管理员吊销任意用户的登录会话
+// @Accept json
+// @Produce json
+// @Param id query uint true "Session ID"
+// @Success 200
+// @Router /users/sessions [delete]
+func (b *BaseApi) RevokeSession(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Query("id"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid session id", err)
+		return
+	}
+
+	if err := sessionService.Revoke(0, uint(id), true); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
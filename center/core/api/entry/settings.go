@@ -131,3 +131,87 @@ func (b *BaseApi) Upgrade(c *gin.Context) {
 	}
 	SuccessWithData(c, nil)
 }
+
+// @Tags Settings
+// @Summary Get security settings
+// @Description Get security settings
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.SecuritySettings
+// @Router /settings/security [get]
+func (b *BaseApi) SecuritySettings(c *gin.Context) {
+	result, err := settingsService.Security()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Settings
+// @Summary Update security settings
+// @Description Update security settings, such as requiring two-factor authentication for all users
+// @Accept json
+// @Produce json
+// @Param request body model.SecuritySettings true "request"
+// @Success 200
+// @Router /settings/security [put]
+func (b *BaseApi) UpdateSecuritySettings(c *gin.Context) {
+	var req model.SecuritySettings
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	if err := settingsService.UpdateSecurity(req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
+// @Tags Settings
+// @Summary Get metrics settings
+// @Description Get whether the Prometheus /metrics endpoint is enabled
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.MetricsSettings
+// @Router /settings/metrics [get]
+func (b *BaseApi) MetricsSettings(c *gin.Context) {
+	result, err := settingsService.Metrics()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Settings
+// @Summary Generate metrics token
+// @Description Generate a new token for scraping /metrics, the previous token is revoked
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.MetricsToken
+// @Router /settings/metrics/token [post]
+func (b *BaseApi) GenerateMetricsToken(c *gin.Context) {
+	result, err := settingsService.GenerateMetricsToken()
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Settings
+// @Summary Disable metrics
+// @Description Revoke the metrics token and disable /metrics
+// @Accept json
+// @Produce json
+// @Success 200
+// @Router /settings/metrics/token [delete]
+func (b *BaseApi) DisableMetrics(c *gin.Context) {
+	if err := settingsService.DisableMetrics(); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
@@ -0,0 +1,133 @@
+package entry
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/core/constant"
+	"github.com/sensdata/idb/core/model"
+)
+
+// @Tags User
+// @Summary setup two-factor authentication
+// @Description 生成两步验证密钥及二维码链接，需调用 enable 确认
+// @Accept json
+// @Produce json
+// @Success 200 {object} model.TotpSetup
+// @Router /users/2fa/setup [post]
+func (b *BaseApi) SetupTwoFactor(c *gin.Context) {
+	claims, err := GetClaims(c)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeAuth, constant.ErrAuth.Error(), err)
+		return
+	}
+
+	result, err := twoFactorService.Setup(claims.ID)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags User
+// @Summary enable two-factor authentication
+// @Description 校验验证码并启用两步验证，返回恢复码
+// @Accept json
+// @Produce json
+// @Param request body model.TotpCode true "request"
+// @Success 200 {object} model.RecoveryCodes
+// @Router /users/2fa/enable [post]
+func (b *BaseApi) EnableTwoFactor(c *gin.Context) {
+	var req model.TotpCode
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	claims, err := GetClaims(c)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeAuth, constant.ErrAuth.Error(), err)
+		return
+	}
+
+	result, err := twoFactorService.Enable(claims.ID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags User
+// @Summary regenerate recovery codes
+// @Description 重新生成恢复码
+// @Accept json
+// @Produce json
+// @Param request body model.TotpCode true "request"
+// @Success 200 {object} model.RecoveryCodes
+// @Router /users/2fa/recovery_codes [post]
+func (b *BaseApi) RegenerateRecoveryCodes(c *gin.Context) {
+	var req model.TotpCode
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	claims, err := GetClaims(c)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeAuth, constant.ErrAuth.Error(), err)
+		return
+	}
+
+	result, err := twoFactorService.RegenerateRecoveryCodes(claims.ID, req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags User
+// @Summary disable two-factor authentication
+// @Description 关闭两步验证，需提供验证码或恢复码
+// @Accept json
+// @Produce json
+// @Param request body model.TotpCode true "request"
+// @Success 200
+// @Router /users/2fa/disable [post]
+func (b *BaseApi) DisableTwoFactor(c *gin.Context) {
+	var req model.TotpCode
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	claims, err := GetClaims(c)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeAuth, constant.ErrAuth.Error(), err)
+		return
+	}
+
+	if err := twoFactorService.Disable(claims.ID, req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
+// @Tags User
+// @Summary reset two-factor authentication of user
+// @Description 管理员清除用户的两步验证（如设备丢失）
+// @Accept json
+// @Produce json
+// @Param request body model.ResetTwoFactor true "request"
+// @Success 200
+// @Router /users/2fa/reset [put]
+func (b *BaseApi) ResetTwoFactor(c *gin.Context) {
+	var req model.ResetTwoFactor
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	if err := twoFactorService.Reset(req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
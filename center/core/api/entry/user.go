@@ -76,6 +76,13 @@ func (b *BaseApi) UpdateUser(c *gin.Context) {
 		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
 		return
 	}
+	// 禁用用户时吊销其全部登录会话
+	if req.Valid == 0 {
+		if err := sessionService.RevokeAll(req.ID); err != nil {
+			ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+			return
+		}
+	}
 	SuccessWithData(c, nil)
 }
 
@@ -121,6 +128,13 @@ func (b *BaseApi) ValidUser(c *gin.Context) {
 		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
 		return
 	}
+	// 禁用用户时吊销其全部登录会话
+	if req.Valid == 0 {
+		if err := sessionService.RevokeAll(req.ID); err != nil {
+			ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+			return
+		}
+	}
 	SuccessWithData(c, nil)
 }
 
@@ -138,6 +152,17 @@ func (b *BaseApi) ChangePassword(c *gin.Context) {
 		return
 	}
 
+	// 非管理员只能修改自己的密码
+	claims, err := GetClaims(c)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeAuth, constant.ErrAuth.Error(), err)
+		return
+	}
+	if claims.ID != req.ID && !userService.IsAdmin(claims.ID) {
+		ErrorWithDetail(c, constant.CodeErrForbidden, constant.ErrAuth.Error(), constant.ErrAuth)
+		return
+	}
+
 	if err := userService.ChangePassword(req); err != nil {
 		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
 		return
@@ -170,3 +195,66 @@ func (b *BaseApi) Profile(c *gin.Context) {
 	}
 	SuccessWithData(c, result)
 }
+
+// @Tags User
+// @Summary set user role
+// @Description 设置用户角色
+// @Accept json
+// @Produce json
+// @Param request body model.UpdateUserRole true "request"
+// @Success 200
+// @Router /users/role [put]
+func (b *BaseApi) SetUserRole(c *gin.Context) {
+	var req model.UpdateUserRole
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	if err := userService.SetRole(req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
+// @Tags User
+// @Summary set user host scope
+// @Description 设置用户可访问的设备组
+// @Accept json
+// @Produce json
+// @Param request body model.UpdateHostScope true "request"
+// @Success 200
+// @Router /users/host_groups [put]
+func (b *BaseApi) SetUserHostScope(c *gin.Context) {
+	var req model.UpdateHostScope
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	if err := userService.SetHostScope(req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
+
+// @Tags User
+// @Summary set user terminal recording
+// @Description 开启或关闭用户的终端录制
+// @Accept json
+// @Produce json
+// @Param request body model.UpdateUserRecording true "request"
+// @Success 200
+// @Router /users/recording [put]
+func (b *BaseApi) SetUserRecording(c *gin.Context) {
+	var req model.UpdateUserRecording
+	if err := CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	if err := userService.SetRecording(req); err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, nil)
+}
@@ -2,6 +2,7 @@ package entry
 
 import (
 	"net/http"
+	"path/filepath"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -240,3 +241,90 @@ func (b *BaseApi) InstallTerminal(c *gin.Context) {
 	}
 	SuccessWithData(c, result)
 }
+
+// @Tags Terminal
+// @Summary List terminal recordings
+// @Description List terminal recordings of host
+// @Accept json
+// @Produce json
+// @Param host path uint true "Host ID"
+// @Param page query int true "Page number"
+// @Param page_size query int true "Page size"
+// @Success 200 {object} model.PageResult
+// @Router /terminals/{host}/recordings [get]
+func (b *BaseApi) TerminalRecordings(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host id", err)
+		return
+	}
+
+	var req model.PageInfo
+	if err := CheckQueryAndValidate(&req, c); err != nil {
+		return
+	}
+
+	result, err := terminalService.Recordings(uint(hostID), req)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	SuccessWithData(c, result)
+}
+
+// @Tags Terminal
+// @Summary Download terminal recording
+// @Description Download terminal recording as asciicast v2 file
+// @Accept json
+// @Produce application/octet-stream
+// @Param host path uint true "Host ID"
+// @Param id query uint true "Recording ID"
+// @Success 200 {file} file
+// @Router /terminals/{host}/recordings/download [get]
+func (b *BaseApi) DownloadTerminalRecording(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host id", err)
+		return
+	}
+	id, err := strconv.ParseUint(c.Query("id"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid recording id", err)
+		return
+	}
+
+	path, err := terminalService.RecordingPath(uint(hostID), uint(id))
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	c.FileAttachment(path, filepath.Base(path))
+}
+
+// @Tags Terminal
+// @Summary Stream terminal recording
+// @Description Stream terminal recording line by line through SSE, follows the recording until the session ends
+// @Accept json
+// @Produce text/event-stream
+// @Param host path uint true "Host ID"
+// @Param id query uint true "Recording ID"
+// @Success 200
+// @Router /terminals/{host}/recordings/stream [get]
+func (b *BaseApi) StreamTerminalRecording(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host id", err)
+		return
+	}
+	id, err := strconv.ParseUint(c.Query("id"), 10, 32)
+	if err != nil {
+		ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid recording id", err)
+		return
+	}
+
+	if err := terminalService.StreamRecording(c, uint(hostID), uint(id)); err != nil {
+		global.LOG.Error("Stream terminal recording failed: %v", err)
+		ErrorWithDetail(c, http.StatusInternalServerError, "Failed to stream recording", err)
+		return
+	}
+}
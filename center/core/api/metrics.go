@@ -0,0 +1,114 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sensdata/idb/center/core/conn"
+	coreplugin "github.com/sensdata/idb/center/core/plugin"
+	"github.com/sensdata/idb/center/db/repo"
+	"github.com/sensdata/idb/center/global"
+	"github.com/sensdata/idb/core/model"
+)
+
+// hostGauge 从最近一次心跳读取的设备指标
+type hostGauge struct {
+	desc  *prometheus.Desc
+	value func(status *model.HostStatusInfo) float64
+}
+
+func newHostDesc(name string, help string) *prometheus.Desc {
+	return prometheus.NewDesc(name, help, []string{"host_id", "host"}, nil)
+}
+
+// fleetCollector 在采集时读取设备状态、待响应请求数及插件状态
+type fleetCollector struct {
+	hostGauges    []hostGauge
+	connected     *prometheus.Desc
+	lastHeartbeat *prometheus.Desc
+	pending       *prometheus.Desc
+	pluginUp      *prometheus.Desc
+}
+
+func newFleetCollector() *fleetCollector {
+	return &fleetCollector{
+		hostGauges: []hostGauge{
+			{newHostDesc("idb_host_cpu_usage_percent", "CPU usage of the host."),
+				func(s *model.HostStatusInfo) float64 { return s.Cpu }},
+			{newHostDesc("idb_host_memory_usage_percent", "Memory usage of the host."),
+				func(s *model.HostStatusInfo) float64 { return s.Memory }},
+			{newHostDesc("idb_host_disk_usage_percent", "Disk usage of the host."),
+				func(s *model.HostStatusInfo) float64 { return s.Disk }},
+			{newHostDesc("idb_host_network_receive_rate", "Network receive rate of the host."),
+				func(s *model.HostStatusInfo) float64 { return s.Rx }},
+			{newHostDesc("idb_host_network_transmit_rate", "Network transmit rate of the host."),
+				func(s *model.HostStatusInfo) float64 { return s.Tx }},
+			{newHostDesc("idb_agent_process_rss_bytes", "Resident memory of the agent process."),
+				func(s *model.HostStatusInfo) float64 { return float64(s.ProcessRSS) }},
+			{newHostDesc("idb_agent_heap_alloc_bytes", "Heap bytes allocated by the agent."),
+				func(s *model.HostStatusInfo) float64 { return float64(s.HeapAlloc) }},
+			{newHostDesc("idb_agent_goroutines", "Goroutines of the agent."),
+				func(s *model.HostStatusInfo) float64 { return float64(s.Goroutines) }},
+			{newHostDesc("idb_agent_open_fds", "Open file descriptors of the agent."),
+				func(s *model.HostStatusInfo) float64 { return float64(s.OpenFDs) }},
+			{newHostDesc("idb_agent_active_sessions", "Active terminal sessions on the agent."),
+				func(s *model.HostStatusInfo) float64 { return float64(s.ActiveSessions) }},
+			{newHostDesc("idb_agent_active_log_followers", "Active log followers on the agent."),
+				func(s *model.HostStatusInfo) float64 { return float64(s.ActiveLogFollowers) }},
+		},
+		connected:     newHostDesc("idb_agent_connected", "Whether the agent is connected (1) or not (0)."),
+		lastHeartbeat: newHostDesc("idb_agent_last_heartbeat_timestamp_seconds", "Unix time of the last heartbeat from the agent."),
+		pending:       prometheus.NewDesc("idb_center_pending_responses", "Requests waiting for an agent response.", nil, nil),
+		pluginUp:      prometheus.NewDesc("idb_plugin_up", "Whether the plugin process is running (1) or not (0).", []string{"plugin"}, nil),
+	}
+}
+
+func (f *fleetCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, g := range f.hostGauges {
+		ch <- g.desc
+	}
+	ch <- f.connected
+	ch <- f.lastHeartbeat
+	ch <- f.pending
+	ch <- f.pluginUp
+}
+
+// Collect 离线设备只输出连接状态，不输出过期的心跳数据
+func (f *fleetCollector) Collect(ch chan<- prometheus.Metric) {
+	hostRepo := repo.NewHostRepo()
+	hosts, err := hostRepo.GetList()
+	if err != nil {
+		global.LOG.Error("Failed to list hosts for metrics: %v", err)
+	}
+	for _, host := range hosts {
+		labels := []string{strconv.FormatUint(uint64(host.ID), 10), host.Name}
+		status := global.GetHostStatus(host.ID)
+		online := status != nil && status.Connected == "online"
+
+		connected := 0.0
+		if online {
+			connected = 1
+		}
+		ch <- prometheus.MustNewConstMetric(f.connected, prometheus.GaugeValue, connected, labels...)
+		if status != nil && status.LastHeartbeat > 0 {
+			ch <- prometheus.MustNewConstMetric(f.lastHeartbeat, prometheus.GaugeValue, float64(status.LastHeartbeat), labels...)
+		}
+		if !online {
+			continue
+		}
+		for _, g := range f.hostGauges {
+			ch <- prometheus.MustNewConstMetric(g.desc, prometheus.GaugeValue, g.value(status), labels...)
+		}
+	}
+
+	if conn.CENTER != nil {
+		ch <- prometheus.MustNewConstMetric(f.pending, prometheus.GaugeValue, float64(conn.CENTER.PendingResponses()))
+	}
+	for name, up := range coreplugin.PLUGINSERVER.Health() {
+		value := 0.0
+		if up {
+			value = 1
+		}
+		ch <- prometheus.MustNewConstMetric(f.pluginUp, prometheus.GaugeValue, value, name)
+	}
+}
@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/db/repo"
+	"github.com/sensdata/idb/center/global"
+	"github.com/sensdata/idb/core/constant"
+	core "github.com/sensdata/idb/core/model"
+	"github.com/sensdata/idb/core/utils"
+)
+
+const (
+	// maxAuditBodySize 超过此大小的请求体不记录参数
+	maxAuditBodySize = 1 << 20
+	// maxAuditRespSize 仅缓存响应的前若干字节用于解析结果码
+	maxAuditRespSize = 4096
+)
+
+// auditWriter 在写出响应的同时缓存开头部分，用于解析 model.Response 中的 code
+type auditWriter struct {
+	gin.ResponseWriter
+	buf bytes.Buffer
+}
+
+func (w *auditWriter) Write(b []byte) (int, error) {
+	if remain := maxAuditRespSize - w.buf.Len(); remain > 0 {
+		if len(b) > remain {
+			w.buf.Write(b[:remain])
+		} else {
+			w.buf.Write(b)
+		}
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// AuditLogger 将变更类请求（非 GET）写入审计表
+// 插件通过内部令牌回调的请求不记录，由 Center 指令下发处统一记录
+func AuditLogger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		method := c.Request.Method
+		if method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions {
+			c.Next()
+			return
+		}
+
+		path := c.Request.URL.Path
+		params := ""
+		isMultipart := strings.HasPrefix(c.ContentType(), "multipart/")
+		if !isSensitivePath(path) && !isMultipart && c.Request.Body != nil && c.Request.ContentLength <= maxAuditBodySize {
+			bodyBytes, err := io.ReadAll(c.Request.Body)
+			if err != nil {
+				global.LOG.Warn("Failed to read request body for audit: %v", err)
+				c.Request.Body = io.NopCloser(bytes.NewBuffer(nil))
+			} else {
+				c.Request.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+				params = utils.RedactJSON(string(bodyBytes))
+			}
+		}
+
+		writer := &auditWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		c.Next()
+
+		if c.GetBool("internal") {
+			return
+		}
+
+		if isMultipart && c.Request.MultipartForm != nil {
+			params = multipartParams(c)
+		}
+		if len(c.Request.URL.RawQuery) > 0 && !isSensitivePath(path) {
+			query, _ := json.Marshal(utils.RedactValues(c.Request.URL.Query()))
+			params = strings.TrimSpace(fmt.Sprintf("%s query=%s", params, query))
+		}
+
+		route := c.FullPath()
+		if route == "" {
+			route = path
+		}
+		entry := model.AuditLog{
+			SourceIP: c.ClientIP(),
+			Source:   constant.AuditSourceApi,
+			Plugin:   routeResource(route),
+			Route:    route,
+			Method:   method,
+			Action:   handlerName(c.HandlerName()),
+			Params:   truncateString(params),
+		}
+		if claims, ok := c.Get("user"); ok {
+			if userClaims, ok := claims.(*utils.Claims); ok {
+				entry.UserID = userClaims.ID
+				entry.UserName = userClaims.Name
+			}
+		}
+		if hostID, err := strconv.ParseUint(c.Param("host"), 10, 32); err == nil {
+			entry.HostID = uint(hostID)
+		}
+		entry.Result, entry.Message = responseResult(c.Writer.Status(), writer.buf.Bytes())
+
+		if err := repo.NewAuditLogRepo().Create(&entry); err != nil {
+			global.LOG.Error("Failed to write audit log: %v", err)
+		}
+	}
+}
+
+// responseResult 根据 HTTP 状态码及 model.Response 的 code 判断执行结果
+func responseResult(status int, body []byte) (string, string) {
+	if status >= http.StatusBadRequest {
+		return constant.AuditResultFailed, http.StatusText(status)
+	}
+	var resp core.Response
+	if err := json.Unmarshal(body, &resp); err != nil {
+		// 非 JSON 或被截断的响应，以状态码为准
+		return constant.AuditResultSuccess, ""
+	}
+	if resp.Code != constant.CodeSuccess {
+		return constant.AuditResultFailed, resp.Message
+	}
+	return constant.AuditResultSuccess, resp.Message
+}
+
+func multipartParams(c *gin.Context) string {
+	form := c.Request.MultipartForm
+	files := make([]string, 0)
+	for _, headers := range form.File {
+		for _, h := range headers {
+			files = append(files, h.Filename)
+		}
+	}
+	data, _ := json.Marshal(map[string]interface{}{
+		"form":  utils.RedactValues(form.Value),
+		"files": files,
+	})
+	return string(data)
+}
+
+// handlerName 从 gin 的处理函数全名中取出方法名，如 CreateHost
+func handlerName(name string) string {
+	if idx := strings.LastIndex(name, "."); idx >= 0 {
+		name = name[idx+1:]
+	}
+	return strings.TrimSuffix(name, "-fm")
+}
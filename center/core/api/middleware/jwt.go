@@ -1,13 +1,26 @@
 package middleware
 
 import (
+	"crypto/subtle"
+	"errors"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/db/repo"
 	"github.com/sensdata/idb/center/global"
+	"github.com/sensdata/idb/core/constant"
 	"github.com/sensdata/idb/core/utils"
 )
 
+// accessTokenKey 存放当前请求所用访问令牌的 context key
+const accessTokenKey = "access_token"
+
+// lastUsedInterval 访问令牌及登录会话最近使用时间的更新间隔，避免每个请求都写库
+const lastUsedInterval = time.Minute
+
 type JWT struct{}
 
 func NewJWT() *JWT {
@@ -17,6 +30,13 @@ func NewJWT() *JWT {
 // to check JWT tokens
 func (j *JWT) JWTAuth() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		// 进程内插件回调
+		if isInternalRequest(c) {
+			c.Set("internal", true)
+			c.Next()
+			return
+		}
+
 		var token string
 		var err error
 		token = c.GetHeader("Authorization")
@@ -30,6 +50,20 @@ func (j *JWT) JWTAuth() gin.HandlerFunc {
 			}
 		}
 
+		// 个人访问令牌
+		if pat := strings.TrimPrefix(token, "Bearer "); strings.HasPrefix(pat, constant.AccessTokenPrefix) {
+			accessToken, user, err := validateAccessToken(pat)
+			if err != nil {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+				c.Abort()
+				return
+			}
+			c.Set("user", &utils.Claims{ID: user.ID, Name: user.Name})
+			c.Set(accessTokenKey, accessToken)
+			c.Next()
+			return
+		}
+
 		claims, err := utils.ValidateJWT(token, global.JWTKey)
 		if err != nil {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
@@ -37,9 +71,67 @@ func (j *JWT) JWTAuth() gin.HandlerFunc {
 			return
 		}
 
+		// 会话被吊销后 token 立即失效
+		if err := validateSession(claims.RegisteredClaims.ID); err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			c.Abort()
+			return
+		}
+
 		c.Set("user", claims)
 		c.Next()
 	}
 }
 
+// validateAccessToken 按摘要查找访问令牌并检查有效期
+func validateAccessToken(token string) (*model.AccessToken, *model.User, error) {
+	tokenRepo := repo.NewAccessTokenRepo()
+	accessToken, err := tokenRepo.Get(tokenRepo.WithByTokenHash(utils.HashToken(token)))
+	if err != nil {
+		return nil, nil, errors.New("Invalid token")
+	}
+	now := time.Now()
+	if accessToken.ExpiresAt != nil && now.After(*accessToken.ExpiresAt) {
+		return nil, nil, errors.New("Token expired")
+	}
+	userRepo := repo.NewUserRepo()
+	user, err := userRepo.Get(userRepo.WithByID(accessToken.UserID))
+	if err != nil {
+		return nil, nil, errors.New("Invalid token")
+	}
+	if accessToken.LastUsedAt == nil || now.Sub(*accessToken.LastUsedAt) > lastUsedInterval {
+		if err := tokenRepo.Update(accessToken.ID, map[string]interface{}{"last_used_at": now}); err != nil {
+			global.LOG.Error("Failed to update last used time of token %d: %v", accessToken.ID, err)
+		}
+	}
+	return &accessToken, &user, nil
+}
+
+// validateSession 检查 token 对应的登录会话是否仍然存在
+func validateSession(sessionID string) error {
+	if sessionID == "" {
+		return errors.New("Invalid token")
+	}
+	sessionRepo := repo.NewLoginSessionRepo()
+	session, err := sessionRepo.Get(sessionRepo.WithBySessionID(sessionID))
+	if err != nil {
+		return errors.New("Session revoked")
+	}
+	now := time.Now()
+	if session.LastSeenAt == nil || now.Sub(*session.LastSeenAt) > lastUsedInterval {
+		if err := sessionRepo.Update(session.ID, map[string]interface{}{"last_seen_at": now}); err != nil {
+			global.LOG.Error("Failed to update last seen time of session %d: %v", session.ID, err)
+		}
+	}
+	return nil
+}
+
+func isInternalRequest(c *gin.Context) bool {
+	token := c.GetHeader(global.InternalTokenHeader)
+	if token == "" || global.InternalToken == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(global.InternalToken)) == 1
+}
+
 // Additional middlewares such as logging, error handling can be defined here
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"path"
 	"strings"
 	"time"
 
@@ -43,8 +44,24 @@ var logWhitelist = []string{
 // sensitivePaths 定义敏感路径，这些路径的 Query 和 Body 不会被记录
 // 即使它们在白名单中，也不会记录详细信息
 var sensitivePaths = []string{
-	"api/v1/auth/sessions",  // 登录接口，包含密码
-	"api/v1/users/password", // 更新密码接口，包含密码
+	"api/v1/auth/sessions",   // 登录接口，包含密码
+	"api/v1/users/password",  // 更新密码接口，包含密码
+	"api/v1/users/2fa",       // 两步验证接口，包含验证码或恢复码
+	"api/v1/auth/oidc",       // 单点登录回调，包含授权码
+	"api/v1/settings/oidc",   // 单点登录配置，包含 client secret
+	"api/v1/settings/ldap",   // LDAP 配置，包含服务账号密码
+	"api/v1/alerts/channels", // 通知渠道配置，包含密钥及密码
+	"api/v1/backups",         // 备份仓库配置，包含仓库密码及访问密钥
+}
+
+// sensitivePatterns 含设备参数的敏感路径，按 path.Match 规则匹配
+var sensitivePatterns = []string{
+	"api/v1/mysql/*/password",            // 数据库 root 密码
+	"api/v1/mysql/*/users",               // 创建数据库用户，包含密码
+	"api/v1/mysql/*/users/password",      // 修改数据库用户密码
+	"api/v1/postgresql/*/users",          // 创建数据库用户，包含密码
+	"api/v1/postgresql/*/users/password", // 修改数据库用户密码
+	"api/v1/redis/*/password",            // Redis 密码
 }
 
 // RequestLogger 返回一个日志中间件
@@ -136,9 +153,9 @@ func isWhitelisted(path string) bool {
 
 // isSensitivePath 检查路径是否是敏感路径
 // 敏感路径的 Query 和 Body 不会被记录
-func isSensitivePath(path string) bool {
+func isSensitivePath(reqPath string) bool {
 	// 规范化路径：移除前导斜杠（如果存在）
-	normalizedPath := strings.TrimPrefix(path, "/")
+	normalizedPath := strings.TrimPrefix(reqPath, "/")
 
 	for _, sensitivePath := range sensitivePaths {
 		// 完整路径匹配
@@ -150,6 +167,11 @@ func isSensitivePath(path string) bool {
 			return true
 		}
 	}
+	for _, pattern := range sensitivePatterns {
+		if matched, _ := path.Match(pattern, normalizedPath); matched {
+			return true
+		}
+	}
 	return false
 }
 
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/center/core/monitor"
+	"github.com/sensdata/idb/center/db/repo"
+	"github.com/sensdata/idb/core/utils"
+)
+
+// RequestMetrics 记录请求耗时，未匹配路由的请求统一记为 unmatched，避免标签数量失控
+func RequestMetrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		monitor.RequestDuration.
+			WithLabelValues(c.Request.Method, route, strconv.Itoa(c.Writer.Status())).
+			Observe(time.Since(start).Seconds())
+	}
+}
+
+// MetricsAuth 校验 Prometheus 采集令牌，未生成令牌时不开放采集
+func MetricsAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		settingRepo := repo.NewSettingsRepo()
+		setting, err := settingRepo.Get(settingRepo.WithByKey("MetricsTokenHash"))
+		if err != nil || setting.Value == "" {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Metrics disabled"})
+			return
+		}
+
+		token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if token == "" || subtle.ConstantTimeCompare([]byte(utils.HashToken(token)), []byte(setting.Value)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid metrics token"})
+			return
+		}
+		c.Next()
+	}
+}
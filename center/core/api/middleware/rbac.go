@@ -0,0 +1,324 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/db/repo"
+	"github.com/sensdata/idb/core/constant"
+	"github.com/sensdata/idb/core/utils"
+)
+
+// systemResources 不被通配符 * 覆盖，必须单独授权
+var systemResources = []string{
+	"users",
+	"settings",
+	"audit",
+}
+
+// auditResourcePaths 终端录像包含输入内容（如 sudo、ssh 提示时输入的密码），录像的查看及录制开关
+// 归入 audit 资源，不随所在路由组的权限或通配符 * 授予
+var auditResourcePaths = []string{
+	"/api/v1/terminals/:host/recordings",
+	"/api/v1/terminals/:host/recordings/download",
+	"/api/v1/terminals/:host/recordings/stream",
+	"/api/v1/hosts/:host/conf/recording",
+	"/api/v1/users/recording",
+}
+
+// selfServicePaths 任何已登录用户都可访问的路由（仅作用于自身账号）
+var selfServicePaths = []string{
+	"/api/v1/users/profile",
+	"/api/v1/users/password",
+	"/api/v1/users/2fa/setup",
+	"/api/v1/users/2fa/enable",
+	"/api/v1/users/2fa/disable",
+	"/api/v1/users/2fa/recovery_codes",
+	"/api/v1/users/tokens",
+	"/api/v1/users/profile/sessions",
+}
+
+// twoFactorSetupPaths 系统强制两步验证时，尚未绑定的用户仅可访问这些路由
+var twoFactorSetupPaths = []string{
+	"/api/v1/users/profile",
+	"/api/v1/users/2fa/setup",
+	"/api/v1/users/2fa/enable",
+}
+
+// hostGroupScopeKey 存放当前用户可访问设备组的 context key
+const hostGroupScopeKey = "host_groups"
+
+type RBAC struct{}
+
+func NewRBAC() *RBAC {
+	return &RBAC{}
+}
+
+// RBACAuth 根据路由组和请求方法校验当前用户角色的权限，需在 JWTAuth 之后使用
+func (r *RBAC) RBACAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetBool("internal") {
+			c.Next()
+			return
+		}
+
+		claims, ok := c.Get("user")
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Token required"})
+			c.Abort()
+			return
+		}
+		userClaims, ok := claims.(*utils.Claims)
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+			c.Abort()
+			return
+		}
+
+		userRepo := repo.NewUserRepo()
+		user, err := userRepo.Get(userRepo.WithByID(userClaims.ID))
+		if err != nil || user.Valid == 0 {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "User not found or disabled"})
+			c.Abort()
+			return
+		}
+
+		// 单点登录的用户由身份源负责多因素认证
+		isOidc := user.Source == constant.UserSourceOidc
+		if !user.TotpEnabled && !isOidc && twoFactorRequired() && !containsPath(twoFactorSetupPaths, c.FullPath()) {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Two-factor authentication setup required"})
+			c.Abort()
+			return
+		}
+
+		roleRepo := repo.NewRoleRepo()
+		role, err := roleRepo.Get(roleRepo.WithByID(user.RoleID))
+		if err != nil {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Role not found"})
+			c.Abort()
+			return
+		}
+		c.Set("role", role.Name)
+
+		// 访问令牌不能管理账号自身，只读令牌不能执行写操作
+		accessToken := currentAccessToken(c)
+		if accessToken != nil {
+			if isSelfServicePath(c.FullPath()) {
+				c.JSON(http.StatusForbidden, gin.H{"error": "Not allowed with access token"})
+				c.Abort()
+				return
+			}
+			if accessToken.Access == constant.AccessRead && requestAccess(c) == constant.AccessWrite {
+				c.JSON(http.StatusForbidden, gin.H{"error": "Read-only access token"})
+				c.Abort()
+				return
+			}
+		}
+
+		isAdmin := role.Name == constant.RoleAdmin
+		if !isAdmin && !isSelfServicePath(c.FullPath()) {
+			resource := permissionResource(c.FullPath())
+			access := requestAccess(c)
+			permRepo := repo.NewPermissionRepo()
+			permissions, err := permRepo.GetList(permRepo.WithByRoleID(role.ID))
+			if err != nil || !Allowed(permissions, resource, access) {
+				c.JSON(http.StatusForbidden, gin.H{"error": "Permission denied"})
+				c.Abort()
+				return
+			}
+		}
+
+		// 设备组范围，管理员不受用户及角色范围限制，但受访问令牌范围限制
+		var groups []uint
+		if !isAdmin {
+			groups, err = hostGroupScope(user.ID, role.ID)
+		}
+		if err == nil && accessToken != nil {
+			groups, err = tokenGroupScope(accessToken.ID, groups)
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load host scope"})
+			c.Abort()
+			return
+		}
+		if groups != nil {
+			c.Set(hostGroupScopeKey, groups)
+			if hostParam := c.Param("host"); hostParam != "" && !hostInScope(hostParam, groups) {
+				c.JSON(http.StatusForbidden, gin.H{"error": "Host out of scope"})
+				c.Abort()
+				return
+			}
+		}
+		c.Next()
+	}
+}
+
+// HostGroupScope 返回当前请求可访问的设备组，nil 表示不受限制
+func HostGroupScope(c *gin.Context) []uint {
+	groups, ok := c.Get(hostGroupScopeKey)
+	if !ok {
+		return nil
+	}
+	return groups.([]uint)
+}
+
+// HostAllowed 判断当前请求是否可以访问设备
+func HostAllowed(c *gin.Context, hostID uint) bool {
+	groups := HostGroupScope(c)
+	if groups == nil {
+		return true
+	}
+	hostRepo := repo.NewHostRepo()
+	host, err := hostRepo.Get(hostRepo.WithByID(hostID))
+	if err != nil {
+		return false
+	}
+	return containsID(groups, host.GroupID)
+}
+
+// hostGroupScope 合并用户与角色绑定的设备组，均未绑定时返回 nil
+func hostGroupScope(userID uint, roleID uint) ([]uint, error) {
+	scopeRepo := repo.NewHostScopeRepo()
+	userScopes, err := scopeRepo.GetList(scopeRepo.WithByUserID(userID))
+	if err != nil {
+		return nil, err
+	}
+	roleScopes, err := scopeRepo.GetList(scopeRepo.WithByRoleID(roleID))
+	if err != nil {
+		return nil, err
+	}
+	if len(userScopes) == 0 && len(roleScopes) == 0 {
+		return nil, nil
+	}
+	groups := make([]uint, 0, len(userScopes)+len(roleScopes))
+	for _, scope := range append(userScopes, roleScopes...) {
+		if !containsID(groups, scope.HostGroupID) {
+			groups = append(groups, scope.HostGroupID)
+		}
+	}
+	return groups, nil
+}
+
+// tokenGroupScope 访问令牌绑定了设备组时，与用户的范围取交集
+func tokenGroupScope(tokenID uint, groups []uint) ([]uint, error) {
+	scopeRepo := repo.NewHostScopeRepo()
+	tokenScopes, err := scopeRepo.GetList(scopeRepo.WithByTokenID(tokenID))
+	if err != nil {
+		return nil, err
+	}
+	if len(tokenScopes) == 0 {
+		return groups, nil
+	}
+	result := make([]uint, 0, len(tokenScopes))
+	for _, scope := range tokenScopes {
+		if (groups == nil || containsID(groups, scope.HostGroupID)) && !containsID(result, scope.HostGroupID) {
+			result = append(result, scope.HostGroupID)
+		}
+	}
+	return result, nil
+}
+
+func currentAccessToken(c *gin.Context) *model.AccessToken {
+	token, ok := c.Get(accessTokenKey)
+	if !ok {
+		return nil
+	}
+	return token.(*model.AccessToken)
+}
+
+func hostInScope(hostParam string, groups []uint) bool {
+	hostID, err := strconv.ParseUint(hostParam, 10, 32)
+	if err != nil {
+		return false
+	}
+	hostRepo := repo.NewHostRepo()
+	host, err := hostRepo.Get(hostRepo.WithByID(uint(hostID)))
+	if err != nil {
+		return false
+	}
+	return containsID(groups, host.GroupID)
+}
+
+func containsID(ids []uint, id uint) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Allowed 判断权限列表是否允许以 access 方式访问 resource，write 权限包含 read
+func Allowed(permissions []model.Permission, resource string, access string) bool {
+	for _, p := range permissions {
+		if p.Resource != resource && (p.Resource != constant.ResourceAll || isSystemResource(resource)) {
+			continue
+		}
+		if p.Access == constant.AccessWrite || p.Access == access {
+			return true
+		}
+	}
+	return false
+}
+
+// routeResource 取 api/v1 之后的第一段路由作为资源名
+func routeResource(fullPath string) string {
+	path := strings.TrimPrefix(fullPath, "/api/v1/")
+	if i := strings.Index(path, "/"); i >= 0 {
+		path = path[:i]
+	}
+	return path
+}
+
+// permissionResource 路由对应的权限资源，终端录像相关的路由为 audit
+func permissionResource(fullPath string) string {
+	if containsPath(auditResourcePaths, fullPath) {
+		return "audit"
+	}
+	return routeResource(fullPath)
+}
+
+// requestAccess GET 类请求为 read，websocket 升级（终端）及其他方法均为 write
+func requestAccess(c *gin.Context) string {
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		if websocket.IsWebSocketUpgrade(c.Request) {
+			return constant.AccessWrite
+		}
+		return constant.AccessRead
+	default:
+		return constant.AccessWrite
+	}
+}
+
+func isSystemResource(resource string) bool {
+	for _, r := range systemResources {
+		if r == resource {
+			return true
+		}
+	}
+	return false
+}
+
+func isSelfServicePath(fullPath string) bool {
+	return containsPath(selfServicePaths, fullPath)
+}
+
+func twoFactorRequired() bool {
+	settingRepo := repo.NewSettingsRepo()
+	setting, err := settingRepo.Get(settingRepo.WithByKey("TwoFactorRequired"))
+	return err == nil && setting.Value == "yes"
+}
+
+func containsPath(paths []string, path string) bool {
+	for _, p := range paths {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
@@ -29,5 +29,9 @@ func commonGroups() []CommonRouter {
 		&RsyncRouter{},
 		&RsyncClientRouter{},
 		&PmaRouter{},
+		&AuditRouter{},
+		&BatchRouter{},
+		&AlertRouter{},
+		&BackupRouter{},
 	}
 }
@@ -3,12 +3,14 @@ package router
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/sensdata/idb/center/core/api/entry"
+	"github.com/sensdata/idb/center/core/api/middleware"
 )
 
 type ActionRouter struct{}
 
 func (s *ActionRouter) InitRouter(Router *gin.RouterGroup) {
 	actionRouter := Router.Group("actions")
+	actionRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
 	baseApi := entry.ApiGroup
 	{
 		actionRouter.POST("", baseApi.SendAction)               // 向目标设备发送action指令
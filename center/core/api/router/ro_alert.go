@@ -0,0 +1,30 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/center/core/api/entry"
+	"github.com/sensdata/idb/center/core/api/middleware"
+)
+
+type AlertRouter struct{}
+
+func (s *AlertRouter) InitRouter(Router *gin.RouterGroup) {
+	alertRouter := Router.Group("alerts")
+	alertRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
+	baseApi := entry.ApiGroup
+	{
+		alertRouter.GET("/rules", baseApi.ListAlertRule)             // 告警规则列表
+		alertRouter.POST("/rules", baseApi.CreateAlertRule)          // 创建告警规则
+		alertRouter.PUT("/rules", baseApi.UpdateAlertRule)           // 修改告警规则
+		alertRouter.DELETE("/rules", baseApi.DeleteAlertRule)        // 删除告警规则
+		alertRouter.GET("/channels", baseApi.ListAlertChannel)       // 通知渠道列表
+		alertRouter.POST("/channels", baseApi.CreateAlertChannel)    // 创建通知渠道
+		alertRouter.PUT("/channels", baseApi.UpdateAlertChannel)     // 修改通知渠道
+		alertRouter.DELETE("/channels", baseApi.DeleteAlertChannel)  // 删除通知渠道
+		alertRouter.POST("/channels/test", baseApi.TestAlertChannel) // 测试通知渠道
+		alertRouter.GET("/silences", baseApi.ListAlertSilence)       // 告警静默列表
+		alertRouter.POST("/silences", baseApi.CreateAlertSilence)    // 创建告警静默
+		alertRouter.DELETE("/silences", baseApi.DeleteAlertSilence)  // 删除告警静默
+		alertRouter.GET("/events", baseApi.ListAlertEvent)           // 告警记录
+	}
+}
@@ -10,7 +10,7 @@ type AppRouter struct{}
 
 func (s *AppRouter) InitRouter(Router *gin.RouterGroup) {
 	appRouter := Router.Group("store")
-	appRouter.Use(middleware.NewJWT().JWTAuth())
+	appRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
 	baseApi := entry.ApiGroup
 	{
 		appRouter.POST("/apps/sync", baseApi.SyncApp)                    // 同步Apps
@@ -0,0 +1,18 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/center/core/api/entry"
+	"github.com/sensdata/idb/center/core/api/middleware"
+)
+
+type AuditRouter struct{}
+
+func (s *AuditRouter) InitRouter(Router *gin.RouterGroup) {
+	auditRouter := Router.Group("audit")
+	auditRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
+	baseApi := entry.ApiGroup
+	{
+		auditRouter.GET("", baseApi.ListAuditLog) // 查询审计日志
+	}
+}
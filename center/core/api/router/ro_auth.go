@@ -13,6 +13,10 @@ func (s *AuthRouter) InitRouter(Router *gin.RouterGroup) {
 	baseApi := entry.ApiGroup
 	{
 		baseRouter.POST("/sessions", baseApi.Login)
+		baseRouter.POST("/sessions/2fa", baseApi.LoginTwoFactor)
 		baseRouter.DELETE("/sessions", middleware.NewJWT().JWTAuth(), baseApi.Logout)
+		baseRouter.GET("/oidc", baseApi.OidcStatus)
+		baseRouter.GET("/oidc/login", baseApi.OidcLogin)
+		baseRouter.GET("/oidc/callback", baseApi.OidcCallback)
 	}
 }
@@ -0,0 +1,25 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/center/core/api/entry"
+	"github.com/sensdata/idb/center/core/api/middleware"
+)
+
+type BackupRouter struct{}
+
+func (s *BackupRouter) InitRouter(Router *gin.RouterGroup) {
+	backupRouter := Router.Group("backups")
+	backupRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
+	baseApi := entry.ApiGroup
+	{
+		backupRouter.GET("/:host/jobs", baseApi.ListBackupJobs)
+		backupRouter.POST("/:host/jobs", baseApi.CreateBackupJob)
+		backupRouter.PUT("/:host/jobs", baseApi.UpdateBackupJob)
+		backupRouter.DELETE("/:host/jobs", baseApi.DeleteBackupJob)
+		backupRouter.POST("/:host/jobs/run", baseApi.RunBackupJob)
+		backupRouter.GET("/:host/snapshots", baseApi.ListBackupSnapshots)
+		backupRouter.GET("/:host/snapshots/files", baseApi.ListBackupSnapshotFiles)
+		backupRouter.POST("/:host/restore", baseApi.RestoreBackup)
+	}
+}
@@ -0,0 +1,20 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/center/core/api/entry"
+	"github.com/sensdata/idb/center/core/api/middleware"
+)
+
+type BatchRouter struct{}
+
+func (s *BatchRouter) InitRouter(Router *gin.RouterGroup) {
+	batchRouter := Router.Group("batch")
+	batchRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
+	baseApi := entry.ApiGroup
+	{
+		batchRouter.POST("", baseApi.ExecuteBatch)       // 批量执行
+		batchRouter.GET("", baseApi.ListBatchTask)       // 批量操作列表
+		batchRouter.GET("/result", baseApi.GetBatchTask) // 批量操作结果
+	}
+}
@@ -3,12 +3,14 @@ package router
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/sensdata/idb/center/core/api/entry"
+	"github.com/sensdata/idb/center/core/api/middleware"
 )
 
 type CommandRouter struct{}
 
 func (s *CommandRouter) InitRouter(Router *gin.RouterGroup) {
 	commandRouter := Router.Group("commands")
+	commandRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
 	baseApi := entry.ApiGroup
 	{
 		commandRouter.POST("", baseApi.SendCommand)            // 发送单个命令
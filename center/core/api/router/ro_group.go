@@ -10,7 +10,7 @@ type GroupRouter struct{}
 
 func (s *GroupRouter) InitRouter(Router *gin.RouterGroup) {
 	groupRouter := Router.Group("groups")
-	groupRouter.Use(middleware.NewJWT().JWTAuth())
+	groupRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
 	baseApi := entry.ApiGroup
 	{
 		groupRouter.GET("", baseApi.ListGroup)      // 获取组列表
@@ -10,7 +10,7 @@ type HomeRouter struct{}
 
 func (s *HomeRouter) InitRouter(Router *gin.RouterGroup) {
 	appRouter := Router.Group("home")
-	appRouter.Use(middleware.NewJWT().JWTAuth())
+	appRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
 	baseApi := entry.ApiGroup
 	{
 		appRouter.GET("/:host/managed/apps", baseApi.ManagedApps) //获取管理应用列表
@@ -10,7 +10,7 @@ type HostRouter struct{}
 
 func (s *HostRouter) InitRouter(Router *gin.RouterGroup) {
 	hostRouter := Router.Group("hosts")
-	hostRouter.Use(middleware.NewJWT().JWTAuth())
+	hostRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
 	baseApi := entry.ApiGroup
 	{
 		hostRouter.GET("/groups", baseApi.ListHostGroup)                        // 获取设备组列表
@@ -24,9 +24,12 @@ func (s *HostRouter) InitRouter(Router *gin.RouterGroup) {
 		hostRouter.GET("/status/follow", baseApi.StatusFollow)                  // 追踪所有设备状态
 		hostRouter.GET("/:host/status", baseApi.HostStatus)                     // 设备状态
 		hostRouter.GET("/:host/status/follow", baseApi.HostStatusFollow)        // 追踪设备状态
+		hostRouter.GET("/:host/metrics", baseApi.HostMetrics)                   // 设备历史指标
 		hostRouter.GET("/:host", baseApi.HostInfo)                              // 设备配置信息
 		hostRouter.PUT("/:host/conf/ssh", baseApi.UpdateHostSSH)                // 更新设备ssh配置
 		hostRouter.PUT("/:host/conf/agent", baseApi.UpdateHostAgent)            // 更新设备agent配置
+		hostRouter.PUT("/:host/conf/recording", baseApi.UpdateHostRecording)    // 开启/关闭设备终端录制
+		hostRouter.PUT("/:host/conf/tags", baseApi.UpdateHostTags)              // 设置设备标签
 		hostRouter.POST("/test/ssh", baseApi.TestHostSSH)                       // 测试设备ssh
 		hostRouter.POST("/:host/test/agent", baseApi.TestHostAgent)             // 测试设备agent
 		hostRouter.POST("/:host/agent/install", baseApi.InstallAgent)           // 安装agent
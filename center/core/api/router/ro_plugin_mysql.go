@@ -10,7 +10,7 @@ type MysqlRouter struct{}
 
 func (s *MysqlRouter) InitRouter(Router *gin.RouterGroup) {
 	mysqlRouter := Router.Group("mysql")
-	mysqlRouter.Use(middleware.NewJWT().JWTAuth())
+	mysqlRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
 	baseApi := entry.ApiGroup
 	{
 		mysqlRouter.GET("/:host", baseApi.MysqlComposes)
@@ -23,5 +23,22 @@ func (s *MysqlRouter) InitRouter(Router *gin.RouterGroup) {
 		mysqlRouter.GET("/:host/password", baseApi.MysqlGetRootPassword)
 		mysqlRouter.POST("/:host/password", baseApi.MysqlSetRootPassword)
 		mysqlRouter.GET("/:host/connection", baseApi.MysqlGetConnectionInfo)
+		mysqlRouter.POST("/:host/dumps", baseApi.MysqlDump)
+		mysqlRouter.GET("/:host/dumps", baseApi.MysqlListDumps)
+		mysqlRouter.DELETE("/:host/dumps", baseApi.MysqlDeleteDump)
+		mysqlRouter.POST("/:host/dumps/restore", baseApi.MysqlRestoreDump)
+		mysqlRouter.GET("/:host/dumps/schedule", baseApi.MysqlGetDumpSchedule)
+		mysqlRouter.POST("/:host/dumps/schedule", baseApi.MysqlSetDumpSchedule)
+		mysqlRouter.GET("/:host/databases", baseApi.MysqlListDatabases)
+		mysqlRouter.POST("/:host/databases", baseApi.MysqlCreateDatabase)
+		mysqlRouter.DELETE("/:host/databases", baseApi.MysqlDropDatabase)
+		mysqlRouter.GET("/:host/users", baseApi.MysqlListUsers)
+		mysqlRouter.POST("/:host/users", baseApi.MysqlCreateUser)
+		mysqlRouter.DELETE("/:host/users", baseApi.MysqlDropUser)
+		mysqlRouter.POST("/:host/users/grant", baseApi.MysqlGrantPrivileges)
+		mysqlRouter.POST("/:host/users/revoke", baseApi.MysqlRevokePrivileges)
+		mysqlRouter.POST("/:host/users/password", baseApi.MysqlSetUserPassword)
+		mysqlRouter.GET("/:host/connections", baseApi.MysqlListConnections)
+		mysqlRouter.GET("/:host/slow_log", baseApi.MysqlGetSlowLog)
 	}
 }
@@ -10,7 +10,7 @@ type PmaRouter struct{}
 
 func (s *PmaRouter) InitRouter(Router *gin.RouterGroup) {
 	pmaRouter := Router.Group("pma")
-	pmaRouter.Use(middleware.NewJWT().JWTAuth())
+	pmaRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
 	baseApi := entry.ApiGroup
 	{
 		pmaRouter.GET("/:host", baseApi.PmaComposes)
@@ -10,7 +10,7 @@ type PostgreSqlRouter struct{}
 
 func (s *PostgreSqlRouter) InitRouter(Router *gin.RouterGroup) {
 	postgresqlRouter := Router.Group("postgresql")
-	postgresqlRouter.Use(middleware.NewJWT().JWTAuth())
+	postgresqlRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
 	baseApi := entry.ApiGroup
 	{
 		postgresqlRouter.GET("/:host", baseApi.PostgreSqlComposes)
@@ -18,5 +18,22 @@ func (s *PostgreSqlRouter) InitRouter(Router *gin.RouterGroup) {
 		postgresqlRouter.POST("/:host/port", baseApi.PostgreSqlSetPort)
 		postgresqlRouter.GET("/:host/conf", baseApi.PostgreSqlGetConf)
 		postgresqlRouter.POST("/:host/conf", baseApi.PostgreSqlSetConf)
+		postgresqlRouter.POST("/:host/dumps", baseApi.PostgreSqlDump)
+		postgresqlRouter.GET("/:host/dumps", baseApi.PostgreSqlListDumps)
+		postgresqlRouter.DELETE("/:host/dumps", baseApi.PostgreSqlDeleteDump)
+		postgresqlRouter.POST("/:host/dumps/restore", baseApi.PostgreSqlRestoreDump)
+		postgresqlRouter.GET("/:host/dumps/schedule", baseApi.PostgreSqlGetDumpSchedule)
+		postgresqlRouter.POST("/:host/dumps/schedule", baseApi.PostgreSqlSetDumpSchedule)
+		postgresqlRouter.GET("/:host/databases", baseApi.PostgreSqlListDatabases)
+		postgresqlRouter.POST("/:host/databases", baseApi.PostgreSqlCreateDatabase)
+		postgresqlRouter.DELETE("/:host/databases", baseApi.PostgreSqlDropDatabase)
+		postgresqlRouter.GET("/:host/users", baseApi.PostgreSqlListUsers)
+		postgresqlRouter.POST("/:host/users", baseApi.PostgreSqlCreateUser)
+		postgresqlRouter.DELETE("/:host/users", baseApi.PostgreSqlDropUser)
+		postgresqlRouter.POST("/:host/users/grant", baseApi.PostgreSqlGrantPrivileges)
+		postgresqlRouter.POST("/:host/users/revoke", baseApi.PostgreSqlRevokePrivileges)
+		postgresqlRouter.POST("/:host/users/password", baseApi.PostgreSqlSetUserPassword)
+		postgresqlRouter.GET("/:host/connections", baseApi.PostgreSqlListConnections)
+		postgresqlRouter.GET("/:host/slow_log", baseApi.PostgreSqlGetSlowLog)
 	}
 }
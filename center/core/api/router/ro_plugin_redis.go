@@ -10,7 +10,7 @@ type RedisRouter struct{}
 
 func (s *RedisRouter) InitRouter(Router *gin.RouterGroup) {
 	redisRouter := Router.Group("redis")
-	redisRouter.Use(middleware.NewJWT().JWTAuth())
+	redisRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
 	baseApi := entry.ApiGroup
 	{
 		redisRouter.GET("/:host", baseApi.RedisComposes)
@@ -22,5 +22,20 @@ func (s *RedisRouter) InitRouter(Router *gin.RouterGroup) {
 		redisRouter.POST("/:host/remote_access", baseApi.RedisSetRemoteAccess)
 		redisRouter.GET("/:host/password", baseApi.RedisGetRootPassword)
 		redisRouter.POST("/:host/password", baseApi.RedisSetRootPassword)
+		redisRouter.POST("/:host/dumps", baseApi.RedisDump)
+		redisRouter.GET("/:host/dumps", baseApi.RedisListDumps)
+		redisRouter.DELETE("/:host/dumps", baseApi.RedisDeleteDump)
+		redisRouter.POST("/:host/dumps/restore", baseApi.RedisRestoreDump)
+		redisRouter.GET("/:host/dumps/schedule", baseApi.RedisGetDumpSchedule)
+		redisRouter.POST("/:host/dumps/schedule", baseApi.RedisSetDumpSchedule)
+		redisRouter.GET("/:host/info", baseApi.RedisInfo)
+		redisRouter.GET("/:host/keyspace", baseApi.RedisKeyspace)
+		redisRouter.GET("/:host/keys", baseApi.RedisScanKeys)
+		redisRouter.GET("/:host/keys/value", baseApi.RedisGetKey)
+		redisRouter.POST("/:host/keys/ttl", baseApi.RedisSetTTL)
+		redisRouter.GET("/:host/slowlog", baseApi.RedisSlowlog)
+		redisRouter.GET("/:host/clients", baseApi.RedisClients)
+		redisRouter.POST("/:host/clients/kill", baseApi.RedisKillClient)
+		redisRouter.GET("/:host/bigkeys", baseApi.RedisBigKeys)
 	}
 }
@@ -10,7 +10,7 @@ type RsyncRouter struct{}
 
 func (s *RsyncRouter) InitRouter(Router *gin.RouterGroup) {
 	rsyncRouter := Router.Group("transfer")
-	rsyncRouter.Use(middleware.NewJWT().JWTAuth())
+	rsyncRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
 	baseApi := entry.ApiGroup
 	{
 		rsyncRouter.GET("/task", baseApi.TransferListTask)
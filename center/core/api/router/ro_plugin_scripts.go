@@ -10,7 +10,7 @@ type ScriptsRouter struct{}
 
 func (s *ScriptsRouter) InitRouter(Router *gin.RouterGroup) {
 	scriptRouter := Router.Group("scripts")
-	scriptRouter.Use(middleware.NewJWT().JWTAuth())
+	scriptRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
 	baseApi := entry.ApiGroup
 	{
 		scriptRouter.GET("/:host/category", baseApi.GetScriptCategories)
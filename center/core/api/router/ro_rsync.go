@@ -10,7 +10,7 @@ type RsyncClientRouter struct{}
 
 func (s *RsyncClientRouter) InitRouter(Router *gin.RouterGroup) {
 	rsyncRouter := Router.Group("rsync")
-	rsyncRouter.Use(middleware.NewJWT().JWTAuth())
+	rsyncRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
 	baseApi := entry.ApiGroup
 	{
 		rsyncRouter.GET("/:host/task", baseApi.RsyncListTask)
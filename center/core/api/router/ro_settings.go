@@ -10,7 +10,7 @@ type SettingsRouter struct{}
 
 func (s *SettingsRouter) InitRouter(Router *gin.RouterGroup) {
 	settingsRouter := Router.Group("settings")
-	settingsRouter.Use(middleware.NewJWT().JWTAuth())
+	settingsRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
 	baseApi := entry.ApiGroup
 	{
 		settingsRouter.GET("/about", baseApi.About)
@@ -20,5 +20,14 @@ func (s *SettingsRouter) InitRouter(Router *gin.RouterGroup) {
 		settingsRouter.GET("", baseApi.Settings)
 		settingsRouter.POST("", baseApi.UpdateSettings)
 		settingsRouter.POST("/upgrade", baseApi.Upgrade)
+		settingsRouter.GET("/security", baseApi.SecuritySettings)
+		settingsRouter.PUT("/security", baseApi.UpdateSecuritySettings)
+		settingsRouter.GET("/oidc", baseApi.OidcSettings)
+		settingsRouter.PUT("/oidc", baseApi.UpdateOidcSettings)
+		settingsRouter.GET("/ldap", baseApi.LdapSettings)
+		settingsRouter.PUT("/ldap", baseApi.UpdateLdapSettings)
+		settingsRouter.GET("/metrics", baseApi.MetricsSettings)
+		settingsRouter.POST("/metrics/token", baseApi.GenerateMetricsToken)
+		settingsRouter.DELETE("/metrics/token", baseApi.DisableMetrics)
 	}
 }
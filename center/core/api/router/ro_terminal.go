@@ -10,7 +10,7 @@ type TerminalRouter struct{}
 
 func (s *TerminalRouter) InitRouter(Router *gin.RouterGroup) {
 	terminalRouter := Router.Group("terminals")
-	terminalRouter.Use(middleware.NewJWT().JWTAuth())
+	terminalRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
 	baseApi := entry.ApiGroup
 	{
 		// websocket接口
@@ -24,5 +24,10 @@ func (s *TerminalRouter) InitRouter(Router *gin.RouterGroup) {
 		terminalRouter.POST("/:host/sessions/quit", baseApi.QuitSession)     // 终止终端会话
 		terminalRouter.POST("/:host/sessions/rename", baseApi.RenameSession) // 重命名终端会话
 		terminalRouter.POST("/:host/install", baseApi.InstallTerminal)       // 安装Agent侧的终端环境
+
+		// 终端录像
+		terminalRouter.GET("/:host/recordings", baseApi.TerminalRecordings)                 // 录像列表
+		terminalRouter.GET("/:host/recordings/download", baseApi.DownloadTerminalRecording) // 下载录像
+		terminalRouter.GET("/:host/recordings/stream", baseApi.StreamTerminalRecording)     // 流式回放录像
 	}
 }
@@ -10,7 +10,7 @@ type UserRouter struct{}
 
 func (s *UserRouter) InitRouter(Router *gin.RouterGroup) {
 	userRouter := Router.Group("users")
-	userRouter.Use(middleware.NewJWT().JWTAuth())
+	userRouter.Use(middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
 	baseApi := entry.ApiGroup
 	{
 		userRouter.GET("", baseApi.ListUser)                // 获取用户列表
@@ -20,5 +20,25 @@ func (s *UserRouter) InitRouter(Router *gin.RouterGroup) {
 		userRouter.PUT("/valid", baseApi.ValidUser)         // 禁用/启用用户
 		userRouter.PUT("/password", baseApi.ChangePassword) // 更新密码
 		userRouter.GET("/profile", baseApi.Profile)
+		userRouter.PUT("/role", baseApi.SetUserRole)                            // 设置用户角色
+		userRouter.GET("/roles", baseApi.ListRole)                              // 获取角色列表
+		userRouter.POST("/roles", baseApi.CreateRole)                           // 新增角色
+		userRouter.PUT("/roles", baseApi.UpdateRole)                            // 更新角色权限
+		userRouter.DELETE("/roles", baseApi.DeleteRole)                         // 删除角色
+		userRouter.PUT("/host_groups", baseApi.SetUserHostScope)                // 设置用户可访问的设备组
+		userRouter.PUT("/roles/host_groups", baseApi.SetRoleHostScope)          // 设置角色可访问的设备组
+		userRouter.PUT("/recording", baseApi.SetUserRecording)                  // 开启/关闭用户终端录制
+		userRouter.POST("/2fa/setup", baseApi.SetupTwoFactor)                   // 生成两步验证密钥
+		userRouter.POST("/2fa/enable", baseApi.EnableTwoFactor)                 // 启用两步验证
+		userRouter.POST("/2fa/disable", baseApi.DisableTwoFactor)               // 关闭两步验证
+		userRouter.POST("/2fa/recovery_codes", baseApi.RegenerateRecoveryCodes) // 重新生成恢复码
+		userRouter.PUT("/2fa/reset", baseApi.ResetTwoFactor)                    // 管理员清除用户两步验证
+		userRouter.GET("/tokens", baseApi.ListAccessToken)                      // 获取访问令牌列表
+		userRouter.POST("/tokens", baseApi.CreateAccessToken)                   // 创建访问令牌
+		userRouter.DELETE("/tokens", baseApi.DeleteAccessToken)                 // 吊销访问令牌
+		userRouter.GET("/profile/sessions", baseApi.ListProfileSession)         // 获取自己的登录会话
+		userRouter.DELETE("/profile/sessions", baseApi.RevokeProfileSession)    // 吊销自己的登录会话
+		userRouter.GET("/sessions", baseApi.ListSession)                        // 获取用户的登录会话
+		userRouter.DELETE("/sessions", baseApi.RevokeSession)                   // 吊销用户的登录会话
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/sensdata/idb/center/core/api/middleware"
 	"github.com/sensdata/idb/center/core/api/router"
+	"github.com/sensdata/idb/center/core/monitor"
 	"github.com/sensdata/idb/center/db/repo"
 	"github.com/sensdata/idb/center/global"
 	"github.com/sensdata/idb/core/model"
@@ -282,9 +283,15 @@ func (s *ApiServer) setUpDefaultRouters() {
 	global.LOG.Info("register router - api")
 	apiGroup := s.Router.Group("api/v1")
 
+	// 记录请求耗时
+	apiGroup.Use(middleware.RequestMetrics())
+
 	// 添加全局日志中间件（支持白名单机制，避免敏感信息泄露）
 	apiGroup.Use(middleware.RequestLogger())
 
+	// 变更类请求写入审计表
+	apiGroup.Use(middleware.AuditLogger())
+
 	// 绑定域名过滤
 	apiGroup.Use(middleware.BindDomain())
 	// 初始化路由
@@ -297,6 +304,13 @@ func (s *ApiServer) setUpDefaultRouters() {
 	swaggerGroup := apiGroup.Group("swagger")
 	swaggerGroup.GET("/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
 
+	// Prometheus 采集，使用独立的采集令牌
+	global.LOG.Info("register router - metrics")
+	if err := monitor.Register(newFleetCollector()); err != nil {
+		global.LOG.Error("Failed to register fleet collector: %v", err)
+	}
+	s.Router.GET("/metrics", middleware.MetricsAuth(), gin.WrapH(monitor.Handler()))
+
 	// 处理未匹配的请求，重定向到 /var/lib/idb/home
 	s.Router.NoRoute(func(c *gin.Context) {
 		// 这里可以使用 c.FileServer 来处理目录下的所有请求
@@ -311,7 +325,7 @@ func (s *ApiServer) setUpDefaultRouters() {
 func (s *ApiServer) SetUpPluginRouters(group string, routes []plugin.PluginRoute) {
 	global.LOG.Info("register router - %s", group)
 	pluginGroup := s.Router.Group("api/v1/" + group)
-	pluginGroup.Use(middleware.NewJWT().JWTAuth())
+	pluginGroup.Use(middleware.RequestMetrics(), middleware.AuditLogger(), middleware.NewJWT().JWTAuth(), middleware.NewRBAC().RBACAuth())
 	for _, route := range routes {
 		switch route.Method {
 		case "GET":
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/db/repo"
+	"github.com/sensdata/idb/core/constant"
+	core "github.com/sensdata/idb/core/model"
+	"github.com/sensdata/idb/core/utils"
+)
+
+type AccessTokenService struct{}
+
+type IAccessTokenService interface {
+	List(userID uint) ([]core.AccessTokenInfo, error)
+	Create(userID uint, req core.CreateAccessToken) (*core.AccessTokenCreated, error)
+	Delete(userID uint, id uint, isAdmin bool) error
+}
+
+func NewIAccessTokenService() IAccessTokenService {
+	return &AccessTokenService{}
+}
+
+// List 列出用户的访问令牌
+func (s *AccessTokenService) List(userID uint) ([]core.AccessTokenInfo, error) {
+	tokens, err := AccessTokenRepo.GetList(AccessTokenRepo.WithByUserID(userID))
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrNoRecords, err.Error())
+	}
+	items := make([]core.AccessTokenInfo, 0, len(tokens))
+	for _, token := range tokens {
+		items = append(items, toAccessTokenInfo(token))
+	}
+	return items, nil
+}
+
+// Create 生成访问令牌，明文仅返回一次，库中只保存摘要
+func (s *AccessTokenService) Create(userID uint, req core.CreateAccessToken) (*core.AccessTokenCreated, error) {
+	scopes, err := toHostScopes(req.HostGroupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := utils.GenerateSecureToken(20)
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	plain := constant.AccessTokenPrefix + secret
+	token := model.AccessToken{
+		UserID:    userID,
+		Name:      req.Name,
+		Prefix:    plain[:len(constant.AccessTokenPrefix)+4],
+		TokenHash: utils.HashToken(plain),
+		Access:    req.Access,
+	}
+	if req.ExpiresAt > 0 {
+		expiresAt := time.Unix(req.ExpiresAt, 0)
+		if expiresAt.Before(time.Now()) {
+			return nil, errors.WithMessage(constant.ErrInvalidParams, "expires_at must be in the future")
+		}
+		token.ExpiresAt = &expiresAt
+	}
+	if err := AccessTokenRepo.Create(&token); err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+
+	for i := range scopes {
+		scopes[i].TokenID = token.ID
+	}
+	if err := HostScopeRepo.Replace(HostScopeRepo.WithByTokenID(token.ID), scopes); err != nil {
+		_ = AccessTokenRepo.Delete(AccessTokenRepo.WithByID(token.ID))
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+
+	return &core.AccessTokenCreated{AccessTokenInfo: toAccessTokenInfo(token), Token: plain}, nil
+}
+
+// Delete 吊销访问令牌，非管理员只能吊销自己的令牌
+func (s *AccessTokenService) Delete(userID uint, id uint, isAdmin bool) error {
+	token, err := AccessTokenRepo.Get(AccessTokenRepo.WithByID(id))
+	if err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	if token.UserID != userID && !isAdmin {
+		return constant.ErrAuth
+	}
+	return deleteAccessTokens(AccessTokenRepo.WithByID(token.ID))
+}
+
+// deleteAccessTokens 删除令牌及其设备组范围
+func deleteAccessTokens(opt repo.DBOption) error {
+	tokens, err := AccessTokenRepo.GetList(opt)
+	if err != nil {
+		return errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	for _, token := range tokens {
+		if err := HostScopeRepo.Delete(HostScopeRepo.WithByTokenID(token.ID)); err != nil {
+			return errors.WithMessage(constant.ErrInternalServer, err.Error())
+		}
+		if err := AccessTokenRepo.Delete(AccessTokenRepo.WithByID(token.ID)); err != nil {
+			return errors.WithMessage(constant.ErrInternalServer, err.Error())
+		}
+	}
+	return nil
+}
+
+func toAccessTokenInfo(token model.AccessToken) core.AccessTokenInfo {
+	return core.AccessTokenInfo{
+		ID:           token.ID,
+		CreatedAt:    token.CreatedAt,
+		UserID:       token.UserID,
+		Name:         token.Name,
+		Prefix:       token.Prefix,
+		Access:       token.Access,
+		ExpiresAt:    token.ExpiresAt,
+		LastUsedAt:   token.LastUsedAt,
+		HostGroupIDs: scopeGroupIDs(HostScopeRepo.WithByTokenID(token.ID)),
+	}
+}
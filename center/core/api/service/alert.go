@@ -0,0 +1,396 @@
+package service
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sensdata/idb/center/core/alert"
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/db/repo"
+	"github.com/sensdata/idb/core/constant"
+	core "github.com/sensdata/idb/core/model"
+	"github.com/sensdata/idb/core/utils"
+)
+
+type AlertService struct{}
+
+type IAlertService interface {
+	ListRule() ([]core.AlertRuleInfo, error)
+	CreateRule(req core.CreateAlertRule) (*core.AlertRuleInfo, error)
+	UpdateRule(req core.UpdateAlertRule) error
+	DeleteRule(id uint) error
+
+	ListChannel() ([]core.AlertChannelInfo, error)
+	CreateChannel(req core.CreateAlertChannel) (*core.AlertChannelInfo, error)
+	UpdateChannel(req core.UpdateAlertChannel) error
+	DeleteChannel(id uint) error
+	TestChannel(req core.TestAlertChannel) error
+
+	ListSilence() ([]core.AlertSilenceInfo, error)
+	CreateSilence(creator string, req core.CreateAlertSilence) (*core.AlertSilenceInfo, error)
+	DeleteSilence(id uint) error
+
+	ListEvent(req core.QueryAlertEvent) (*core.PageResult, error)
+}
+
+func NewIAlertService() IAlertService {
+	return &AlertService{}
+}
+
+func (s *AlertService) ListRule() ([]core.AlertRuleInfo, error) {
+	rules, err := AlertRuleRepo.GetList()
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrNoRecords, err.Error())
+	}
+	items := make([]core.AlertRuleInfo, 0, len(rules))
+	for _, rule := range rules {
+		items = append(items, toAlertRuleInfo(rule))
+	}
+	return items, nil
+}
+
+func (s *AlertService) CreateRule(req core.CreateAlertRule) (*core.AlertRuleInfo, error) {
+	if err := validateAlertRule(req); err != nil {
+		return nil, err
+	}
+	rule := model.AlertRule{}
+	fillAlertRule(&rule, req)
+	if err := AlertRuleRepo.Create(&rule); err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	info := toAlertRuleInfo(rule)
+	return &info, nil
+}
+
+// UpdateRule 修改规则后，已触发的告警按新条件重新评估
+func (s *AlertService) UpdateRule(req core.UpdateAlertRule) error {
+	rule, err := AlertRuleRepo.Get(AlertRuleRepo.WithByID(req.ID))
+	if err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	if err := validateAlertRule(req.CreateAlertRule); err != nil {
+		return err
+	}
+	fillAlertRule(&rule, req.CreateAlertRule)
+	return AlertRuleRepo.Update(rule.ID, map[string]interface{}{
+		"name":            rule.Name,
+		"metric":          rule.Metric,
+		"operator":        rule.Operator,
+		"threshold":       rule.Threshold,
+		"duration":        rule.Duration,
+		"severity":        rule.Severity,
+		"host_ids":        rule.HostIDs,
+		"channel_ids":     rule.ChannelIDs,
+		"repeat_interval": rule.RepeatInterval,
+		"enabled":         rule.Enabled,
+	})
+}
+
+// DeleteRule 删除规则，其未恢复的告警由告警引擎直接恢复
+func (s *AlertService) DeleteRule(id uint) error {
+	if _, err := AlertRuleRepo.Get(AlertRuleRepo.WithByID(id)); err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	return AlertRuleRepo.Delete(AlertRuleRepo.WithByID(id))
+}
+
+func (s *AlertService) ListChannel() ([]core.AlertChannelInfo, error) {
+	channels, err := AlertChannelRepo.GetList()
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrNoRecords, err.Error())
+	}
+	items := make([]core.AlertChannelInfo, 0, len(channels))
+	for _, channel := range channels {
+		items = append(items, toAlertChannelInfo(channel))
+	}
+	return items, nil
+}
+
+func (s *AlertService) CreateChannel(req core.CreateAlertChannel) (*core.AlertChannelInfo, error) {
+	if err := alert.ValidateConfig(req.Type, req.Config); err != nil {
+		return nil, errors.WithMessage(constant.ErrInvalidParams, err.Error())
+	}
+	config, err := utils.ToJSONString(req.Config)
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrJSONMarshal, err.Error())
+	}
+	channel := model.AlertChannel{
+		Name:    req.Name,
+		Type:    req.Type,
+		Config:  config,
+		Enabled: req.Enabled,
+	}
+	if err := AlertChannelRepo.Create(&channel); err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	info := toAlertChannelInfo(channel)
+	return &info, nil
+}
+
+func (s *AlertService) UpdateChannel(req core.UpdateAlertChannel) error {
+	channel, err := AlertChannelRepo.Get(AlertChannelRepo.WithByID(req.ID))
+	if err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	cfg, err := keepAlertChannelSecrets(channel, req.Type, req.Config)
+	if err != nil {
+		return err
+	}
+	if err := alert.ValidateConfig(req.Type, cfg); err != nil {
+		return errors.WithMessage(constant.ErrInvalidParams, err.Error())
+	}
+	config, err := utils.ToJSONString(cfg)
+	if err != nil {
+		return errors.WithMessage(constant.ErrJSONMarshal, err.Error())
+	}
+	return AlertChannelRepo.Update(channel.ID, map[string]interface{}{
+		"name":    req.Name,
+		"type":    req.Type,
+		"config":  config,
+		"enabled": req.Enabled,
+	})
+}
+
+// DeleteChannel 删除渠道，并从引用它的规则中移除
+func (s *AlertService) DeleteChannel(id uint) error {
+	if _, err := AlertChannelRepo.Get(AlertChannelRepo.WithByID(id)); err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	rules, err := AlertRuleRepo.GetList()
+	if err != nil {
+		return errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	for _, rule := range rules {
+		ids := utils.SplitIDs(rule.ChannelIDs)
+		kept := make([]uint, 0, len(ids))
+		for _, channelID := range ids {
+			if channelID != id {
+				kept = append(kept, channelID)
+			}
+		}
+		if len(kept) == len(ids) {
+			continue
+		}
+		if err := AlertRuleRepo.Update(rule.ID, map[string]interface{}{"channel_ids": utils.JoinIDs(kept)}); err != nil {
+			return errors.WithMessage(constant.ErrInternalServer, err.Error())
+		}
+	}
+	return AlertChannelRepo.Delete(AlertChannelRepo.WithByID(id))
+}
+
+// TestChannel 发送一条测试通知，返回渠道的错误信息
+func (s *AlertService) TestChannel(req core.TestAlertChannel) error {
+	cfg := req.Config
+	if req.ID > 0 {
+		channel, err := AlertChannelRepo.Get(AlertChannelRepo.WithByID(req.ID))
+		if err != nil {
+			return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+		}
+		if cfg, err = keepAlertChannelSecrets(channel, req.Type, cfg); err != nil {
+			return err
+		}
+	}
+	if err := alert.ValidateConfig(req.Type, cfg); err != nil {
+		return errors.WithMessage(constant.ErrInvalidParams, err.Error())
+	}
+
+	n := core.AlertNotification{
+		State:    constant.AlertStateFiring,
+		RuleName: "Test notification",
+		Severity: constant.AlertSeverityInfo,
+		HostName: "idb",
+		Metric:   "test",
+		Message:  "This is a test notification from idb.",
+		StartsAt: time.Now(),
+	}
+	if err := alert.Send(req.Type, cfg, n); err != nil {
+		return errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	return nil
+}
+
+func (s *AlertService) ListSilence() ([]core.AlertSilenceInfo, error) {
+	silences, err := AlertSilenceRepo.GetList()
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrNoRecords, err.Error())
+	}
+	items := make([]core.AlertSilenceInfo, 0, len(silences))
+	for _, silence := range silences {
+		items = append(items, toAlertSilenceInfo(silence))
+	}
+	return items, nil
+}
+
+func (s *AlertService) CreateSilence(creator string, req core.CreateAlertSilence) (*core.AlertSilenceInfo, error) {
+	now := time.Now()
+	startsAt := now
+	if req.StartsAt != nil {
+		startsAt = *req.StartsAt
+	}
+	if !req.EndsAt.After(startsAt) || !req.EndsAt.After(now) {
+		return nil, errors.WithMessage(constant.ErrInvalidParams, "ends_at must be later than starts_at and now")
+	}
+	if req.RuleID > 0 {
+		if _, err := AlertRuleRepo.Get(AlertRuleRepo.WithByID(req.RuleID)); err != nil {
+			return nil, errors.WithMessage(constant.ErrRecordNotFound, "rule not found")
+		}
+	}
+	if req.HostID > 0 {
+		if _, err := HostRepo.Get(HostRepo.WithByID(req.HostID)); err != nil {
+			return nil, errors.WithMessage(constant.ErrHostNotFound, err.Error())
+		}
+	}
+
+	silence := model.AlertSilence{
+		RuleID:   req.RuleID,
+		HostID:   req.HostID,
+		StartsAt: startsAt,
+		EndsAt:   req.EndsAt,
+		Comment:  req.Comment,
+		Creator:  creator,
+	}
+	if err := AlertSilenceRepo.Create(&silence); err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	info := toAlertSilenceInfo(silence)
+	return &info, nil
+}
+
+func (s *AlertService) DeleteSilence(id uint) error {
+	if _, err := AlertSilenceRepo.Get(AlertSilenceRepo.WithByID(id)); err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	return AlertSilenceRepo.Delete(AlertSilenceRepo.WithByID(id))
+}
+
+func (s *AlertService) ListEvent(req core.QueryAlertEvent) (*core.PageResult, error) {
+	var opts []repo.DBOption
+	if req.State != "" {
+		opts = append(opts, AlertEventRepo.WithByState(req.State))
+	}
+	if req.RuleID > 0 {
+		opts = append(opts, AlertEventRepo.WithByRuleID(req.RuleID))
+	}
+	if req.HostID > 0 {
+		opts = append(opts, AlertEventRepo.WithByHostID(req.HostID))
+	}
+	total, events, err := AlertEventRepo.Page(req.Page, req.PageSize, opts...)
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrNoRecords, err.Error())
+	}
+	items := make([]core.AlertEventInfo, 0, len(events))
+	for _, event := range events {
+		items = append(items, core.AlertEventInfo{
+			ID:             event.ID,
+			RuleID:         event.RuleID,
+			RuleName:       event.RuleName,
+			HostID:         event.HostID,
+			HostName:       event.HostName,
+			Metric:         event.Metric,
+			Severity:       event.Severity,
+			Value:          event.Value,
+			Message:        event.Message,
+			State:          event.State,
+			Silenced:       event.Silenced,
+			StartsAt:       event.StartsAt,
+			ResolvedAt:     event.ResolvedAt,
+			LastNotifiedAt: event.LastNotifiedAt,
+		})
+	}
+	return &core.PageResult{Total: total, Items: items}, nil
+}
+
+// validateAlertRule 校验关联的设备及通知渠道存在
+func validateAlertRule(req core.CreateAlertRule) error {
+	for _, id := range req.HostIDs {
+		if _, err := HostRepo.Get(HostRepo.WithByID(id)); err != nil {
+			return errors.WithMessagef(constant.ErrHostNotFound, "host %d not found", id)
+		}
+	}
+	for _, id := range req.ChannelIDs {
+		if _, err := AlertChannelRepo.Get(AlertChannelRepo.WithByID(id)); err != nil {
+			return errors.WithMessagef(constant.ErrRecordNotFound, "channel %d not found", id)
+		}
+	}
+	return nil
+}
+
+func fillAlertRule(rule *model.AlertRule, req core.CreateAlertRule) {
+	rule.Name = req.Name
+	rule.Metric = req.Metric
+	rule.Operator = req.Operator
+	if rule.Operator == "" {
+		rule.Operator = ">"
+	}
+	rule.Threshold = req.Threshold
+	rule.Duration = req.Duration
+	rule.Severity = req.Severity
+	rule.HostIDs = utils.JoinIDs(req.HostIDs)
+	rule.ChannelIDs = utils.JoinIDs(req.ChannelIDs)
+	rule.RepeatInterval = req.RepeatInterval
+	rule.Enabled = req.Enabled
+}
+
+func toAlertRuleInfo(rule model.AlertRule) core.AlertRuleInfo {
+	return core.AlertRuleInfo{
+		ID:             rule.ID,
+		CreatedAt:      rule.CreatedAt,
+		Name:           rule.Name,
+		Metric:         rule.Metric,
+		Operator:       rule.Operator,
+		Threshold:      rule.Threshold,
+		Duration:       rule.Duration,
+		Severity:       rule.Severity,
+		HostIDs:        utils.SplitIDs(rule.HostIDs),
+		ChannelIDs:     utils.SplitIDs(rule.ChannelIDs),
+		RepeatInterval: rule.RepeatInterval,
+		Enabled:        rule.Enabled,
+	}
+}
+
+// toAlertChannelInfo 不返回密钥及密码
+func toAlertChannelInfo(channel model.AlertChannel) core.AlertChannelInfo {
+	var config core.AlertChannelConfig
+	_ = utils.FromJSONString(channel.Config, &config)
+	config.Secret = ""
+	config.Password = ""
+	return core.AlertChannelInfo{
+		ID:        channel.ID,
+		CreatedAt: channel.CreatedAt,
+		Name:      channel.Name,
+		Type:      channel.Type,
+		Config:    config,
+		Enabled:   channel.Enabled,
+	}
+}
+
+// keepAlertChannelSecrets 渠道类型未变且未填写密钥或密码时，沿用已保存的值
+func keepAlertChannelSecrets(channel model.AlertChannel, channelType string, config core.AlertChannelConfig) (core.AlertChannelConfig, error) {
+	if channel.Type != channelType {
+		return config, nil
+	}
+	var saved core.AlertChannelConfig
+	if err := utils.FromJSONString(channel.Config, &saved); err != nil {
+		return config, errors.WithMessage(constant.ErrJSONMarshal, err.Error())
+	}
+	if config.Secret == "" {
+		config.Secret = saved.Secret
+	}
+	if config.Password == "" {
+		config.Password = saved.Password
+	}
+	return config, nil
+}
+
+func toAlertSilenceInfo(silence model.AlertSilence) core.AlertSilenceInfo {
+	return core.AlertSilenceInfo{
+		ID:        silence.ID,
+		CreatedAt: silence.CreatedAt,
+		RuleID:    silence.RuleID,
+		HostID:    silence.HostID,
+		StartsAt:  silence.StartsAt,
+		EndsAt:    silence.EndsAt,
+		Comment:   silence.Comment,
+		Creator:   silence.Creator,
+	}
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"time"
+
+	"github.com/jinzhu/copier"
+	"github.com/pkg/errors"
+	"github.com/sensdata/idb/core/constant"
+
+	core "github.com/sensdata/idb/core/model"
+)
+
+type AuditService struct{}
+
+type IAuditService interface {
+	List(req core.QueryAuditLog) (*core.PageResult, error)
+}
+
+func NewIAuditService() IAuditService {
+	return &AuditService{}
+}
+
+// List audit logs, newest first
+func (s *AuditService) List(req core.QueryAuditLog) (*core.PageResult, error) {
+	var start, end time.Time
+	if req.Start > 0 {
+		start = time.Unix(req.Start, 0)
+	}
+	if req.End > 0 {
+		end = time.Unix(req.End, 0)
+	}
+	total, logs, err := AuditLogRepo.Page(
+		req.Page,
+		req.PageSize,
+		AuditLogRepo.WithByUserName(req.User),
+		AuditLogRepo.WithByHostID(req.HostID),
+		AuditLogRepo.WithByCreatedAt(start, end),
+	)
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrNoRecords, err.Error())
+	}
+
+	items := make([]core.AuditLogInfo, 0, len(logs))
+	if err := copier.Copy(&items, &logs); err != nil {
+		return nil, errors.WithMessage(constant.ErrStructTransform, err.Error())
+	}
+	return &core.PageResult{Total: total, Items: items}, nil
+}
@@ -1,18 +1,32 @@
 package service
 
 import (
+	"sync"
+	"time"
+
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
+	"github.com/sensdata/idb/center/db/model"
 	"github.com/sensdata/idb/center/global"
 	"github.com/sensdata/idb/core/constant"
-	"github.com/sensdata/idb/core/model"
+	core "github.com/sensdata/idb/core/model"
 	"github.com/sensdata/idb/core/utils"
+	"gorm.io/gorm"
+)
+
+const (
+	// 两步验证挑战的有效期及最大尝试次数
+	challengeTTL         = 5 * time.Minute
+	challengeMaxAttempts = 5
+	// 登录 token 有效期，单位秒
+	tokenExpire = 3600
 )
 
 type AuthService struct{}
 
 type IAuthService interface {
-	Login(c *gin.Context, info model.Login) (*model.LoginResult, error)
+	Login(c *gin.Context, info core.Login) (*core.LoginResult, error)
+	LoginTwoFactor(c *gin.Context, req core.LoginTwoFactor) (*core.LoginResult, error)
 	LogOut(c *gin.Context) error
 }
 
@@ -20,29 +34,206 @@ func NewIAuthService() IAuthService {
 	return &AuthService{}
 }
 
-// LogOut implements IAuthService.
+// twoFactorChallenge 密码校验通过、等待验证码的登录
+type twoFactorChallenge struct {
+	userID   uint
+	expireAt time.Time
+	attempts int
+}
+
+var (
+	challenges  = make(map[string]*twoFactorChallenge)
+	challengeMu sync.Mutex
+)
+
+// LogOut 删除当前 token 对应的登录会话
 func (s *AuthService) LogOut(c *gin.Context) error {
+	claims, ok := c.Get("user")
+	if !ok {
+		return constant.ErrNotLogin
+	}
+	userClaims, ok := claims.(*utils.Claims)
+	if !ok || userClaims.RegisteredClaims.ID == "" {
+		return nil
+	}
+	if err := SessionRepo.Delete(SessionRepo.WithBySessionID(userClaims.RegisteredClaims.ID)); err != nil {
+		return errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
 	return nil
 }
 
 // Login implements IAuthService.
-func (s *AuthService) Login(c *gin.Context, info model.Login) (*model.LoginResult, error) {
-	user, err := UserRepo.Get(UserRepo.WithByName(info.Name))
+func (s *AuthService) Login(c *gin.Context, info core.Login) (*core.LoginResult, error) {
+	ip := c.ClientIP()
+	if err := checkLoginLocked(info.Name, ip); err != nil {
+		return nil, err
+	}
+
+	user, err := authenticate(info.Name, info.Password)
+	if err != nil {
+		global.LOG.Error("Failed to authenticate user %s: %v", info.Name, err)
+		// 目录服务不可用不计入失败次数，本地管理员仍可登录
+		if !errors.Is(err, constant.ErrLdapUnavailable) {
+			recordLoginFailure(info.Name, ip)
+		}
+		return nil, err
+	}
+
+	// 已启用两步验证，返回挑战，等待验证码
+	if user.TotpEnabled {
+		challenge, err := newChallenge(user.ID)
+		if err != nil {
+			return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+		}
+		return &core.LoginResult{ID: int(user.ID), Name: user.Name, TwoFactor: true, Challenge: challenge}, nil
+	}
+
+	resetLoginFailure(info.Name, ip)
+	return issueToken(c, user)
+}
+
+// authenticate 本地用户校验本地密码；启用 LDAP 时，LDAP 用户及本地不存在的用户交由目录服务校验
+func authenticate(name string, password string) (*model.User, error) {
+	user, err := UserRepo.Get(UserRepo.WithByName(name))
+	if err == nil && isLocalUser(&user) {
+		if !utils.ValidatePassword(user.Password, password, user.Salt) {
+			return nil, errors.WithMessage(constant.ErrInvalidAccountOrPassword, constant.ErrInvalidAccountOrPassword.Error())
+		}
+		return &user, nil
+	}
+
+	if (err == nil && user.Source == constant.UserSourceLdap) || errors.Is(err, gorm.ErrRecordNotFound) {
+		settings, serr := loadLdapSettings()
+		if serr == nil && settings.Enabled {
+			return ldapLogin(settings, name, password)
+		}
+	}
+
 	if err != nil {
-		global.LOG.Error("User not found: %v", err)
 		return nil, errors.WithMessage(constant.ErrInvalidAccountOrPassword, err.Error())
 	}
+	return nil, errors.WithMessagef(constant.ErrInvalidAccountOrPassword, "user from %s can't login with password", user.Source)
+}
 
-	if !utils.ValidatePassword(user.Password, info.Password, user.Salt) {
-		global.LOG.Error("Failed to validate password")
-		return nil, errors.WithMessage(constant.ErrInvalidAccountOrPassword, constant.ErrInvalidAccountOrPassword.Error())
+// LoginTwoFactor 登录第二步，校验验证码或恢复码后签发 token
+func (s *AuthService) LoginTwoFactor(c *gin.Context, req core.LoginTwoFactor) (*core.LoginResult, error) {
+	userID, ok := useChallenge(req.Challenge)
+	if !ok {
+		return nil, errors.WithMessage(constant.ErrAuth, "challenge expired, please login again")
+	}
+	user, err := UserRepo.Get(UserRepo.WithByID(userID))
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrInvalidAccountOrPassword, err.Error())
 	}
+	ip := c.ClientIP()
+	if err := checkLoginLocked(user.Name, ip); err != nil {
+		dropChallenge(req.Challenge)
+		return nil, err
+	}
+	if err := verifyTwoFactor(&user, req.Code); err != nil {
+		global.LOG.Error("Failed to validate two-factor code of user %s", user.Name)
+		recordLoginFailure(user.Name, ip)
+		return nil, errors.WithMessage(constant.ErrAuth, err.Error())
+	}
+	dropChallenge(req.Challenge)
 
-	token, err := utils.GenerateJWT(user.ID, user.Name, 3600, global.JWTKey)
+	resetLoginFailure(user.Name, ip)
+	return issueToken(c, &user)
+}
+
+// issueToken 创建登录会话并签发携带会话 ID 的 token
+func issueToken(c *gin.Context, user *model.User) (*core.LoginResult, error) {
+	now := time.Now()
+	if err := SessionRepo.Delete(SessionRepo.WithByExpiredBefore(now)); err != nil {
+		global.LOG.Error("Failed to clean expired sessions: %v", err)
+	}
+	sessionID, err := utils.GenerateSecureToken(32)
+	if err != nil {
+		global.LOG.Error("Failed to generate session id %v", err)
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	session := model.LoginSession{
+		UserID:    user.ID,
+		SessionID: sessionID,
+		SourceIP:  c.ClientIP(),
+		UserAgent: truncateString(c.Request.UserAgent(), 256),
+		ExpiresAt: now.Add(tokenExpire * time.Second),
+	}
+	if err := SessionRepo.Create(&session); err != nil {
+		global.LOG.Error("Failed to create session %v", err)
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+
+	token, err := utils.GenerateJWT(user.ID, user.Name, session.SessionID, tokenExpire, global.JWTKey)
 	if err != nil {
 		global.LOG.Error("Failed to generate token %v", err)
 		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
 	}
 
-	return &model.LoginResult{ID: int(user.ID), Name: user.Name, Token: token}, nil
+	return &core.LoginResult{
+		ID:             int(user.ID),
+		Name:           user.Name,
+		Token:          token,
+		TwoFactorSetup: !user.TotpEnabled && user.Source != constant.UserSourceOidc && TwoFactorRequired(),
+	}, nil
+}
+
+// checkLoginLocked 账号或来源 IP 失败次数过多时拒绝登录
+func checkLoginLocked(name string, ip string) error {
+	wait := loginLockedFor(name, ip)
+	if wait <= 0 {
+		return nil
+	}
+	global.LOG.Error("Login of %s from %s is locked for %v", name, ip, wait)
+	return errors.WithMessagef(constant.ErrLoginLocked, "too many failed attempts, retry after %d seconds", int(wait.Seconds())+1)
+}
+
+func truncateString(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
+
+func newChallenge(userID uint) (string, error) {
+	challenge, err := utils.GenerateSecureToken(32)
+	if err != nil {
+		return "", err
+	}
+
+	challengeMu.Lock()
+	defer challengeMu.Unlock()
+
+	now := time.Now()
+	for k, v := range challenges {
+		if now.After(v.expireAt) {
+			delete(challenges, k)
+		}
+	}
+	challenges[challenge] = &twoFactorChallenge{userID: userID, expireAt: now.Add(challengeTTL)}
+	return challenge, nil
+}
+
+// useChallenge 返回挑战对应的用户，超时或尝试次数过多时作废
+func useChallenge(challenge string) (uint, bool) {
+	challengeMu.Lock()
+	defer challengeMu.Unlock()
+
+	v, ok := challenges[challenge]
+	if !ok {
+		return 0, false
+	}
+	v.attempts++
+	if time.Now().After(v.expireAt) || v.attempts > challengeMaxAttempts {
+		delete(challenges, challenge)
+		return 0, false
+	}
+	return v.userID, true
+}
+
+func dropChallenge(challenge string) {
+	challengeMu.Lock()
+	defer challengeMu.Unlock()
+	delete(challenges, challenge)
 }
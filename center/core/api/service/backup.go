@@ -0,0 +1,200 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sensdata/idb/center/core/conn"
+	"github.com/sensdata/idb/center/global"
+	"github.com/sensdata/idb/core/model"
+	"github.com/sensdata/idb/core/utils"
+)
+
+// backupQueryTimeout 列出快照及快照中的文件需要读取仓库，S3、SFTP 仓库在本地缓存失效时耗时较长
+const backupQueryTimeout = 2 * time.Minute
+
+type BackupService struct{}
+
+type IBackupService interface {
+	ListJobs(hostID uint) (*model.BackupJobList, error)
+	CreateJob(hostID uint, req model.CreateBackupJob) (*model.BackupJobCreated, error)
+	UpdateJob(hostID uint, req model.UpdateBackupJob) error
+	DeleteJob(hostID uint, req model.QueryBackupJob) error
+	RunJob(hostID uint, req model.QueryBackupJob) (*model.BackupLog, error)
+	Snapshots(hostID uint, req model.QueryBackupJob) (*model.BackupSnapshotList, error)
+	SnapshotFiles(hostID uint, req model.QueryBackupFiles) (*model.BackupFileList, error)
+	Restore(hostID uint, req model.RestoreBackup) (*model.BackupLog, error)
+}
+
+func NewIBackupService() IBackupService {
+	return &BackupService{}
+}
+
+func (s *BackupService) ListJobs(hostID uint) (*model.BackupJobList, error) {
+	var resp model.BackupJobList
+	if err := backupAction(hostID, model.Backup_List, nil, &resp); err != nil {
+		return &resp, fmt.Errorf("failed to list backup jobs: %v", err)
+	}
+	return &resp, nil
+}
+
+func (s *BackupService) CreateJob(hostID uint, req model.CreateBackupJob) (*model.BackupJobCreated, error) {
+	var resp model.BackupJobCreated
+	if err := fillRepositoryHost(&req.Repository); err != nil {
+		return &resp, err
+	}
+	if err := backupAction(hostID, model.Backup_Create, req, &resp); err != nil {
+		return &resp, fmt.Errorf("failed to create backup job: %v", err)
+	}
+	return &resp, nil
+}
+
+func (s *BackupService) UpdateJob(hostID uint, req model.UpdateBackupJob) error {
+	if err := fillRepositoryHost(&req.Repository); err != nil {
+		return err
+	}
+	if err := backupAction(hostID, model.Backup_Update, req, nil); err != nil {
+		return fmt.Errorf("failed to update backup job: %v", err)
+	}
+	return nil
+}
+
+func (s *BackupService) DeleteJob(hostID uint, req model.QueryBackupJob) error {
+	if err := backupAction(hostID, model.Backup_Delete, req, nil); err != nil {
+		return fmt.Errorf("failed to delete backup job: %v", err)
+	}
+	return nil
+}
+
+func (s *BackupService) RunJob(hostID uint, req model.QueryBackupJob) (*model.BackupLog, error) {
+	var resp model.BackupLog
+	if err := backupAction(hostID, model.Backup_Run, req, &resp); err != nil {
+		return &resp, fmt.Errorf("failed to run backup job: %v", err)
+	}
+	return &resp, nil
+}
+
+func (s *BackupService) Snapshots(hostID uint, req model.QueryBackupJob) (*model.BackupSnapshotList, error) {
+	var resp model.BackupSnapshotList
+	if err := backupActionTimeout(hostID, model.Backup_Snapshots, req, &resp, backupQueryTimeout); err != nil {
+		return &resp, fmt.Errorf("failed to list snapshots: %v", err)
+	}
+	return &resp, nil
+}
+
+func (s *BackupService) SnapshotFiles(hostID uint, req model.QueryBackupFiles) (*model.BackupFileList, error) {
+	var resp model.BackupFileList
+	if err := backupActionTimeout(hostID, model.Backup_Files, req, &resp, backupQueryTimeout); err != nil {
+		return &resp, fmt.Errorf("failed to list snapshot files: %v", err)
+	}
+	return &resp, nil
+}
+
+// Restore 恢复到其他设备时，从源设备读取任务的仓库配置后交由目标设备执行
+func (s *BackupService) Restore(hostID uint, req model.RestoreBackup) (*model.BackupLog, error) {
+	var resp model.BackupLog
+	restoreReq := model.BackupRestoreRequest{
+		JobID:      req.JobID,
+		Snapshot:   req.Snapshot,
+		TargetPath: req.TargetPath,
+		Includes:   req.Includes,
+	}
+	targetHostID := hostID
+	if req.TargetHostID != 0 && req.TargetHostID != hostID {
+		if _, err := HostRepo.Get(HostRepo.WithByID(req.TargetHostID)); err != nil {
+			return &resp, errors.New("target host not found")
+		}
+		var repo model.BackupRepository
+		if err := backupAction(hostID, model.Backup_Repository, model.QueryBackupJob{ID: req.JobID}, &repo); err != nil {
+			return &resp, fmt.Errorf("failed to get backup repository: %v", err)
+		}
+		if repo.Type == "local" {
+			return &resp, errors.New("snapshots in a local repository can only be restored on the source host")
+		}
+		restoreReq.Repository = &repo
+		targetHostID = req.TargetHostID
+	}
+	if err := backupAction(targetHostID, model.Backup_Restore, restoreReq, &resp); err != nil {
+		return &resp, fmt.Errorf("failed to restore snapshot: %v", err)
+	}
+	return &resp, nil
+}
+
+// fillRepositoryHost sftp 仓库指定设备时使用设备的 SSH 信息
+func fillRepositoryHost(repo *model.BackupRepository) error {
+	if repo.Type != "sftp" || repo.HostID == 0 {
+		return nil
+	}
+	host, err := HostRepo.Get(HostRepo.WithByID(repo.HostID))
+	if err != nil {
+		return errors.New("repository host not found")
+	}
+	repo.SSHHost = host.Addr
+	repo.SSHPort = host.Port
+	repo.SSHUser = host.User
+	repo.SSHPassword = ""
+	repo.SSHPrivateKey = ""
+	if host.AuthMode == "password" {
+		repo.SSHPassword = host.Password
+		return nil
+	}
+	if host.PassPhrase != "" {
+		return errors.New("private keys protected by a passphrase are not supported for backup repositories")
+	}
+	key, err := conn.GetPrivateKeyContent(host.PrivateKey)
+	if err != nil {
+		return fmt.Errorf("failed to read private key of repository host: %v", err)
+	}
+	repo.SSHPrivateKey = key
+	return nil
+}
+
+// backupAction 向设备发送备份相关的 action，resp 为 nil 时忽略返回内容
+func backupAction(hostID uint, action string, req interface{}, resp interface{}) error {
+	return backupActionTimeout(hostID, action, req, resp, 0)
+}
+
+// backupActionTimeout 同 backupAction，timeout 为 0 时使用默认的超时时间
+func backupActionTimeout(hostID uint, action string, req interface{}, resp interface{}, timeout time.Duration) error {
+	var data string
+	if req != nil {
+		var err error
+		data, err = utils.ToJSONString(req)
+		if err != nil {
+			return err
+		}
+	}
+
+	hostAction := model.HostAction{
+		HostID: hostID,
+		Action: model.Action{
+			Action: action,
+			Data:   data,
+		},
+	}
+	var (
+		actionResponse *model.Action
+		err            error
+	)
+	if timeout > 0 {
+		actionResponse, err = conn.CENTER.ExecuteActionTimeout(hostAction, timeout)
+	} else {
+		actionResponse, err = conn.CENTER.ExecuteAction(hostAction)
+	}
+	if err != nil {
+		return err
+	}
+	if !actionResponse.Result {
+		global.LOG.Error("action %s failed: %s", action, actionResponse.Data)
+		return errors.New(actionResponse.Data)
+	}
+	if resp == nil {
+		return nil
+	}
+	if err := utils.FromJSONString(actionResponse.Data, resp); err != nil {
+		global.LOG.Error("Error unmarshaling data of action %s: %v", action, err)
+		return fmt.Errorf("json err: %v", err)
+	}
+	return nil
+}
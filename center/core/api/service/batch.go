@@ -0,0 +1,354 @@
+package service
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sensdata/idb/center/core/conn"
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/db/repo"
+	"github.com/sensdata/idb/center/global"
+	"github.com/sensdata/idb/core/constant"
+	logstreamTypes "github.com/sensdata/idb/core/logstream/pkg/types"
+	"github.com/sensdata/idb/core/logstream/pkg/writer"
+	core "github.com/sensdata/idb/core/model"
+	"github.com/sensdata/idb/core/utils"
+)
+
+const (
+	defaultBatchConcurrency = 10
+	defaultBatchTimeout     = 60 // 秒
+)
+
+type BatchService struct{}
+
+type IBatchService interface {
+	Execute(user *utils.Claims, req core.BatchExecute, groupScope []uint) (*core.BatchTaskInfo, error)
+	List(req core.PageInfo, userID uint, isAdmin bool) (*core.PageResult, error)
+	Get(id uint, userID uint, isAdmin bool) (*core.BatchTaskInfo, error)
+}
+
+func NewIBatchService() IBatchService {
+	return &BatchService{}
+}
+
+// Execute 在目标设备上并发执行命令、脚本或 action，进度写入日志流任务，结果异步汇总
+func (s *BatchService) Execute(user *utils.Claims, req core.BatchExecute, groupScope []uint) (*core.BatchTaskInfo, error) {
+	batchType, content, err := batchContent(req)
+	if err != nil {
+		return nil, err
+	}
+
+	hosts, skipped, err := resolveBatchTargets(req.BatchTarget, groupScope)
+	if err != nil {
+		return nil, err
+	}
+	if len(hosts) == 0 && len(skipped) == 0 {
+		return nil, errors.WithMessage(constant.ErrHostNotFound, "no host matched")
+	}
+
+	defaultHost, err := HostRepo.Get(HostRepo.WithByDefault())
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrHostNotFound, err.Error())
+	}
+	task, err := global.LogStream.CreateTask(logstreamTypes.TaskTypeFile, nil)
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+
+	batch := model.BatchTask{
+		UserID:   user.ID,
+		UserName: user.Name,
+		Type:     batchType,
+		Content:  content,
+		Status:   constant.BatchStatusRunning,
+		Total:    len(hosts) + len(skipped),
+		LogPath:  task.LogPath,
+	}
+	if err := BatchTaskRepo.Create(&batch); err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+
+	concurrency := req.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultBatchConcurrency
+	}
+	timeout := req.Timeout
+	if timeout <= 0 {
+		timeout = defaultBatchTimeout
+	}
+	go runBatch(batch.ID, task.ID, req, hosts, skipped, concurrency, time.Duration(timeout)*time.Second)
+
+	info := toBatchTaskInfo(batch, defaultHost.ID)
+	return &info, nil
+}
+
+// List 管理员可查看全部批量操作，其他用户只能查看自己的
+func (s *BatchService) List(req core.PageInfo, userID uint, isAdmin bool) (*core.PageResult, error) {
+	var opts []repo.DBOption
+	if !isAdmin {
+		opts = append(opts, BatchTaskRepo.WithByUserID(userID))
+	}
+	total, batches, err := BatchTaskRepo.Page(req.Page, req.PageSize, opts...)
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrNoRecords, err.Error())
+	}
+
+	logHost := defaultHostID()
+	items := make([]core.BatchTaskInfo, 0, len(batches))
+	for _, batch := range batches {
+		items = append(items, toBatchTaskInfo(batch, logHost))
+	}
+	return &core.PageResult{Total: total, Items: items}, nil
+}
+
+// Get 查询批量操作详情，包含各设备的执行结果
+func (s *BatchService) Get(id uint, userID uint, isAdmin bool) (*core.BatchTaskInfo, error) {
+	batch, err := BatchTaskRepo.Get(BatchTaskRepo.WithByID(id))
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	if batch.UserID != userID && !isAdmin {
+		return nil, constant.ErrAuth
+	}
+
+	info := toBatchTaskInfo(batch, defaultHostID())
+	info.Results = []core.BatchHostResult{}
+	if batch.Results != "" {
+		if err := utils.FromJSONString(batch.Results, &info.Results); err != nil {
+			return nil, errors.WithMessage(constant.ErrJSONMarshal, err.Error())
+		}
+	}
+	return &info, nil
+}
+
+// batchContent 校验 command、script、action 有且只有一个
+func batchContent(req core.BatchExecute) (string, string, error) {
+	count := 0
+	batchType, content := "", ""
+	if req.Command != "" {
+		count++
+		batchType, content = constant.BatchTypeCommand, req.Command
+	}
+	if req.Script != "" {
+		count++
+		batchType, content = constant.BatchTypeScript, req.Script
+	}
+	if req.Action != nil {
+		count++
+		data, err := utils.ToJSONString(req.Action)
+		if err != nil {
+			return "", "", errors.WithMessage(constant.ErrJSONMarshal, err.Error())
+		}
+		batchType, content = constant.BatchTypeAction, data
+	}
+	if count != 1 {
+		return "", "", errors.WithMessage(constant.ErrInvalidParams, "exactly one of command, script and action is required")
+	}
+	return batchType, content, nil
+}
+
+// resolveBatchTargets 合并设备 ID、设备组及标签选出的设备，超出可访问范围的设备直接记为失败
+func resolveBatchTargets(target core.BatchTarget, groupScope []uint) ([]model.Host, []core.BatchHostResult, error) {
+	var hosts []model.Host
+	var skipped []core.BatchHostResult
+	seen := make(map[uint]bool)
+	add := func(host model.Host) {
+		if !seen[host.ID] {
+			seen[host.ID] = true
+			hosts = append(hosts, host)
+		}
+	}
+
+	for _, id := range target.HostIDs {
+		if seen[id] {
+			continue
+		}
+		host, err := HostRepo.Get(HostRepo.WithByID(id))
+		if err != nil {
+			seen[id] = true
+			skipped = append(skipped, core.BatchHostResult{HostID: id, Error: "host not found"})
+			continue
+		}
+		if groupScope != nil && !containsGroup(groupScope, host.GroupID) {
+			seen[id] = true
+			skipped = append(skipped, core.BatchHostResult{HostID: id, HostName: host.Name, Error: "host out of scope"})
+			continue
+		}
+		add(host)
+	}
+
+	if len(target.HostGroupIDs) > 0 || len(target.Tags) > 0 {
+		var opts []repo.DBOption
+		if len(target.HostGroupIDs) > 0 {
+			opts = append(opts, HostRepo.WithByGroupIDs(target.HostGroupIDs))
+		}
+		if groupScope != nil {
+			opts = append(opts, HostRepo.WithByGroupIDs(groupScope))
+		}
+		candidates, err := HostRepo.GetList(opts...)
+		if err != nil {
+			return nil, nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+		}
+		for _, host := range candidates {
+			if hasAllTags(splitTags(host.Tags), target.Tags) {
+				add(host)
+			}
+		}
+	}
+	return hosts, skipped, nil
+}
+
+// runBatch 以固定并发执行，全部完成后保存汇总结果
+func runBatch(batchID uint, taskID string, req core.BatchExecute, hosts []model.Host, skipped []core.BatchHostResult, concurrency int, timeout time.Duration) {
+	taskStatus(taskID, logstreamTypes.TaskStatusRunning)
+
+	var w *writer.Writer
+	if tw, err := global.LogStream.GetWriter(taskID); err != nil {
+		global.LOG.Error("Failed to get log writer for task %s: %v", taskID, err)
+	} else {
+		w = &tw
+	}
+	taskLog(w, logstreamTypes.LogLevelInfo, fmt.Sprintf("batch %d begin: %d hosts, concurrency %d, timeout %v", batchID, len(hosts)+len(skipped), concurrency, timeout))
+	for _, result := range skipped {
+		taskLog(w, logstreamTypes.LogLevelError, fmt.Sprintf("[host %d] skipped: %s", result.HostID, result.Error))
+	}
+
+	results := make([]core.BatchHostResult, len(hosts))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range hosts {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			host := hosts[i]
+			taskLog(w, logstreamTypes.LogLevelInfo, fmt.Sprintf("[%s] start", host.Name))
+			results[i] = executeOnHost(host, req, timeout)
+			if results[i].Success {
+				taskLog(w, logstreamTypes.LogLevelInfo, fmt.Sprintf("[%s] success in %dms\n%s", host.Name, results[i].Duration, results[i].Output))
+			} else {
+				taskLog(w, logstreamTypes.LogLevelError, fmt.Sprintf("[%s] failed in %dms: %s\n%s", host.Name, results[i].Duration, results[i].Error, results[i].Output))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	results = append(results, skipped...)
+	success := 0
+	for _, result := range results {
+		if result.Success {
+			success++
+		}
+	}
+	failed := len(results) - success
+
+	data, err := utils.ToJSONString(results)
+	if err != nil {
+		global.LOG.Error("Failed to marshal results of batch %d: %v", batchID, err)
+	}
+	now := time.Now()
+	if err := BatchTaskRepo.Update(batchID, map[string]interface{}{
+		"status":      constant.BatchStatusDone,
+		"success":     success,
+		"failed":      failed,
+		"results":     data,
+		"finished_at": &now,
+	}); err != nil {
+		global.LOG.Error("Failed to update batch %d: %v", batchID, err)
+	}
+
+	taskLog(w, logstreamTypes.LogLevelInfo, fmt.Sprintf("batch %d done: %d success, %d failed", batchID, success, failed))
+	if failed > 0 {
+		taskStatus(taskID, logstreamTypes.TaskStatusFailed)
+	} else {
+		taskStatus(taskID, logstreamTypes.TaskStatusSuccess)
+	}
+}
+
+// executeOnHost 在单台设备上执行，超时后不再等待 agent 的结果
+func executeOnHost(host model.Host, req core.BatchExecute, timeout time.Duration) core.BatchHostResult {
+	result := core.BatchHostResult{HostID: host.ID, HostName: host.Name}
+	start := time.Now()
+
+	if req.Action != nil {
+		action, err := conn.CENTER.ExecuteActionTimeout(core.HostAction{HostID: host.ID, Action: *req.Action}, timeout)
+		switch {
+		case err != nil:
+			result.Error = err.Error()
+		case !action.Result:
+			result.Error = action.Data
+		default:
+			result.Success = true
+			result.Output = action.Data
+		}
+		result.Duration = time.Since(start).Milliseconds()
+		return result
+	}
+
+	command := req.Command
+	if command == "" {
+		command = req.Script
+	}
+	output, err := conn.CENTER.ExecuteCommandTimeout(core.Command{HostID: host.ID, Command: command}, timeout)
+	switch {
+	case err != nil:
+		result.Error = err.Error()
+	case output == "error":
+		// agent 执行失败时只返回 error
+		result.Error = "command failed"
+	default:
+		result.Success = true
+		result.Output = output
+	}
+	result.Duration = time.Since(start).Milliseconds()
+	return result
+}
+
+func toBatchTaskInfo(batch model.BatchTask, logHost uint) core.BatchTaskInfo {
+	return core.BatchTaskInfo{
+		ID:         batch.ID,
+		CreatedAt:  batch.CreatedAt,
+		UserName:   batch.UserName,
+		Type:       batch.Type,
+		Content:    batch.Content,
+		Status:     batch.Status,
+		Total:      batch.Total,
+		Success:    batch.Success,
+		Failed:     batch.Failed,
+		LogHost:    logHost,
+		LogPath:    batch.LogPath,
+		FinishedAt: batch.FinishedAt,
+	}
+}
+
+func defaultHostID() uint {
+	host, err := HostRepo.Get(HostRepo.WithByDefault())
+	if err != nil {
+		return 0
+	}
+	return host.ID
+}
+
+func hasAllTags(tags []string, required []string) bool {
+	for _, tag := range required {
+		if !containsTag(tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsGroup(groups []uint, id uint) bool {
+	for _, g := range groups {
+		if g == id {
+			return true
+		}
+	}
+	return false
+}
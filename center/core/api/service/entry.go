@@ -7,13 +7,26 @@ import (
 var (
 	CommonRepo = repo.NewCommonRepo()
 
-	RoleRepo       = repo.NewRoleRepo()
-	UserRepo       = repo.NewUserRepo()
-	GroupRepo      = repo.NewGroupRepo()
-	HostRepo       = repo.NewHostRepo()
-	HostGroupRepo  = repo.NewHostGroupRepo()
-	AppRepo        = repo.NewAppRepo()
-	AppVersionRepo = repo.NewAppVersionRepo()
-	SettingsRepo   = repo.NewSettingsRepo()
-	TimezoneRepo   = repo.NewTimezonesRepo()
+	RoleRepo        = repo.NewRoleRepo()
+	PermissionRepo  = repo.NewPermissionRepo()
+	UserRepo        = repo.NewUserRepo()
+	GroupRepo       = repo.NewGroupRepo()
+	HostRepo        = repo.NewHostRepo()
+	HostGroupRepo   = repo.NewHostGroupRepo()
+	HostScopeRepo   = repo.NewHostScopeRepo()
+	AuditLogRepo    = repo.NewAuditLogRepo()
+	AccessTokenRepo = repo.NewAccessTokenRepo()
+	SessionRepo     = repo.NewLoginSessionRepo()
+	AppRepo         = repo.NewAppRepo()
+	AppVersionRepo  = repo.NewAppVersionRepo()
+	SettingsRepo    = repo.NewSettingsRepo()
+	TimezoneRepo    = repo.NewTimezonesRepo()
+
+	TerminalRecordingRepo = repo.NewTerminalRecordingRepo()
+	BatchTaskRepo         = repo.NewBatchTaskRepo()
+	HostMetricRepo        = repo.NewHostMetricRepo()
+	AlertRuleRepo         = repo.NewAlertRuleRepo()
+	AlertChannelRepo      = repo.NewAlertChannelRepo()
+	AlertSilenceRepo      = repo.NewAlertSilenceRepo()
+	AlertEventRepo        = repo.NewAlertEventRepo()
 )
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"github.com/sensdata/idb/core/constant"
+	core "github.com/sensdata/idb/core/model"
+	"github.com/sensdata/idb/core/utils"
+	"gorm.io/gorm"
+)
+
+// externalIdentity 外部身份源认证通过后的用户信息
+type externalIdentity struct {
+	Source     string
+	ExternalID string
+	Name       string
+	Groups     []string
+}
+
+// provisionExternalUser 按来源和外部标识查找用户，不存在时自动创建，每次登录按组映射同步角色
+func provisionExternalUser(identity externalIdentity, mappings []core.GroupRoleMapping, defaultRole string) (*model.User, error) {
+	roleName := mapGroupsToRole(identity.Groups, mappings, defaultRole)
+	if roleName == "" {
+		return nil, errors.WithMessagef(constant.ErrAuth, "no role mapped for user %s", identity.Name)
+	}
+	role, err := RoleRepo.Get(RoleRepo.WithByName(roleName))
+	if err != nil {
+		return nil, errors.WithMessagef(constant.ErrRecordNotFound, "role %s not found", roleName)
+	}
+
+	user, err := UserRepo.Get(UserRepo.WithBySource(identity.Source), UserRepo.WithByExternalID(identity.ExternalID))
+	if err == nil {
+		if user.Valid == 0 {
+			return nil, errors.WithMessage(constant.ErrAuth, "user is disabled")
+		}
+		if user.RoleID != role.ID {
+			if err := UserRepo.Update(user.ID, map[string]interface{}{"role_id": role.ID}); err != nil {
+				return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+			}
+			user.RoleID = role.ID
+		}
+		return &user, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+
+	// 同名用户已存在时不自动关联，避免身份源冒用本地账号
+	if _, err := UserRepo.Get(UserRepo.WithByName(identity.Name)); err == nil {
+		return nil, errors.WithMessagef(constant.ErrNameIsExist, "user %s already exists", identity.Name)
+	}
+	group, err := GroupRepo.Get(GroupRepo.WithByName("default"))
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+
+	// 外部用户不使用本地密码，填充随机值
+	password, err := utils.GenerateSecureToken(32)
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	salt := utils.GenerateNonce(8)
+	user = model.User{
+		Name:       identity.Name,
+		Password:   utils.HashPassword(password, salt),
+		Salt:       salt,
+		RoleID:     role.ID,
+		GroupID:    group.ID,
+		Valid:      1,
+		Source:     identity.Source,
+		ExternalID: identity.ExternalID,
+	}
+	if err := UserRepo.Create(&user); err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	global.LOG.Info("Provisioned %s user %s with role %s", identity.Source, user.Name, role.Name)
+	return &user, nil
+}
+
+// isLocalUser 本地用户使用密码登录，其余用户由外部身份源认证
+func isLocalUser(user *model.User) bool {
+	return user.Source == "" || user.Source == constant.UserSourceLocal
+}
+
+// mapGroupsToRole 返回第一条匹配的角色，均未匹配时返回默认角色
+func mapGroupsToRole(groups []string, mappings []core.GroupRoleMapping, defaultRole string) string {
+	for _, mapping := range mappings {
+		for _, group := range groups {
+			if group == mapping.Group {
+				return mapping.Role
+			}
+		}
+	}
+	return defaultRole
+}
+
+// validateRoleMappings 检查映射及默认角色引用的角色均存在
+func validateRoleMappings(mappings []core.GroupRoleMapping, defaultRole string) error {
+	roles := make([]string, 0, len(mappings)+1)
+	for _, mapping := range mappings {
+		roles = append(roles, mapping.Role)
+	}
+	if defaultRole != "" {
+		roles = append(roles, defaultRole)
+	}
+	for _, name := range roles {
+		if _, err := RoleRepo.Get(RoleRepo.WithByName(name)); err != nil {
+			return errors.WithMessagef(constant.ErrRecordNotFound, "role %s not found", name)
+		}
+	}
+	return nil
+}
+
+// loadSettingValues 批量读取设置项，不存在的项不返回
+func loadSettingValues(keys []string) map[string]string {
+	values := make(map[string]string, len(keys))
+	for _, key := range keys {
+		setting, err := SettingsRepo.Get(SettingsRepo.WithByKey(key))
+		if err == nil {
+			values[key] = setting.Value
+		}
+	}
+	return values
+}
+
+// saveSettingValues 按顺序保存设置项
+func saveSettingValues(keys []string, values []string) error {
+	for i, key := range keys {
+		if err := SettingsRepo.Upsert(key, values[i]); err != nil {
+			return errors.WithMessage(constant.ErrInternalServer, err.Error())
+		}
+	}
+	return nil
+}
+
+func settingBool(v bool) string {
+	if v {
+		return "yes"
+	}
+	return "no"
+}
@@ -23,23 +23,33 @@ import (
 	"github.com/sensdata/idb/core/utils"
 )
 
+const (
+	// 未指定时间范围时查询最近一小时
+	defaultMetricRange = 3600
+	// 未指定 step 时返回的最大点数
+	maxMetricPoints = 360
+)
+
 type HostService struct{}
 
 type IHostService interface {
-	ListGroup(req core.PageInfo) (*core.PageResult, error)
+	ListGroup(req core.PageInfo, groupScope []uint) (*core.PageResult, error)
 	CreateGroup(req core.CreateGroup) (*core.GroupInfo, error)
 	UpdateGroup(id uint, upMap map[string]interface{}) error
 	DeleteGroup(ids []uint) error
-	List(req core.ListHost) (*core.PageResult, error)
+	List(req core.ListHost, groupScope []uint) (*core.PageResult, error)
 	Create(req core.CreateHost) (*core.HostInfo, error)
 	Update(id uint, upMap map[string]interface{}) error
 	Delete(id uint) error
-	StatusFollow(c *gin.Context) error
+	StatusFollow(c *gin.Context, groupScope []uint) error
 	Info(id uint) (*core.HostInfo, error)
 	HostStatus(id uint) (*core.HostStatusInfo, error)
+	Metrics(id uint, req core.QueryHostMetrics) (*core.HostMetrics, error)
 	HostStatusFollow(c *gin.Context) error
 	UpdateSSH(id uint, req core.UpdateHostSSH) error
 	UpdateAgent(id uint, req core.UpdateHostAgent) error
+	UpdateRecording(id uint, req core.UpdateRecording) error
+	UpdateTags(id uint, req core.UpdateHostTags) error
 	TestSSH(req core.TestSSH) error
 	TestAgent(id uint, req core.TestAgent) error
 	InstallAgent(id uint, req core.InstallAgent) (*core.LogInfo, error)
@@ -53,9 +63,9 @@ func NewIHostService() IHostService {
 	return &HostService{}
 }
 
-// List host group
-func (s *HostService) ListGroup(req core.PageInfo) (*core.PageResult, error) {
-	total, groups, err := HostGroupRepo.Page(req.Page, req.PageSize)
+// List host group, groupScope 为 nil 时不限制设备组
+func (s *HostService) ListGroup(req core.PageInfo, groupScope []uint) (*core.PageResult, error) {
+	total, groups, err := HostGroupRepo.Page(req.Page, req.PageSize, HostGroupRepo.WithByIDs(groupScope))
 	if err != nil {
 		return nil, errors.WithMessage(constant.ErrNoRecords, err.Error())
 	}
@@ -99,13 +109,17 @@ func (s *HostService) DeleteGroup(ids []uint) error {
 		}
 	}
 
+	if err := HostScopeRepo.Delete(HostScopeRepo.WithByHostGroupIDs(ids)); err != nil {
+		return errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
 	return HostGroupRepo.Delete(CommonRepo.WithIdsIn(ids))
 }
 
-// List host
-func (s *HostService) List(req core.ListHost) (*core.PageResult, error) {
+// List host, groupScope 为 nil 时不限制设备组
+func (s *HostService) List(req core.ListHost, groupScope []uint) (*core.PageResult, error) {
 	var opts []repo.DBOption
 	opts = append(opts, HostRepo.WithByGroupID(req.GroupID))
+	opts = append(opts, HostRepo.WithByGroupIDs(groupScope))
 	if req.Keyword != "" {
 		opts = append(opts, HostRepo.WithByName(req.Keyword))
 	}
@@ -169,6 +183,9 @@ func (s *HostService) List(req core.ListHost) (*core.PageResult, error) {
 				AgentStatus:  *agentStatus,
 				AgentLatest:  latestVersion,
 				CanUpgrade:   host.AgentVersion != latestVersion,
+
+				RecordTerminal: host.RecordTerminal,
+				Tags:           splitTags(host.Tags),
 			},
 		)
 	}
@@ -266,11 +283,15 @@ func (s *HostService) Delete(id uint) error {
 	global.DeleteHostStatus(host.ID)
 	// 删除安装状态
 	global.DeleteInstalledStatus(host.ID)
+	// 删除历史指标
+	if err := HostMetricRepo.Delete(HostMetricRepo.WithByHostID(host.ID)); err != nil {
+		global.LOG.Error("Failed to delete metrics of host %d: %v", host.ID, err)
+	}
 
 	return HostRepo.Delete(CommonRepo.WithIdsIn([]uint{host.ID}))
 }
 
-func (s *HostService) StatusFollow(c *gin.Context) error {
+func (s *HostService) StatusFollow(c *gin.Context, groupScope []uint) error {
 	idsStr := c.Query("ids")
 	if idsStr == "" {
 		return fmt.Errorf("invalid ids")
@@ -282,6 +303,13 @@ func (s *HostService) StatusFollow(c *gin.Context) error {
 		if err != nil {
 			return fmt.Errorf("invalid id: %s", part)
 		}
+		// 跳过不在设备组范围内的设备
+		if groupScope != nil {
+			host, err := HostRepo.Get(HostRepo.WithByID(uint(id)), HostRepo.WithByGroupIDs(groupScope))
+			if err != nil || host.ID == 0 {
+				continue
+			}
+		}
 		ids = append(ids, id)
 	}
 
@@ -408,6 +436,9 @@ func (s *HostService) Info(id uint) (*core.HostInfo, error) {
 		AgentMode:    "",
 		AgentVersion: host.AgentVersion,
 		AgentStatus:  *agentStatus,
+
+		RecordTerminal: host.RecordTerminal,
+		Tags:           splitTags(host.Tags),
 	}, nil
 }
 
@@ -432,6 +463,94 @@ func (s *HostService) HostStatus(id uint) (*core.HostStatusInfo, error) {
 	return hostStatus, nil
 }
 
+// Metrics 查询设备的历史指标，按时间范围选择覆盖该范围的存储粒度，再按 step 合并
+func (s *HostService) Metrics(id uint, req core.QueryHostMetrics) (*core.HostMetrics, error) {
+	if _, err := HostRepo.Get(HostRepo.WithByID(id)); err != nil {
+		return nil, errors.WithMessage(constant.ErrHostNotFound, err.Error())
+	}
+
+	now := time.Now()
+	to := req.To
+	if to == 0 {
+		to = now.Unix()
+	}
+	from := req.From
+	if from == 0 {
+		from = to - defaultMetricRange
+	}
+	if from >= to {
+		return nil, errors.WithMessage(constant.ErrInvalidParams, "from must be earlier than to")
+	}
+	step := req.Step
+	if step == 0 {
+		step = int((to - from) / maxMetricPoints)
+	}
+
+	// 在仍保留起始时间数据的粒度中，选择不超过 step 的最粗粒度
+	var rollup *conn.MetricRollup
+	for i := range conn.MetricRollups {
+		r := conn.MetricRollups[i]
+		if i < len(conn.MetricRollups)-1 && from < now.Add(-r.Retention).Unix() {
+			continue
+		}
+		if rollup == nil || r.Resolution <= step {
+			rollup = &r
+		}
+	}
+	resolution := rollup.Resolution
+	step = (max(step, resolution) + resolution - 1) / resolution * resolution
+
+	metrics, err := HostMetricRepo.GetList(
+		HostMetricRepo.WithByHostID(id),
+		HostMetricRepo.WithByResolution(resolution),
+		HostMetricRepo.WithByTimeRange(from-from%int64(step), to),
+	)
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+
+	points := []core.HostMetricPoint{}
+	var current *model.HostMetric
+	appendPoint := func() {
+		if current != nil {
+			points = append(points, core.HostMetricPoint{
+				Timestamp:      current.Timestamp,
+				Cpu:            current.Cpu,
+				CpuMax:         current.CpuMax,
+				Memory:         current.Memory,
+				MemoryMax:      current.MemoryMax,
+				Disk:           current.Disk,
+				Rx:             current.Rx,
+				Tx:             current.Tx,
+				Goroutines:     current.Goroutines,
+				OpenFDs:        current.OpenFDs,
+				ActiveSessions: current.ActiveSessions,
+			})
+		}
+	}
+	for _, metric := range metrics {
+		metric.Timestamp -= metric.Timestamp % int64(step)
+		if current != nil && current.Timestamp == metric.Timestamp {
+			merged := conn.MergeHostMetric(*current, metric)
+			current = &merged
+			continue
+		}
+		appendPoint()
+		m := metric
+		current = &m
+	}
+	appendPoint()
+
+	return &core.HostMetrics{
+		HostID:     id,
+		From:       from,
+		To:         to,
+		Step:       step,
+		Resolution: resolution,
+		Points:     points,
+	}, nil
+}
+
 func (s *HostService) HostStatusFollow(c *gin.Context) error {
 	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
 	if err != nil {
@@ -494,6 +613,33 @@ func (s *HostService) HostStatusFollow(c *gin.Context) error {
 	}
 }
 
+// UpdateRecording 开启或关闭该设备的终端录制
+func (s *HostService) UpdateRecording(id uint, req core.UpdateRecording) error {
+	if _, err := HostRepo.Get(HostRepo.WithByID(id)); err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	return HostRepo.Update(id, map[string]interface{}{"record_terminal": req.Enabled})
+}
+
+// UpdateTags 设置设备标签，去除空白及重复项
+func (s *HostService) UpdateTags(id uint, req core.UpdateHostTags) error {
+	if _, err := HostRepo.Get(HostRepo.WithByID(id)); err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	tags := make([]string, 0, len(req.Tags))
+	for _, tag := range req.Tags {
+		tag = strings.TrimSpace(tag)
+		if tag != "" && !containsTag(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	value := strings.Join(tags, ",")
+	if len(value) > 256 {
+		return errors.WithMessage(constant.ErrInvalidParams, "too many tags")
+	}
+	return HostRepo.Update(id, map[string]interface{}{"tags": value})
+}
+
 func (s *HostService) UpdateSSH(id uint, req core.UpdateHostSSH) error {
 	//找host
 	host, err := HostRepo.Get(HostRepo.WithByID(id))
@@ -731,3 +877,22 @@ func (s *HostService) RestartAgent(id uint) error {
 
 	return nil
 }
+
+func splitTags(value string) []string {
+	tags := make([]string, 0)
+	for _, tag := range strings.Split(value, ",") {
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
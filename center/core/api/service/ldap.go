@@ -0,0 +1,251 @@
+package service
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/pkg/errors"
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"github.com/sensdata/idb/core/constant"
+	core "github.com/sensdata/idb/core/model"
+)
+
+const (
+	// 连接及查询目录服务的超时时间
+	ldapTimeout = 10 * time.Second
+
+	defaultLdapUserFilter = "(uid=%s)"
+	defaultLdapUserAttr   = "uid"
+	defaultLdapGroupAttr  = "memberOf"
+)
+
+type LdapService struct{}
+
+type ILdapService interface {
+	Settings() (*core.LdapSettings, error)
+	UpdateSettings(req core.LdapSettings) error
+}
+
+func NewILdapService() ILdapService {
+	return &LdapService{}
+}
+
+// ldapSettingKeys 设置项与 LdapSettings 字段的对应关系
+var ldapSettingKeys = []string{
+	"LdapEnabled",
+	"LdapURL",
+	"LdapStartTLS",
+	"LdapSkipVerify",
+	"LdapBindDN",
+	"LdapBindPassword",
+	"LdapSearchBase",
+	"LdapUserFilter",
+	"LdapUserAttr",
+	"LdapGroupAttr",
+	"LdapRoleMappings",
+	"LdapDefaultRole",
+}
+
+// Settings 查询 LDAP 配置，不返回服务账号密码
+func (s *LdapService) Settings() (*core.LdapSettings, error) {
+	settings, err := loadLdapSettings()
+	if err != nil {
+		return nil, err
+	}
+	settings.BindPassword = ""
+	return settings, nil
+}
+
+// UpdateSettings 保存 LDAP 配置，启用时校验目录服务可以连接
+func (s *LdapService) UpdateSettings(req core.LdapSettings) error {
+	req.URL = strings.TrimSpace(req.URL)
+	if err := validateRoleMappings(req.RoleMappings, req.DefaultRole); err != nil {
+		return err
+	}
+
+	current, err := loadLdapSettings()
+	if err != nil {
+		return err
+	}
+	if req.BindPassword == "" {
+		req.BindPassword = current.BindPassword
+	}
+	if req.UserFilter != "" && !strings.Contains(req.UserFilter, "%s") {
+		return errors.WithMessage(constant.ErrInvalidParams, "user_filter must contain %s")
+	}
+
+	if req.Enabled {
+		if req.URL == "" || req.SearchBase == "" {
+			return errors.WithMessage(constant.ErrInvalidParams, "url and search_base are required")
+		}
+		conn, err := ldapConnect(&req)
+		if err != nil {
+			return err
+		}
+		conn.Close()
+	}
+
+	mappings, err := json.Marshal(req.RoleMappings)
+	if err != nil {
+		return errors.WithMessage(constant.ErrJSONMarshal, err.Error())
+	}
+	values := []string{
+		settingBool(req.Enabled),
+		req.URL,
+		settingBool(req.StartTLS),
+		settingBool(req.SkipVerify),
+		req.BindDN,
+		req.BindPassword,
+		req.SearchBase,
+		req.UserFilter,
+		req.UserAttr,
+		req.GroupAttr,
+		string(mappings),
+		req.DefaultRole,
+	}
+	return saveSettingValues(ldapSettingKeys, values)
+}
+
+// loadLdapSettings 从设置表读取 LDAP 配置，未设置的项使用默认值
+func loadLdapSettings() (*core.LdapSettings, error) {
+	values := loadSettingValues(ldapSettingKeys)
+
+	settings := &core.LdapSettings{
+		Enabled:      values["LdapEnabled"] == "yes",
+		URL:          values["LdapURL"],
+		StartTLS:     values["LdapStartTLS"] == "yes",
+		SkipVerify:   values["LdapSkipVerify"] == "yes",
+		BindDN:       values["LdapBindDN"],
+		BindPassword: values["LdapBindPassword"],
+		SearchBase:   values["LdapSearchBase"],
+		UserFilter:   values["LdapUserFilter"],
+		UserAttr:     values["LdapUserAttr"],
+		GroupAttr:    values["LdapGroupAttr"],
+		DefaultRole:  values["LdapDefaultRole"],
+		RoleMappings: []core.GroupRoleMapping{},
+	}
+	if settings.UserFilter == "" {
+		settings.UserFilter = defaultLdapUserFilter
+	}
+	if settings.UserAttr == "" {
+		settings.UserAttr = defaultLdapUserAttr
+	}
+	if settings.GroupAttr == "" {
+		settings.GroupAttr = defaultLdapGroupAttr
+	}
+	if mappings := values["LdapRoleMappings"]; mappings != "" {
+		if err := json.Unmarshal([]byte(mappings), &settings.RoleMappings); err != nil {
+			return nil, errors.WithMessage(constant.ErrJSONMarshal, err.Error())
+		}
+	}
+	return settings, nil
+}
+
+// ldapLogin 在目录中查找用户并以其 DN 校验密码，通过后创建或更新本地用户
+func ldapLogin(settings *core.LdapSettings, name string, password string) (*model.User, error) {
+	// 空密码会被目录服务视为匿名绑定而成功，必须拒绝
+	if password == "" {
+		return nil, errors.WithMessage(constant.ErrInvalidAccountOrPassword, "empty password")
+	}
+
+	conn, err := ldapConnect(settings)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	result, err := conn.Search(ldap.NewSearchRequest(
+		settings.SearchBase,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, int(ldapTimeout.Seconds()), false,
+		strings.ReplaceAll(settings.UserFilter, "%s", ldap.EscapeFilter(name)),
+		[]string{settings.UserAttr, settings.GroupAttr},
+		nil,
+	))
+	if err != nil {
+		return nil, errors.WithMessagef(constant.ErrLdapUnavailable, "search failed: %v", err)
+	}
+	if len(result.Entries) != 1 {
+		return nil, errors.WithMessagef(constant.ErrInvalidAccountOrPassword, "found %d entries for %s", len(result.Entries), name)
+	}
+	entry := result.Entries[0]
+
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, errors.WithMessage(constant.ErrInvalidAccountOrPassword, err.Error())
+		}
+		return nil, errors.WithMessagef(constant.ErrLdapUnavailable, "bind failed: %v", err)
+	}
+
+	username := entry.GetAttributeValue(settings.UserAttr)
+	if username == "" {
+		username = name
+	}
+	identity := externalIdentity{
+		Source:     constant.UserSourceLdap,
+		ExternalID: strings.ToLower(username),
+		Name:       username,
+		Groups:     ldapGroups(entry.GetAttributeValues(settings.GroupAttr)),
+	}
+	return provisionExternalUser(identity, settings.RoleMappings, settings.DefaultRole)
+}
+
+// ldapConnect 连接目录服务，配置了服务账号时先绑定服务账号
+func ldapConnect(settings *core.LdapSettings) (*ldap.Conn, error) {
+	u, err := url.Parse(settings.URL)
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrInvalidParams, err.Error())
+	}
+	tlsConfig := &tls.Config{
+		ServerName:         u.Hostname(),
+		InsecureSkipVerify: settings.SkipVerify,
+	}
+
+	conn, err := ldap.DialURL(settings.URL,
+		ldap.DialWithDialer(&net.Dialer{Timeout: ldapTimeout}),
+		ldap.DialWithTLSConfig(tlsConfig),
+	)
+	if err != nil {
+		global.LOG.Error("Failed to connect ldap %s: %v", settings.URL, err)
+		return nil, errors.WithMessage(constant.ErrLdapUnavailable, err.Error())
+	}
+	conn.SetTimeout(ldapTimeout)
+
+	if settings.StartTLS && u.Scheme != "ldaps" {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, errors.WithMessage(constant.ErrLdapUnavailable, err.Error())
+		}
+	}
+	if settings.BindDN != "" {
+		if err := conn.Bind(settings.BindDN, settings.BindPassword); err != nil {
+			conn.Close()
+			global.LOG.Error("Failed to bind ldap service account %s: %v", settings.BindDN, err)
+			return nil, errors.WithMessagef(constant.ErrLdapUnavailable, "service account bind failed: %v", err)
+		}
+	}
+	return conn, nil
+}
+
+// ldapGroups 同时返回组的完整 DN 及 CN，映射时可任选其一
+func ldapGroups(values []string) []string {
+	groups := make([]string, 0, len(values)*2)
+	for _, value := range values {
+		groups = append(groups, value)
+		dn, err := ldap.ParseDN(value)
+		if err != nil || len(dn.RDNs) == 0 {
+			continue
+		}
+		for _, attr := range dn.RDNs[0].Attributes {
+			if strings.EqualFold(attr.Type, "cn") {
+				groups = append(groups, attr.Value)
+			}
+		}
+	}
+	return groups
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// 同一账号、同一来源 IP 连续失败达到阈值后开始锁定
+	accountFailThreshold = 5
+	ipFailThreshold      = 20
+	// 锁定时长从 loginLockBase 开始逐次翻倍，最长 loginLockMax
+	loginLockBase = time.Minute
+	loginLockMax  = time.Hour
+	// 超过该时长没有新的失败则清零计数
+	loginFailWindow = 24 * time.Hour
+)
+
+// loginFailure 某个账号或来源 IP 的连续登录失败记录
+type loginFailure struct {
+	count       int
+	lastFail    time.Time
+	lockedUntil time.Time
+}
+
+var (
+	loginFailures  = make(map[string]*loginFailure)
+	loginFailureMu sync.Mutex
+)
+
+// loginLockedFor 返回账号或来源 IP 的剩余锁定时长，0 表示允许登录
+func loginLockedFor(name string, ip string) time.Duration {
+	loginFailureMu.Lock()
+	defer loginFailureMu.Unlock()
+
+	now := time.Now()
+	var wait time.Duration
+	for _, key := range []string{accountKey(name), ipKey(ip)} {
+		if v, ok := loginFailures[key]; ok && v.lockedUntil.After(now) {
+			if d := v.lockedUntil.Sub(now); d > wait {
+				wait = d
+			}
+		}
+	}
+	return wait
+}
+
+// recordLoginFailure 累计失败次数，账号不存在时同样计数，避免借此探测用户名
+func recordLoginFailure(name string, ip string) {
+	loginFailureMu.Lock()
+	defer loginFailureMu.Unlock()
+
+	now := time.Now()
+	for k, v := range loginFailures {
+		if now.Sub(v.lastFail) > loginFailWindow {
+			delete(loginFailures, k)
+		}
+	}
+	addLoginFailure(accountKey(name), accountFailThreshold, now)
+	addLoginFailure(ipKey(ip), ipFailThreshold, now)
+}
+
+// resetLoginFailure 登录成功后清除账号及来源 IP 的失败记录
+func resetLoginFailure(name string, ip string) {
+	loginFailureMu.Lock()
+	defer loginFailureMu.Unlock()
+	delete(loginFailures, accountKey(name))
+	delete(loginFailures, ipKey(ip))
+}
+
+func addLoginFailure(key string, threshold int, now time.Time) {
+	v, ok := loginFailures[key]
+	if !ok {
+		v = &loginFailure{}
+		loginFailures[key] = v
+	}
+	v.count++
+	v.lastFail = now
+	if v.count < threshold {
+		return
+	}
+	lock := loginLockMax
+	if shift := v.count - threshold; shift < 6 {
+		lock = loginLockBase << shift
+		if lock > loginLockMax {
+			lock = loginLockMax
+		}
+	}
+	v.lockedUntil = now.Add(lock)
+}
+
+func accountKey(name string) string {
+	return "user:" + name
+}
+
+func ipKey(ip string) string {
+	return "ip:" + ip
+}
@@ -0,0 +1,538 @@
+package service
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-resty/resty/v2"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+	"github.com/sensdata/idb/center/global"
+	"github.com/sensdata/idb/core/constant"
+	core "github.com/sensdata/idb/core/model"
+	"github.com/sensdata/idb/core/utils"
+)
+
+const (
+	// 登录请求的 state 有效期
+	oidcStateTTL = 10 * time.Minute
+	// state 同时写入 HttpOnly cookie，回调时比对，确保回调来自发起登录的浏览器，防止登录 CSRF
+	oidcStateCookie     = "idb-oidc-state"
+	oidcStateCookiePath = "/api/v1/auth/oidc"
+	// 发现文档及签名公钥的缓存时长
+	oidcProviderTTL = time.Hour
+
+	defaultOidcScopes        = "openid profile email groups"
+	defaultOidcGroupsClaim   = "groups"
+	defaultOidcUsernameClaim = "preferred_username"
+)
+
+type OidcService struct{}
+
+type IOidcService interface {
+	Status() *core.OidcStatus
+	Settings() (*core.OidcSettings, error)
+	UpdateSettings(req core.OidcSettings) error
+	AuthURL(c *gin.Context) (string, error)
+	Callback(c *gin.Context, code string, state string) (*core.LoginResult, error)
+}
+
+func NewIOidcService() IOidcService {
+	return &OidcService{}
+}
+
+// oidcProvider 身份源的发现文档及签名公钥
+type oidcProvider struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+
+	fetchedAt time.Time
+	keys      map[string]interface{}
+}
+
+// oidcLoginState 跳转到身份源前生成，回调时校验并作废
+type oidcLoginState struct {
+	nonce    string
+	verifier string
+	expireAt time.Time
+}
+
+type oidcJwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type oidcTokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+var (
+	oidcProviders = make(map[string]*oidcProvider)
+	oidcStates    = make(map[string]*oidcLoginState)
+	oidcMu        sync.Mutex
+)
+
+// oidcSettingKeys 设置项与 OidcSettings 字段的对应关系
+var oidcSettingKeys = []string{
+	"OidcEnabled",
+	"OidcIssuer",
+	"OidcClientID",
+	"OidcClientSecret",
+	"OidcRedirectURL",
+	"OidcScopes",
+	"OidcGroupsClaim",
+	"OidcUsernameClaim",
+	"OidcRoleMappings",
+	"OidcDefaultRole",
+}
+
+// Status 登录页据此决定是否显示单点登录入口
+func (s *OidcService) Status() *core.OidcStatus {
+	settings, err := loadOidcSettings()
+	return &core.OidcStatus{Enabled: err == nil && settings.Enabled}
+}
+
+// Settings 查询 OIDC 配置，不返回 client secret
+func (s *OidcService) Settings() (*core.OidcSettings, error) {
+	settings, err := loadOidcSettings()
+	if err != nil {
+		return nil, err
+	}
+	settings.ClientSecret = ""
+	return settings, nil
+}
+
+// UpdateSettings 保存 OIDC 配置，启用时校验发现文档可以访问
+func (s *OidcService) UpdateSettings(req core.OidcSettings) error {
+	req.Issuer = strings.TrimSuffix(strings.TrimSpace(req.Issuer), "/")
+	if err := validateRoleMappings(req.RoleMappings, req.DefaultRole); err != nil {
+		return err
+	}
+
+	current, err := loadOidcSettings()
+	if err != nil {
+		return err
+	}
+	if req.ClientSecret == "" {
+		req.ClientSecret = current.ClientSecret
+	}
+
+	if req.Enabled {
+		if req.Issuer == "" || req.ClientID == "" || req.RedirectURL == "" {
+			return errors.WithMessage(constant.ErrInvalidParams, "issuer, client_id and redirect_url are required")
+		}
+		if _, err := discoverOidc(req.Issuer, true); err != nil {
+			return err
+		}
+	}
+
+	mappings, err := json.Marshal(req.RoleMappings)
+	if err != nil {
+		return errors.WithMessage(constant.ErrJSONMarshal, err.Error())
+	}
+	values := []string{
+		settingBool(req.Enabled),
+		req.Issuer,
+		req.ClientID,
+		req.ClientSecret,
+		req.RedirectURL,
+		req.Scopes,
+		req.GroupsClaim,
+		req.UsernameClaim,
+		string(mappings),
+		req.DefaultRole,
+	}
+	return saveSettingValues(oidcSettingKeys, values)
+}
+
+// AuthURL 生成跳转到身份源的授权地址，使用 state、nonce 及 PKCE
+func (s *OidcService) AuthURL(c *gin.Context) (string, error) {
+	settings, err := loadOidcSettings()
+	if err != nil {
+		return "", err
+	}
+	if !settings.Enabled {
+		return "", errors.WithMessage(constant.ErrAuth, "oidc login is disabled")
+	}
+	provider, err := discoverOidc(settings.Issuer, false)
+	if err != nil {
+		return "", err
+	}
+
+	var secrets [3]string
+	for i, n := range []int{16, 16, 32} {
+		if secrets[i], err = utils.GenerateSecureToken(n); err != nil {
+			return "", errors.WithMessage(constant.ErrInternalServer, err.Error())
+		}
+	}
+	state := secrets[0]
+	loginState := &oidcLoginState{
+		nonce:    secrets[1],
+		verifier: secrets[2],
+		expireAt: time.Now().Add(oidcStateTTL),
+	}
+	saveOidcState(state, loginState)
+	// 身份源回调是跨站的顶层跳转，需要 Lax 才会携带
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcStateCookie, state, int(oidcStateTTL.Seconds()), oidcStateCookiePath, "", c.Request.TLS != nil, true)
+
+	challenge := sha256.Sum256([]byte(loginState.verifier))
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", settings.ClientID)
+	query.Set("redirect_uri", settings.RedirectURL)
+	query.Set("scope", settings.Scopes)
+	query.Set("state", state)
+	query.Set("nonce", loginState.nonce)
+	query.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+	query.Set("code_challenge_method", "S256")
+
+	sep := "?"
+	if strings.Contains(provider.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return provider.AuthorizationEndpoint + sep + query.Encode(), nil
+}
+
+// Callback 用授权码换取 id_token，校验后创建或更新用户并签发 token
+func (s *OidcService) Callback(c *gin.Context, code string, state string) (*core.LoginResult, error) {
+	browserState, _ := c.Cookie(oidcStateCookie)
+	c.SetCookie(oidcStateCookie, "", -1, oidcStateCookiePath, "", c.Request.TLS != nil, true)
+	if state == "" || subtle.ConstantTimeCompare([]byte(browserState), []byte(state)) != 1 {
+		return nil, errors.WithMessage(constant.ErrAuth, "state does not match this browser, please login again")
+	}
+	loginState, ok := takeOidcState(state)
+	if !ok {
+		return nil, errors.WithMessage(constant.ErrAuth, "invalid or expired state, please login again")
+	}
+	settings, err := loadOidcSettings()
+	if err != nil {
+		return nil, err
+	}
+	if !settings.Enabled {
+		return nil, errors.WithMessage(constant.ErrAuth, "oidc login is disabled")
+	}
+	provider, err := discoverOidc(settings.Issuer, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokenResp oidcTokenResponse
+	resp, err := resty.New().
+		SetTimeout(10*time.Second).
+		R().
+		SetBasicAuth(url.QueryEscape(settings.ClientID), url.QueryEscape(settings.ClientSecret)).
+		SetFormData(map[string]string{
+			"grant_type":    "authorization_code",
+			"code":          code,
+			"redirect_uri":  settings.RedirectURL,
+			"client_id":     settings.ClientID,
+			"code_verifier": loginState.verifier,
+		}).
+		Post(provider.TokenEndpoint)
+	if err != nil {
+		global.LOG.Error("Failed to exchange oidc code: %v", err)
+		return nil, errors.WithMessage(constant.ErrAuth, err.Error())
+	}
+	_ = json.Unmarshal(resp.Body(), &tokenResp)
+	if resp.IsError() || tokenResp.IDToken == "" {
+		global.LOG.Error("Failed to exchange oidc code: %s %s", resp.Status(), tokenResp.Error)
+		return nil, errors.WithMessagef(constant.ErrAuth, "token exchange failed: %s %s", tokenResp.Error, tokenResp.ErrorDescription)
+	}
+
+	claims, err := verifyIDToken(provider, settings, tokenResp.IDToken, loginState.nonce)
+	if err != nil {
+		global.LOG.Error("Failed to verify oidc id token: %v", err)
+		return nil, errors.WithMessage(constant.ErrAuth, err.Error())
+	}
+
+	identity := externalIdentity{
+		Source:     constant.UserSourceOidc,
+		ExternalID: claimString(claims, "sub"),
+		Name:       claimString(claims, settings.UsernameClaim),
+		Groups:     claimStrings(claims, settings.GroupsClaim),
+	}
+	if identity.Name == "" {
+		identity.Name = claimString(claims, "email")
+	}
+	if identity.Name == "" {
+		identity.Name = identity.ExternalID
+	}
+	user, err := provisionExternalUser(identity, settings.RoleMappings, settings.DefaultRole)
+	if err != nil {
+		global.LOG.Error("Failed to provision oidc user %s: %v", identity.Name, err)
+		return nil, err
+	}
+	return issueToken(c, user)
+}
+
+// loadOidcSettings 从设置表读取 OIDC 配置，未设置的项使用默认值
+func loadOidcSettings() (*core.OidcSettings, error) {
+	values := loadSettingValues(oidcSettingKeys)
+
+	settings := &core.OidcSettings{
+		Enabled:       values["OidcEnabled"] == "yes",
+		Issuer:        values["OidcIssuer"],
+		ClientID:      values["OidcClientID"],
+		ClientSecret:  values["OidcClientSecret"],
+		RedirectURL:   values["OidcRedirectURL"],
+		Scopes:        values["OidcScopes"],
+		GroupsClaim:   values["OidcGroupsClaim"],
+		UsernameClaim: values["OidcUsernameClaim"],
+		DefaultRole:   values["OidcDefaultRole"],
+		RoleMappings:  []core.GroupRoleMapping{},
+	}
+	if settings.Scopes == "" {
+		settings.Scopes = defaultOidcScopes
+	}
+	if settings.GroupsClaim == "" {
+		settings.GroupsClaim = defaultOidcGroupsClaim
+	}
+	if settings.UsernameClaim == "" {
+		settings.UsernameClaim = defaultOidcUsernameClaim
+	}
+	if mappings := values["OidcRoleMappings"]; mappings != "" {
+		if err := json.Unmarshal([]byte(mappings), &settings.RoleMappings); err != nil {
+			return nil, errors.WithMessage(constant.ErrJSONMarshal, err.Error())
+		}
+	}
+	return settings, nil
+}
+
+// discoverOidc 读取发现文档及签名公钥，结果按 issuer 缓存
+func discoverOidc(issuer string, refresh bool) (*oidcProvider, error) {
+	oidcMu.Lock()
+	cached, ok := oidcProviders[issuer]
+	oidcMu.Unlock()
+	if ok && !refresh && time.Since(cached.fetchedAt) < oidcProviderTTL {
+		return cached, nil
+	}
+
+	var provider oidcProvider
+	if err := getOidcJSON(issuer+"/.well-known/openid-configuration", &provider); err != nil {
+		return nil, errors.WithMessagef(constant.ErrInternalServer, "failed to fetch oidc discovery: %v", err)
+	}
+	if strings.TrimSuffix(provider.Issuer, "/") != issuer {
+		return nil, errors.WithMessagef(constant.ErrInvalidParams, "issuer mismatch: %s", provider.Issuer)
+	}
+	if provider.AuthorizationEndpoint == "" || provider.TokenEndpoint == "" || provider.JwksURI == "" {
+		return nil, errors.WithMessage(constant.ErrInvalidParams, "incomplete oidc discovery document")
+	}
+	keys, err := fetchOidcKeys(provider.JwksURI)
+	if err != nil {
+		return nil, err
+	}
+	provider.keys = keys
+	provider.fetchedAt = time.Now()
+
+	oidcMu.Lock()
+	oidcProviders[issuer] = &provider
+	oidcMu.Unlock()
+	return &provider, nil
+}
+
+// fetchOidcKeys 读取 JWKS，支持 RSA 及 EC 公钥
+func fetchOidcKeys(jwksURI string) (map[string]interface{}, error) {
+	var jwks struct {
+		Keys []oidcJwk `json:"keys"`
+	}
+	if err := getOidcJSON(jwksURI, &jwks); err != nil {
+		return nil, errors.WithMessagef(constant.ErrInternalServer, "failed to fetch jwks: %v", err)
+	}
+
+	keys := make(map[string]interface{}, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			global.LOG.Error("Skip jwk %s: %v", jwk.Kid, err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+// getOidcJSON 读取身份源的 JSON 文档，不依赖响应的 Content-Type
+func getOidcJSON(target string, result interface{}) error {
+	resp, err := resty.New().
+		SetTimeout(10 * time.Second).
+		R().
+		Get(target)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("%s", resp.Status())
+	}
+	return json.Unmarshal(resp.Body(), result)
+}
+
+func (k oidcJwk) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+// verifyIDToken 校验 id_token 的签名、签发方、受众、有效期及 nonce
+func verifyIDToken(provider *oidcProvider, settings *core.OidcSettings, idToken string, nonce string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}))
+	_, err := parser.ParseWithClaims(idToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		if key := provider.key(kid); key != nil {
+			return key, nil
+		}
+		// 身份源轮换了密钥，重新读取一次
+		refreshed, err := discoverOidc(settings.Issuer, true)
+		if err != nil {
+			return nil, err
+		}
+		if key := refreshed.key(kid); key != nil {
+			return key, nil
+		}
+		return nil, fmt.Errorf("unknown signing key %s", kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSuffix(claimString(claims, "iss"), "/") != settings.Issuer {
+		return nil, errors.New("issuer mismatch")
+	}
+	if !claims.VerifyAudience(settings.ClientID, true) {
+		return nil, errors.New("audience mismatch")
+	}
+	if _, ok := claims["exp"]; !ok {
+		return nil, errors.New("missing exp")
+	}
+	if claimString(claims, "nonce") != nonce {
+		return nil, errors.New("nonce mismatch")
+	}
+	if claimString(claims, "sub") == "" {
+		return nil, errors.New("missing sub")
+	}
+	return claims, nil
+}
+
+// key 按 kid 查找公钥，id_token 未指定 kid 且只有一个公钥时直接使用
+func (p *oidcProvider) key(kid string) interface{} {
+	if key, ok := p.keys[kid]; ok {
+		return key
+	}
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key
+		}
+	}
+	return nil
+}
+
+func saveOidcState(state string, loginState *oidcLoginState) {
+	oidcMu.Lock()
+	defer oidcMu.Unlock()
+
+	now := time.Now()
+	for k, v := range oidcStates {
+		if now.After(v.expireAt) {
+			delete(oidcStates, k)
+		}
+	}
+	oidcStates[state] = loginState
+}
+
+// takeOidcState 取出并作废 state，每个 state 只能使用一次
+func takeOidcState(state string) (*oidcLoginState, bool) {
+	oidcMu.Lock()
+	defer oidcMu.Unlock()
+
+	v, ok := oidcStates[state]
+	if !ok {
+		return nil, false
+	}
+	delete(oidcStates, state)
+	if time.Now().After(v.expireAt) {
+		return nil, false
+	}
+	return v, true
+}
+
+func claimString(claims jwt.MapClaims, name string) string {
+	v, _ := claims[name].(string)
+	return v
+}
+
+// claimStrings 读取字符串数组类型的声明，兼容单个字符串
+func claimStrings(claims jwt.MapClaims, name string) []string {
+	switch v := claims[name].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,373 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"github.com/sensdata/idb/core/constant"
+	"github.com/sensdata/idb/core/log"
+	core "github.com/sensdata/idb/core/model"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const (
+	testOidcClientID     = "idb-test"
+	testOidcClientSecret = "s3cret"
+	testOidcRedirectURL  = "https://idb.example.com/oidc/callback"
+	testOidcKid          = "test-key"
+)
+
+// mockIssuer 模拟身份源，提供发现文档、JWKS 及 token 接口，授权码由测试直接登记
+type mockIssuer struct {
+	t      *testing.T
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mu    sync.Mutex
+	codes map[string]mockAuthCode
+}
+
+// mockAuthCode 授权码对应的 PKCE challenge 及要返回的 id_token
+type mockAuthCode struct {
+	challenge string
+	idToken   string
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &mockIssuer{t: t, key: key, codes: map[string]mockAuthCode{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]string{
+			"issuer":                 m.server.URL,
+			"authorization_endpoint": m.server.URL + "/authorize",
+			"token_endpoint":         m.server.URL + "/token",
+			"jwks_uri":               m.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": testOidcKid,
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", m.token)
+	m.server = httptest.NewServer(mux)
+	t.Cleanup(m.server.Close)
+	return m
+}
+
+// token 校验客户端凭据、授权码及 PKCE verifier
+func (m *mockIssuer) token(w http.ResponseWriter, r *http.Request) {
+	id, secret, ok := r.BasicAuth()
+	if !ok || id != testOidcClientID || secret != testOidcClientSecret {
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJSON(w, map[string]string{"error": "invalid_client"})
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	m.mu.Lock()
+	code, ok := m.codes[r.PostForm.Get("code")]
+	delete(m.codes, r.PostForm.Get("code"))
+	m.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || r.PostForm.Get("grant_type") != "authorization_code" ||
+		r.PostForm.Get("redirect_uri") != testOidcRedirectURL ||
+		base64.RawURLEncoding.EncodeToString(sum[:]) != code.challenge {
+		w.WriteHeader(http.StatusBadRequest)
+		writeJSON(w, map[string]string{"error": "invalid_grant"})
+		return
+	}
+	writeJSON(w, map[string]string{"access_token": "at", "token_type": "Bearer", "id_token": code.idToken})
+}
+
+// authorize 模拟用户在身份源完成登录，登记授权码并返回
+func (m *mockIssuer) authorize(authURL string, idToken string) (code string, state string) {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	q := u.Query()
+	if q.Get("code_challenge_method") != "S256" || q.Get("client_id") != testOidcClientID {
+		m.t.Fatalf("unexpected authorization request %s", authURL)
+	}
+	code = "code-" + q.Get("state")
+	m.mu.Lock()
+	m.codes[code] = mockAuthCode{challenge: q.Get("code_challenge"), idToken: idToken}
+	m.mu.Unlock()
+	return code, q.Get("state")
+}
+
+// sign 以 key 签发 id_token
+func (m *mockIssuer) sign(key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = testOidcKid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	return signed
+}
+
+func (m *mockIssuer) claims(nonce string) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":                m.server.URL,
+		"aud":                testOidcClientID,
+		"sub":                "user-1",
+		"preferred_username": "alice",
+		"groups":             []string{"ops"},
+		"nonce":              nonce,
+		"iat":                now.Unix(),
+		"exp":                now.Add(5 * time.Minute).Unix(),
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// setupOidcTest 使用临时的 sqlite 数据库，创建角色及默认分组，并启用指向 mock 身份源的 OIDC
+func setupOidcTest(t *testing.T) *mockIssuer {
+	dir := t.TempDir()
+	l, err := log.InitLogger(dir, "test.log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	global.LOG = l
+	db, err := gorm.Open(sqlite.Open(filepath.Join(dir, "test.db")), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&model.Setting{}, &model.User{}, &model.Role{}, &model.Group{}, &model.LoginSession{}); err != nil {
+		t.Fatal(err)
+	}
+	db.Create(&model.Role{Name: "admin"})
+	db.Create(&model.Role{Name: "viewer"})
+	db.Create(&model.Group{GroupName: "default"})
+	global.DB = db
+	global.JWTKey = "test-jwt-key"
+
+	oidcMu.Lock()
+	oidcProviders = make(map[string]*oidcProvider)
+	oidcStates = make(map[string]*oidcLoginState)
+	oidcMu.Unlock()
+
+	issuer := newMockIssuer(t)
+	err = NewIOidcService().UpdateSettings(core.OidcSettings{
+		Enabled:      true,
+		Issuer:       issuer.server.URL,
+		ClientID:     testOidcClientID,
+		ClientSecret: testOidcClientSecret,
+		RedirectURL:  testOidcRedirectURL,
+		RoleMappings: []core.GroupRoleMapping{{Group: "ops", Role: "admin"}},
+		DefaultRole:  "viewer",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return issuer
+}
+
+// oidcTestContext 模拟浏览器回调，cookie 为发起登录时写入的 state cookie
+func oidcTestContext(cookie *http.Cookie) *gin.Context {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/auth/oidc/callback", nil)
+	if cookie != nil {
+		c.Request.AddCookie(cookie)
+	}
+	return c
+}
+
+// startLogin 发起登录，返回授权地址及写入浏览器的 state cookie
+func startLogin(t *testing.T, s IOidcService) (string, *http.Cookie) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/auth/oidc/login", nil)
+	authURL, err := s.AuthURL(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cookie := range w.Result().Cookies() {
+		if cookie.Name == oidcStateCookie {
+			if !cookie.HttpOnly || cookie.MaxAge <= 0 {
+				t.Errorf("state cookie = %+v, want short-lived HttpOnly", cookie)
+			}
+			return authURL, cookie
+		}
+	}
+	t.Fatal("state cookie not set")
+	return "", nil
+}
+
+// login 走完一次授权流程，modify 用于在签发前修改 id_token 的声明，key 为空时使用身份源的密钥
+func login(t *testing.T, issuer *mockIssuer, key *rsa.PrivateKey, modify func(claims jwt.MapClaims)) (*core.LoginResult, error) {
+	s := NewIOidcService()
+	authURL, cookie := startLogin(t, s)
+	u, _ := url.Parse(authURL)
+	claims := issuer.claims(u.Query().Get("nonce"))
+	if modify != nil {
+		modify(claims)
+	}
+	if key == nil {
+		key = issuer.key
+	}
+	code, state := issuer.authorize(authURL, issuer.sign(key, claims))
+	return s.Callback(oidcTestContext(cookie), code, state)
+}
+
+func TestOidcLogin(t *testing.T) {
+	issuer := setupOidcTest(t)
+
+	result, err := login(t, issuer, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Name != "alice" || result.Token == "" || result.TwoFactorSetup {
+		t.Errorf("result = %+v", result)
+	}
+	user, err := UserRepo.Get(UserRepo.WithByName("alice"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	role, _ := RoleRepo.Get(RoleRepo.WithByName("admin"))
+	if user.Source != constant.UserSourceOidc || user.ExternalID != "user-1" || user.RoleID != role.ID {
+		t.Errorf("user = %+v", user)
+	}
+
+	// 再次登录时按最新的组更新角色
+	if _, err := login(t, issuer, nil, func(claims jwt.MapClaims) { claims["groups"] = []string{"dev"} }); err != nil {
+		t.Fatal(err)
+	}
+	user, _ = UserRepo.Get(UserRepo.WithByName("alice"))
+	role, _ = RoleRepo.Get(RoleRepo.WithByName("viewer"))
+	if user.RoleID != role.ID {
+		t.Errorf("role id = %d, want %d", user.RoleID, role.ID)
+	}
+}
+
+func TestOidcLoginRejected(t *testing.T) {
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		key     *rsa.PrivateKey
+		modify  func(claims jwt.MapClaims)
+		wantErr string
+	}{
+		{
+			name:    "bad nonce",
+			modify:  func(claims jwt.MapClaims) { claims["nonce"] = "replayed" },
+			wantErr: "nonce mismatch",
+		},
+		{
+			name:    "bad signature",
+			key:     otherKey,
+			wantErr: "verification error",
+		},
+		{
+			name: "expired token",
+			modify: func(claims jwt.MapClaims) {
+				claims["iat"] = time.Now().Add(-time.Hour).Unix()
+				claims["exp"] = time.Now().Add(-time.Minute).Unix()
+			},
+			wantErr: "expired",
+		},
+		{
+			name:    "wrong audience",
+			modify:  func(claims jwt.MapClaims) { claims["aud"] = "other-client" },
+			wantErr: "audience mismatch",
+		},
+		{
+			name:    "wrong issuer",
+			modify:  func(claims jwt.MapClaims) { claims["iss"] = "https://evil.example.com" },
+			wantErr: "issuer mismatch",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issuer := setupOidcTest(t)
+			_, err := login(t, issuer, tt.key, tt.modify)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want %q", err, tt.wantErr)
+			}
+			if _, err := UserRepo.Get(UserRepo.WithByName("alice")); err == nil {
+				t.Error("user provisioned for rejected login")
+			}
+		})
+	}
+}
+
+func TestOidcStateSingleUse(t *testing.T) {
+	issuer := setupOidcTest(t)
+	s := NewIOidcService()
+	authURL, cookie := startLogin(t, s)
+	u, _ := url.Parse(authURL)
+	code, state := issuer.authorize(authURL, issuer.sign(issuer.key, issuer.claims(u.Query().Get("nonce"))))
+	if _, err := s.Callback(oidcTestContext(cookie), code, state); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Callback(oidcTestContext(cookie), code, state); err == nil || !strings.Contains(err.Error(), "state") {
+		t.Fatalf("err = %v, want invalid state", err)
+	}
+	if _, err := s.Callback(oidcTestContext(&http.Cookie{Name: oidcStateCookie, Value: "unknown"}), code, "unknown"); err == nil {
+		t.Fatal("expected error for unknown state")
+	}
+}
+
+// TestOidcStateBoundToBrowser 回调必须携带发起登录时写入的 state cookie，防止登录 CSRF
+func TestOidcStateBoundToBrowser(t *testing.T) {
+	issuer := setupOidcTest(t)
+	s := NewIOidcService()
+
+	// 攻击者发起登录并在身份源完成认证，诱导受害者的浏览器访问回调地址
+	attackerURL, _ := startLogin(t, s)
+	u, _ := url.Parse(attackerURL)
+	code, state := issuer.authorize(attackerURL, issuer.sign(issuer.key, issuer.claims(u.Query().Get("nonce"))))
+	_, victimCookie := startLogin(t, s)
+
+	for name, cookie := range map[string]*http.Cookie{"missing cookie": nil, "other login": victimCookie} {
+		if _, err := s.Callback(oidcTestContext(cookie), code, state); err == nil || !strings.Contains(err.Error(), "does not match") {
+			t.Errorf("%s: err = %v, want state mismatch", name, err)
+		}
+	}
+	if _, err := UserRepo.Get(UserRepo.WithByName("alice")); err == nil {
+		t.Error("user provisioned for forged callback")
+	}
+}
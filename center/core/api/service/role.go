@@ -0,0 +1,148 @@
+package service
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sensdata/idb/core/constant"
+
+	"github.com/sensdata/idb/center/db/model"
+	core "github.com/sensdata/idb/core/model"
+)
+
+type RoleService struct{}
+
+type IRoleService interface {
+	List(req core.PageInfo) (*core.PageResult, error)
+	Create(req core.CreateRole) (*core.RoleDetail, error)
+	Update(req core.UpdateRole) error
+	Delete(id uint) error
+	SetHostScope(req core.UpdateHostScope) error
+}
+
+func NewIRoleService() IRoleService {
+	return &RoleService{}
+}
+
+// builtinRoles 内置角色不允许删除，admin 的权限也不允许修改
+var builtinRoles = []string{constant.RoleAdmin, constant.RoleUser, constant.RoleOperator, constant.RoleReadOnly}
+
+// List role
+func (s *RoleService) List(req core.PageInfo) (*core.PageResult, error) {
+	total, roles, err := RoleRepo.Page(req.Page, req.PageSize)
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrNoRecords, err.Error())
+	}
+
+	items := make([]core.RoleDetail, 0, len(roles))
+	for _, role := range roles {
+		permissions, err := PermissionRepo.GetList(PermissionRepo.WithByRoleID(role.ID))
+		if err != nil {
+			return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+		}
+		detail := toRoleDetail(role, permissions)
+		detail.HostGroupIDs = scopeGroupIDs(HostScopeRepo.WithByRoleID(role.ID))
+		items = append(items, detail)
+	}
+	return &core.PageResult{Total: total, Items: items}, nil
+}
+
+// Create role
+func (s *RoleService) Create(req core.CreateRole) (*core.RoleDetail, error) {
+	if _, err := RoleRepo.Get(RoleRepo.WithByName(req.Name)); err == nil {
+		return nil, constant.ErrNameIsExist
+	}
+
+	role := model.Role{Name: req.Name, Description: req.Description}
+	if err := RoleRepo.Create(&role); err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	permissions := toPermissions(req.Permissions)
+	if err := PermissionRepo.Replace(role.ID, permissions); err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	detail := toRoleDetail(role, permissions)
+	return &detail, nil
+}
+
+// Update role description and permissions
+func (s *RoleService) Update(req core.UpdateRole) error {
+	role, err := RoleRepo.Get(RoleRepo.WithByID(req.ID))
+	if err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	if role.Name == constant.RoleAdmin {
+		return errors.WithMessage(constant.ErrInternalServer, "can't modify admin role")
+	}
+
+	if err := RoleRepo.Update(role.ID, map[string]interface{}{"description": req.Description}); err != nil {
+		return errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	return PermissionRepo.Replace(role.ID, toPermissions(req.Permissions))
+}
+
+// Delete role
+func (s *RoleService) Delete(id uint) error {
+	role, err := RoleRepo.Get(RoleRepo.WithByID(id))
+	if err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	for _, name := range builtinRoles {
+		if role.Name == name {
+			return errors.WithMessage(constant.ErrInternalServer, "can't delete builtin role")
+		}
+	}
+	users, err := UserRepo.GetList(UserRepo.WithByRoleID(role.ID))
+	if err != nil {
+		return errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	if len(users) > 0 {
+		return errors.WithMessage(constant.ErrInternalServer, "role is in use")
+	}
+
+	if err := PermissionRepo.Delete(PermissionRepo.WithByRoleID(role.ID)); err != nil {
+		return errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	if err := HostScopeRepo.Delete(HostScopeRepo.WithByRoleID(role.ID)); err != nil {
+		return errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	return RoleRepo.Delete(CommonRepo.WithByID(role.ID))
+}
+
+func (s *RoleService) SetHostScope(req core.UpdateHostScope) error {
+	role, err := RoleRepo.Get(RoleRepo.WithByID(req.ID))
+	if err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	if role.Name == constant.RoleAdmin {
+		return errors.WithMessage(constant.ErrInternalServer, "can't modify admin role")
+	}
+	scopes, err := toHostScopes(req.HostGroupIDs)
+	if err != nil {
+		return err
+	}
+	for i := range scopes {
+		scopes[i].RoleID = role.ID
+	}
+	return HostScopeRepo.Replace(HostScopeRepo.WithByRoleID(role.ID), scopes)
+}
+
+func toPermissions(infos []core.PermissionInfo) []model.Permission {
+	permissions := make([]model.Permission, 0, len(infos))
+	for _, info := range infos {
+		permissions = append(permissions, model.Permission{Resource: info.Resource, Access: info.Access})
+	}
+	return permissions
+}
+
+func toRoleDetail(role model.Role, permissions []model.Permission) core.RoleDetail {
+	detail := core.RoleDetail{
+		ID:          role.ID,
+		CreatedAt:   role.CreatedAt,
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: make([]core.PermissionInfo, 0, len(permissions)),
+	}
+	for _, p := range permissions {
+		detail.Permissions = append(detail.Permissions, core.PermissionInfo{Resource: p.Resource, Access: p.Access})
+	}
+	return detail
+}
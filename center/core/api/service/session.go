@@ -0,0 +1,69 @@
+package service
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/core/constant"
+	core "github.com/sensdata/idb/core/model"
+)
+
+type SessionService struct{}
+
+type ISessionService interface {
+	List(userID uint, current string) ([]core.LoginSessionInfo, error)
+	Revoke(userID uint, id uint, isAdmin bool) error
+	RevokeAll(userID uint) error
+}
+
+func NewISessionService() ISessionService {
+	return &SessionService{}
+}
+
+// List 列出用户的登录会话，current 为当前请求的会话 ID
+func (s *SessionService) List(userID uint, current string) ([]core.LoginSessionInfo, error) {
+	sessions, err := SessionRepo.GetList(SessionRepo.WithByUserID(userID))
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrNoRecords, err.Error())
+	}
+	items := make([]core.LoginSessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		items = append(items, toSessionInfo(session, current))
+	}
+	return items, nil
+}
+
+// Revoke 吊销登录会话，非管理员只能吊销自己的会话
+func (s *SessionService) Revoke(userID uint, id uint, isAdmin bool) error {
+	session, err := SessionRepo.Get(SessionRepo.WithByID(id))
+	if err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	if session.UserID != userID && !isAdmin {
+		return constant.ErrAuth
+	}
+	if err := SessionRepo.Delete(SessionRepo.WithByID(session.ID)); err != nil {
+		return errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	return nil
+}
+
+// RevokeAll 吊销用户的全部登录会话
+func (s *SessionService) RevokeAll(userID uint) error {
+	if err := SessionRepo.Delete(SessionRepo.WithByUserID(userID)); err != nil {
+		return errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	return nil
+}
+
+func toSessionInfo(session model.LoginSession, current string) core.LoginSessionInfo {
+	return core.LoginSessionInfo{
+		ID:         session.ID,
+		CreatedAt:  session.CreatedAt,
+		UserID:     session.UserID,
+		SourceIP:   session.SourceIP,
+		UserAgent:  session.UserAgent,
+		ExpiresAt:  session.ExpiresAt,
+		LastSeenAt: session.LastSeenAt,
+		Current:    current != "" && session.SessionID == current,
+	}
+}
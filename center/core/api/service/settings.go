@@ -41,6 +41,11 @@ type ISettingsService interface {
 	Timezones(req model.SearchPageInfo) (*model.PageResult, error)
 	Settings() (*model.SettingInfo, error)
 	Update(req model.UpdateSettingRequest) (*model.UpdateSettingResponse, error)
+	Security() (*model.SecuritySettings, error)
+	UpdateSecurity(req model.SecuritySettings) error
+	Metrics() (*model.MetricsSettings, error)
+	GenerateMetricsToken() (*model.MetricsToken, error)
+	DisableMetrics() error
 	Upgrade() error
 }
 
@@ -453,6 +458,48 @@ func (s *SettingsService) Settings() (*model.SettingInfo, error) {
 	}, nil
 }
 
+func (s *SettingsService) Security() (*model.SecuritySettings, error) {
+	return &model.SecuritySettings{TwoFactorRequired: TwoFactorRequired()}, nil
+}
+
+func (s *SettingsService) UpdateSecurity(req model.SecuritySettings) error {
+	value := "no"
+	if req.TwoFactorRequired {
+		value = twoFactorSettingOn
+	}
+	return SettingsRepo.Upsert("TwoFactorRequired", value)
+}
+
+// metricsSettingKeys 采集令牌的摘要及前缀，摘要为空表示未开放采集
+var metricsSettingKeys = []string{"MetricsTokenHash", "MetricsTokenPrefix"}
+
+func (s *SettingsService) Metrics() (*model.MetricsSettings, error) {
+	values := loadSettingValues(metricsSettingKeys)
+	return &model.MetricsSettings{
+		Enabled: values["MetricsTokenHash"] != "",
+		Prefix:  values["MetricsTokenPrefix"],
+	}, nil
+}
+
+// GenerateMetricsToken 生成新的采集令牌，原令牌立即失效
+func (s *SettingsService) GenerateMetricsToken() (*model.MetricsToken, error) {
+	secret, err := utils.GenerateSecureToken(20)
+	if err != nil {
+		return nil, err
+	}
+	plain := constant.MetricsTokenPrefix + secret
+	values := []string{utils.HashToken(plain), plain[:len(constant.MetricsTokenPrefix)+4]}
+	if err := saveSettingValues(metricsSettingKeys, values); err != nil {
+		return nil, err
+	}
+	return &model.MetricsToken{Token: plain}, nil
+}
+
+// DisableMetrics 清除采集令牌，关闭 /metrics
+func (s *SettingsService) DisableMetrics() error {
+	return saveSettingValues(metricsSettingKeys, []string{"", ""})
+}
+
 func (s *SettingsService) Update(req model.UpdateSettingRequest) (*model.UpdateSettingResponse, error) {
 	var response model.UpdateSettingResponse
 	var scheme string
@@ -1,11 +1,20 @@
 package service
 
 import (
+	"bufio"
 	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
 	"time"
 
+	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/copier"
+	"github.com/pkg/errors"
 	"github.com/sensdata/idb/center/core/conn"
 	"github.com/sensdata/idb/center/global"
+	"github.com/sensdata/idb/core/constant"
 	"github.com/sensdata/idb/core/logstream/pkg/types"
 	"github.com/sensdata/idb/core/message"
 	"github.com/sensdata/idb/core/model"
@@ -21,6 +30,9 @@ type ITerminalService interface {
 	Quit(token string, hostID uint, req model.TerminalRequest) error
 	Rename(token string, hostID uint, req model.TerminalRequest) error
 	Install(hostID uint, req model.TerminalRequest) (*model.ScriptResult, error)
+	Recordings(hostID uint, req model.PageInfo) (*model.PageResult, error)
+	RecordingPath(hostID uint, id uint) (string, error)
+	StreamRecording(c *gin.Context, hostID uint, id uint) error
 }
 
 func NewITerminalService() ITerminalService {
@@ -273,3 +285,85 @@ func (s *TerminalService) Install(hostID uint, req model.TerminalRequest) (*mode
 	result.LogHost = hostID
 	return &result, nil
 }
+
+func (s *TerminalService) Recordings(hostID uint, req model.PageInfo) (*model.PageResult, error) {
+	total, recordings, err := TerminalRecordingRepo.Page(req.Page, req.PageSize, TerminalRecordingRepo.WithByHostID(hostID))
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrNoRecords, err.Error())
+	}
+	items := make([]model.TerminalRecordingInfo, 0, len(recordings))
+	if err := copier.Copy(&items, &recordings); err != nil {
+		return nil, errors.WithMessage(constant.ErrStructTransform, err.Error())
+	}
+	return &model.PageResult{Total: total, Items: items}, nil
+}
+
+func (s *TerminalService) RecordingPath(hostID uint, id uint) (string, error) {
+	recording, err := TerminalRecordingRepo.Get(TerminalRecordingRepo.WithByID(id), TerminalRecordingRepo.WithByHostID(hostID))
+	if err != nil {
+		return "", errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	return recording.Path, nil
+}
+
+// StreamRecording 以 SSE 逐行推送录像内容（header 及事件），录制中的会话持续推送新事件直到结束
+func (s *TerminalService) StreamRecording(c *gin.Context, hostID uint, id uint) error {
+	path, err := s.RecordingPath(hostID, id)
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// 设置 SSE 响应头
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+	c.Writer.Header().Set("Transfer-Encoding", "chunked")
+
+	ctx := c.Request.Context()
+	flusher, ok := c.Writer.(http.Flusher)
+	if !ok {
+		return fmt.Errorf("streaming not supported")
+	}
+
+	reader := bufio.NewReader(file)
+	var pending string
+	finished := false
+	for {
+		line, err := reader.ReadString('\n')
+		pending += line
+		if err == nil {
+			c.SSEvent("cast", strings.TrimSuffix(pending, "\n"))
+			flusher.Flush()
+			pending = ""
+			continue
+		}
+		if err != io.EOF {
+			return err
+		}
+
+		// 读到文件末尾：录制已结束则退出；刚结束时再读一轮，避免漏掉最后写入的内容
+		if finished {
+			if len(pending) > 0 {
+				c.SSEvent("cast", pending)
+			}
+			c.SSEvent("end", "")
+			flusher.Flush()
+			return nil
+		}
+		recording, err := TerminalRecordingRepo.Get(TerminalRecordingRepo.WithByID(id))
+		if err != nil || recording.Finished {
+			finished = true
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/core/constant"
+	core "github.com/sensdata/idb/core/model"
+	"github.com/sensdata/idb/core/utils"
+)
+
+const (
+	totpIssuer         = "iDB"
+	recoveryCodeCount  = 10
+	twoFactorSettingOn = "yes"
+)
+
+var errInvalidTwoFactorCode = errors.New("invalid two-factor code")
+
+type TwoFactorService struct{}
+
+type ITwoFactorService interface {
+	Setup(userID uint) (*core.TotpSetup, error)
+	Enable(userID uint, req core.TotpCode) (*core.RecoveryCodes, error)
+	Disable(userID uint, req core.TotpCode) error
+	RegenerateRecoveryCodes(userID uint, req core.TotpCode) (*core.RecoveryCodes, error)
+	Reset(req core.ResetTwoFactor) error
+}
+
+func NewITwoFactorService() ITwoFactorService {
+	return &TwoFactorService{}
+}
+
+// Setup 生成待确认的密钥，需调用 Enable 校验验证码后才生效
+func (s *TwoFactorService) Setup(userID uint) (*core.TotpSetup, error) {
+	user, err := UserRepo.Get(UserRepo.WithByID(userID))
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	if user.TotpEnabled {
+		return nil, errors.New("two-factor authentication already enabled")
+	}
+	secret, err := utils.GenerateTotpSecret()
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	if err := UserRepo.Update(user.ID, map[string]interface{}{"totp_secret": secret}); err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	return &core.TotpSetup{Secret: secret, URI: utils.TotpURI(totpIssuer, user.Name, secret)}, nil
+}
+
+// Enable 校验待确认密钥的验证码，启用两步验证并返回恢复码
+func (s *TwoFactorService) Enable(userID uint, req core.TotpCode) (*core.RecoveryCodes, error) {
+	user, err := UserRepo.Get(UserRepo.WithByID(userID))
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	if user.TotpEnabled {
+		return nil, errors.New("two-factor authentication already enabled")
+	}
+	if user.TotpSecret == "" {
+		return nil, errors.New("two-factor authentication not set up")
+	}
+	step, ok := utils.ValidateTotp(user.TotpSecret, req.Code, time.Now())
+	if !ok {
+		return nil, errInvalidTwoFactorCode
+	}
+	codes, err := utils.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	upMap := map[string]interface{}{
+		"totp_enabled":   true,
+		"totp_last_step": step,
+		"recovery_codes": hashRecoveryCodes(codes),
+	}
+	if err := UserRepo.Update(user.ID, upMap); err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	return &core.RecoveryCodes{Codes: codes}, nil
+}
+
+// Disable 关闭两步验证，需提供验证码或恢复码；系统强制开启时不允许关闭
+func (s *TwoFactorService) Disable(userID uint, req core.TotpCode) error {
+	if TwoFactorRequired() {
+		return errors.New("two-factor authentication is required by administrator")
+	}
+	user, err := UserRepo.Get(UserRepo.WithByID(userID))
+	if err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	if !user.TotpEnabled {
+		return nil
+	}
+	if err := verifyTwoFactor(&user, req.Code); err != nil {
+		return err
+	}
+	return clearTwoFactor(user.ID)
+}
+
+// RegenerateRecoveryCodes 作废原有恢复码并生成新的一组
+func (s *TwoFactorService) RegenerateRecoveryCodes(userID uint, req core.TotpCode) (*core.RecoveryCodes, error) {
+	user, err := UserRepo.Get(UserRepo.WithByID(userID))
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	if !user.TotpEnabled {
+		return nil, errors.New("two-factor authentication not enabled")
+	}
+	if err := verifyTwoFactor(&user, req.Code); err != nil {
+		return nil, err
+	}
+	codes, err := utils.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	if err := UserRepo.Update(user.ID, map[string]interface{}{"recovery_codes": hashRecoveryCodes(codes)}); err != nil {
+		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	return &core.RecoveryCodes{Codes: codes}, nil
+}
+
+// Reset 管理员为丢失设备的用户清除两步验证
+func (s *TwoFactorService) Reset(req core.ResetTwoFactor) error {
+	if _, err := UserRepo.Get(UserRepo.WithByID(req.ID)); err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	return clearTwoFactor(req.ID)
+}
+
+// TwoFactorRequired 系统设置是否强制所有用户启用两步验证
+func TwoFactorRequired() bool {
+	setting, err := SettingsRepo.Get(SettingsRepo.WithByKey("TwoFactorRequired"))
+	return err == nil && setting.Value == twoFactorSettingOn
+}
+
+// verifyTwoFactor 校验验证码（拒绝重放）或一次性恢复码（使用后作废）
+func verifyTwoFactor(user *model.User, code string) error {
+	code = strings.TrimSpace(code)
+	if step, ok := utils.ValidateTotp(user.TotpSecret, code, time.Now()); ok {
+		if step <= user.TotpLastStep {
+			return errInvalidTwoFactorCode
+		}
+		return UserRepo.Update(user.ID, map[string]interface{}{"totp_last_step": step})
+	}
+
+	hash := utils.HashToken(strings.ToLower(code))
+	hashes := strings.Split(user.RecoveryCodes, ",")
+	for i, h := range hashes {
+		if h != "" && h == hash {
+			remain := append(hashes[:i:i], hashes[i+1:]...)
+			return UserRepo.Update(user.ID, map[string]interface{}{"recovery_codes": strings.Join(remain, ",")})
+		}
+	}
+	return errInvalidTwoFactorCode
+}
+
+func clearTwoFactor(userID uint) error {
+	upMap := map[string]interface{}{
+		"totp_enabled":   false,
+		"totp_secret":    "",
+		"totp_last_step": 0,
+		"recovery_codes": "",
+	}
+	return UserRepo.Update(userID, upMap)
+}
+
+func hashRecoveryCodes(codes []string) string {
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hashes = append(hashes, utils.HashToken(code))
+	}
+	return strings.Join(hashes, ",")
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/sensdata/idb/core/constant"
 
 	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/db/repo"
 	core "github.com/sensdata/idb/core/model"
 	"github.com/sensdata/idb/core/utils"
 )
@@ -19,6 +20,10 @@ type IUserService interface {
 	Delete(ids []uint) error
 	ChangePassword(req core.ChangePassword) error
 	Profile(userId uint) (*core.Profile, error)
+	SetRole(req core.UpdateUserRole) error
+	SetHostScope(req core.UpdateHostScope) error
+	SetRecording(req core.UpdateUserRecording) error
+	IsAdmin(userId uint) bool
 }
 
 func NewIUserService() IUserService {
@@ -32,7 +37,20 @@ func (s *UserService) List(req core.PageInfo) (*core.PageResult, error) {
 		return nil, errors.WithMessage(constant.ErrNoRecords, err.Error())
 	}
 
-	return &core.PageResult{Total: total, Items: users}, nil
+	items := make([]core.UserInfo, 0, len(users))
+	for _, user := range users {
+		info := core.UserInfo{ID: user.ID, CreatedAt: user.CreatedAt, Name: user.Name, Valid: user.Valid, RecordTerminal: user.RecordTerminal, TwoFactor: user.TotpEnabled, Source: user.Source}
+		if role, err := RoleRepo.Get(RoleRepo.WithByID(user.RoleID)); err == nil {
+			info.RoleInfo = core.RoleInfo{ID: role.ID, RoleName: role.Name, CreatedAt: role.CreatedAt}
+		}
+		if group, err := GroupRepo.Get(GroupRepo.WithByID(user.GroupID)); err == nil {
+			info.GroupInfo = core.GroupInfo{ID: group.ID, GroupName: group.GroupName, CreatedAt: group.CreatedAt}
+		}
+		info.HostGroupIDs = scopeGroupIDs(HostScopeRepo.WithByUserID(user.ID))
+		items = append(items, info)
+	}
+
+	return &core.PageResult{Total: total, Items: items}, nil
 }
 
 // Create user
@@ -42,8 +60,12 @@ func (s *UserService) Create(req core.CreateUser) (*core.UserInfo, error) {
 		return nil, errors.WithMessage(constant.ErrStructTransform, err.Error())
 	}
 
-	//找角色
-	role, err := RoleRepo.Get(RoleRepo.WithByName("user"))
+	//找角色，未指定时使用 user 角色
+	roleOpt := RoleRepo.WithByName(constant.RoleUser)
+	if req.RoleID != 0 {
+		roleOpt = RoleRepo.WithByID(req.RoleID)
+	}
+	role, err := RoleRepo.Get(roleOpt)
 	if err != nil {
 		return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
 	}
@@ -83,6 +105,22 @@ func (s *UserService) Update(id uint, upMap map[string]interface{}) error {
 }
 
 func (s *UserService) Delete(ids []uint) error {
+	for _, id := range ids {
+		if user, err := UserRepo.Get(UserRepo.WithByID(id)); err == nil && user.Name == "admin" {
+			return errors.WithMessage(constant.ErrInternalServer, "can't delete admin user")
+		}
+	}
+	for _, id := range ids {
+		if err := HostScopeRepo.Delete(HostScopeRepo.WithByUserID(id)); err != nil {
+			return errors.WithMessage(constant.ErrInternalServer, err.Error())
+		}
+		if err := deleteAccessTokens(AccessTokenRepo.WithByUserID(id)); err != nil {
+			return err
+		}
+		if err := SessionRepo.Delete(SessionRepo.WithByUserID(id)); err != nil {
+			return errors.WithMessage(constant.ErrInternalServer, err.Error())
+		}
+	}
 	return UserRepo.Delete(CommonRepo.WithIdsIn(ids))
 }
 
@@ -93,6 +131,11 @@ func (s *UserService) ChangePassword(req core.ChangePassword) error {
 		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
 	}
 
+	// 外部身份源的用户由身份源管理密码
+	if !isLocalUser(&user) {
+		return errors.WithMessage(constant.ErrInvalidParams, "password of external user can't be changed")
+	}
+
 	// 校验原密码
 	oldHash := utils.HashPassword(req.OldPassword, user.Salt)
 	if oldHash != user.Password {
@@ -106,7 +149,15 @@ func (s *UserService) ChangePassword(req core.ChangePassword) error {
 	upMap["password"] = passwordHash
 	upMap["salt"] = salt
 
-	return UserRepo.Update(user.ID, upMap)
+	if err := UserRepo.Update(user.ID, upMap); err != nil {
+		return err
+	}
+
+	// 修改密码后吊销该用户的全部登录会话
+	if err := SessionRepo.Delete(SessionRepo.WithByUserID(user.ID)); err != nil {
+		return errors.WithMessage(constant.ErrInternalServer, err.Error())
+	}
+	return nil
 }
 
 func (s *UserService) Profile(userId uint) (*core.Profile, error) {
@@ -114,8 +165,84 @@ func (s *UserService) Profile(userId uint) (*core.Profile, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &core.Profile{
-		ID:   user.ID,
-		Name: user.Name,
-	}, nil
+	profile := &core.Profile{
+		ID:        user.ID,
+		Name:      user.Name,
+		TwoFactor: user.TotpEnabled,
+	}
+	if role, err := RoleRepo.Get(RoleRepo.WithByID(user.RoleID)); err == nil {
+		profile.Role = role.Name
+	}
+	return profile, nil
+}
+
+// SetRole 修改用户角色，内置 admin 账号的角色不允许修改
+func (s *UserService) SetRole(req core.UpdateUserRole) error {
+	user, err := UserRepo.Get(UserRepo.WithByID(req.ID))
+	if err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	if user.Name == "admin" {
+		return errors.WithMessage(constant.ErrInternalServer, "can't change role of admin user")
+	}
+	role, err := RoleRepo.Get(RoleRepo.WithByID(req.RoleID))
+	if err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	return UserRepo.Update(user.ID, map[string]interface{}{"role_id": role.ID})
+}
+
+func (s *UserService) IsAdmin(userId uint) bool {
+	user, err := UserRepo.Get(UserRepo.WithByID(userId))
+	if err != nil {
+		return false
+	}
+	role, err := RoleRepo.Get(RoleRepo.WithByID(user.RoleID))
+	return err == nil && role.Name == constant.RoleAdmin
+}
+
+func (s *UserService) SetHostScope(req core.UpdateHostScope) error {
+	user, err := UserRepo.Get(UserRepo.WithByID(req.ID))
+	if err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	scopes, err := toHostScopes(req.HostGroupIDs)
+	if err != nil {
+		return err
+	}
+	for i := range scopes {
+		scopes[i].UserID = user.ID
+	}
+	return HostScopeRepo.Replace(HostScopeRepo.WithByUserID(user.ID), scopes)
+}
+
+// SetRecording 开启或关闭该用户的终端录制
+func (s *UserService) SetRecording(req core.UpdateUserRecording) error {
+	if _, err := UserRepo.Get(UserRepo.WithByID(req.ID)); err != nil {
+		return errors.WithMessage(constant.ErrRecordNotFound, err.Error())
+	}
+	return UserRepo.Update(req.ID, map[string]interface{}{"record_terminal": req.Enabled})
+}
+
+func toHostScopes(groupIDs []uint) ([]model.HostScope, error) {
+	scopes := make([]model.HostScope, 0, len(groupIDs))
+	for _, groupID := range groupIDs {
+		if _, err := HostGroupRepo.Get(HostGroupRepo.WithByID(groupID)); err != nil {
+			return nil, errors.WithMessage(constant.ErrRecordNotFound, "host group not found")
+		}
+		scopes = append(scopes, model.HostScope{HostGroupID: groupID})
+	}
+	return scopes, nil
+}
+
+func scopeGroupIDs(subject repo.DBOption) []uint {
+	ids := make([]uint, 0)
+	scopes, err := HostScopeRepo.GetList(subject)
+	if err != nil {
+		return ids
+	}
+	for _, scope := range scopes {
+		ids = append(ids, scope.HostGroupID)
+	}
+	return ids
 }
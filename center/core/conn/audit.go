@@ -0,0 +1,127 @@
+package conn
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"github.com/sensdata/idb/core/constant"
+	core "github.com/sensdata/idb/core/model"
+	"github.com/sensdata/idb/core/utils"
+)
+
+// maxAuditParamsLength 审计参数的最大长度（字符数），如文件内容修改
+const maxAuditParamsLength = 2000
+
+// readOnlyActionSuffixes 以这些后缀结尾的 action 只读取数据，不写入审计
+var readOnlyActionSuffixes = []string{
+	"_list",
+	"_detail",
+	"_tree",
+	"_search",
+	"_content",
+	"_content_part",
+	"_dir_size",
+	"_status",
+	"_info",
+	"_inspect",
+	"_query",
+	"_names",
+	"_page",
+	"_log",
+	"_logs",
+	"_stats",
+	"_diff",
+	"_usage",
+	"_download",
+}
+
+// readOnlyActions 无法通过后缀判断的只读 action
+var readOnlyActions = []string{
+	core.SysInfo_OverView,
+	core.SysInfo_Network,
+	core.SysInfo_System,
+	core.SysInfo_Get_Auto_Clear,
+	core.Sysinfo_Get_Sys_Setting,
+	core.Sysinfo_Hardware,
+	core.Ssh_Config,
+	core.Ssh_Secret,
+	core.Git_File,
+	core.Docker_Conf,
+	core.CA_Groups,
+	core.CA_Group_Pk,
+	core.CA_Group_Csr,
+	core.File_Grep_Result,
+	core.File_Trash_Settings,
+}
+
+func isReadOnlyAction(action string) bool {
+	for _, a := range readOnlyActions {
+		if a == action {
+			return true
+		}
+	}
+	for _, suffix := range readOnlyActionSuffixes {
+		if strings.HasSuffix(action, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// auditDispatch 记录下发到 agent 的 action 或命令
+func auditDispatch(hostID uint, action string, params string, err error, ok bool, msg string) {
+	entry := model.AuditLog{
+		HostID: hostID,
+		Source: constant.AuditSourceAgent,
+		Action: action,
+		Params: truncateAuditParams(utils.RedactJSON(params)),
+		Result: constant.AuditResultSuccess,
+	}
+	if err != nil {
+		entry.Result = constant.AuditResultFailed
+		entry.Message = err.Error()
+	} else if !ok {
+		entry.Result = constant.AuditResultFailed
+		entry.Message = truncateAuditParams(msg)
+	}
+	createAuditLog(&entry)
+}
+
+// auditTerminal 记录终端会话的开始与结束
+func auditTerminal(c *gin.Context, hostID uint, action string, params string) {
+	entry := model.AuditLog{
+		SourceIP: c.ClientIP(),
+		HostID:   hostID,
+		Source:   constant.AuditSourceTerminal,
+		Plugin:   "terminals",
+		Route:    c.FullPath(),
+		Method:   c.Request.Method,
+		Action:   action,
+		Params:   params,
+		Result:   constant.AuditResultSuccess,
+	}
+	if claims, ok := c.Get("user"); ok {
+		if userClaims, ok := claims.(*utils.Claims); ok {
+			entry.UserID = userClaims.ID
+			entry.UserName = userClaims.Name
+		}
+	}
+	createAuditLog(&entry)
+}
+
+func createAuditLog(entry *model.AuditLog) {
+	if err := AuditLogRepo.Create(entry); err != nil {
+		global.LOG.Error("Failed to write audit log: %v", err)
+	}
+}
+
+func truncateAuditParams(s string) string {
+	runes := []rune(s)
+	if len(runes) <= maxAuditParamsLength {
+		return s
+	}
+	return string(runes[:maxAuditParamsLength]) + fmt.Sprintf("... [truncated, total length: %d]", len(runes))
+}
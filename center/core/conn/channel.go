@@ -20,6 +20,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 
+	"github.com/sensdata/idb/center/core/monitor"
 	"github.com/sensdata/idb/center/db/model"
 	"github.com/sensdata/idb/center/db/repo"
 	"github.com/sensdata/idb/center/global"
@@ -31,6 +32,18 @@ import (
 	"github.com/sensdata/idb/core/utils/common"
 )
 
+// executeTimeout 等待 agent 返回命令及 action 结果的默认超时时间
+const executeTimeout = 10 * time.Second
+
+// cmdErrorResult agent 执行命令失败时返回的结果
+const cmdErrorResult = "error"
+
+// downloadMaxRetries 下载中断后无进展的最大连续重试次数
+const downloadMaxRetries = 5
+
+// errDownloadChunk agent 读取文件出错，重试无法恢复
+var errDownloadChunk = errors.New("failed to download file chunk")
+
 type Center struct {
 	agentConns        map[string]net.Conn // 存储Agent端连接的映射
 	done              chan struct{}
@@ -41,6 +54,7 @@ type Center struct {
 	sessionTokenMap   map[string]string // 缓存session是否被占用
 	hostStates        map[uint]*hostConnState
 	hostStateMu       sync.Mutex
+	metrics           *metricStore // 心跳指标历史
 }
 
 type hostConnState struct {
@@ -52,10 +66,14 @@ type ICenter interface {
 	Start() error
 	Stop() error
 	ExecuteCommand(req core.Command) (string, error)
+	ExecuteCommandTimeout(req core.Command, timeout time.Duration) (string, error)
+	ExecuteSystemCommand(req core.Command) (string, error)
 	ExecuteCommandGroup(req core.CommandGroup) ([]string, error)
 	ExecuteAction(req core.HostAction) (*core.Action, error)
+	ExecuteActionTimeout(req core.HostAction, timeout time.Duration) (*core.Action, error)
 	UploadFile(hostID uint, path string, file *multipart.FileHeader) error
 	DownloadFile(ctx *gin.Context, hostID uint, path string) error
+	RelayFile(srcHostID uint, srcPath string, size int64, fileHash string, dstHostID uint, dstPath string, cancel <-chan struct{}, progress func(received int64)) error
 	GetAgentConn(host *model.Host) (*net.Conn, error)
 	IsAgentConnected(host model.Host) bool
 	RegisterAgentSession(aws *AgentWebSocketSession)
@@ -66,6 +84,7 @@ type ICenter interface {
 	TestAgent(host model.Host, req core.TestAgent) error
 	ReleaseAgentConn(host model.Host) error
 	DisconnectHost(host *model.Host) error
+	PendingResponses() int
 }
 
 func NewCenter() ICenter {
@@ -78,6 +97,7 @@ func NewCenter() ICenter {
 		sessionTokenMap:   make(map[string]string),
 		hostStates:        make(map[uint]*hostConnState),
 		hostStateMu:       sync.Mutex{},
+		metrics:           newMetricStore(),
 	}
 }
 
@@ -92,6 +112,9 @@ func (c *Center) Start() error {
 	go c.ensureConnections()
 	go c.autoUpgradeDefaultHostAgent()
 
+	// 保存心跳指标历史
+	go c.metrics.run(c.done)
+
 	return nil
 }
 
@@ -626,6 +649,7 @@ func (c *Center) processMessage(host *model.Host, msg *message.Message) {
 			}
 			global.SetHostStatus(host.ID, hostStatusInfo)
 			global.SetInstalledStatus(host.ID, &hostStatusInfo.Installed)
+			c.metrics.record(host.ID, time.Now(), &heartbeat)
 
 			go func() {
 				conn, err := c.getAgentConn(host)
@@ -672,8 +696,8 @@ func (c *Center) processMessage(host *model.Host, msg *message.Message) {
 
 func (c *Center) processFileMessage(msg *message.FileMessage) {
 	switch msg.Type {
-	case message.Upload: //上传回复
-		global.LOG.Info("Upload: %s, %d, %d, %d", msg.FileName, msg.Status, msg.Offset, msg.ChunkSize)
+	case message.Upload, message.UploadQuery: //上传回复
+		global.LOG.Info("Upload: %s, %d, %d, %d", msg.FileName, msg.Status, msg.Offset, msg.Received)
 		// 获取响应通道
 		c.mu.Lock()
 		responseCh, exists := c.fileResponseChMap[msg.MsgID]
@@ -848,9 +872,8 @@ func (c *Center) UploadFile(hostID uint, path string, file *multipart.FileHeader
 		return errors.WithMessage(constant.ErrHost, err.Error())
 	}
 
-	// 查找agent conn
-	conn, err := c.getAgentConn(&host)
-	if err != nil {
+	// 查找agent conn，传输中断后会重新获取
+	if _, err := c.getAgentConn(&host); err != nil {
 		return errors.WithMessage(constant.ErrAgent, err.Error())
 	}
 
@@ -861,83 +884,13 @@ func (c *Center) UploadFile(hostID uint, path string, file *multipart.FileHeader
 	}
 	defer srcFile.Close()
 
-	// 获取文件大小
-	fileSize := file.Size
-
-	// 创建等待响应的通道（缓冲1，防止goroutine泄漏）
-	responseCh := make(chan *message.FileMessage, 1)
-
-	// 生成消息ID
-	msgID := utils.GenerateMsgId()
-
-	// 将通道和msgID映射存储在map中
-	c.mu.Lock()
-	c.fileResponseChMap[msgID] = responseCh
-	c.mu.Unlock()
-
-	// 分块读取文件并发送
-	const bufferSize = 256 * 1024 // 256KB 块大小
-	buffer := make([]byte, bufferSize)
-	var offset int64 = 0
-	for {
-		// 读取文件块
-		n, err := srcFile.Read(buffer)
-		if err != nil && err != io.EOF {
-			return errors.WithMessage(errors.New(constant.ErrFileRead), err.Error())
-		}
-		if n == 0 {
-			break // 文件读取完毕
-		}
-
-		// 构造要发送的消息
-		msg, err := message.CreateFileMessage(
-			msgID,
-			message.Upload,
-			0,
-			path,
-			file.Filename,
-			fileSize,
-			offset,
-			n,
-			buffer[:n],
-		)
-		if err != nil {
-			return errors.WithMessage(constant.ErrInternalServer, err.Error())
-		}
-
-		// 并发发送消息
-		go func() {
-			err := message.SendFileMessage(*conn, msg)
-			if err != nil {
-				global.LOG.Error("Failed to send file chunk: %s %d %d, %v", msg.FileName, msg.Offset, msg.ChunkSize, err)
-				// 如果发送失败，写入空响应
-				msg.Status = message.FileErr
-				select {
-				case responseCh <- msg:
-				default:
-				}
-			}
-		}()
-
-		// 等待 agent 响应
-		select {
-		case response := <-responseCh:
-			if response.Status == message.FileErr {
-				return errors.New("failed to upload file chunk")
-			}
-			// 继续下一块
-		case <-time.After(10 * time.Second): // 设置超时时间
-			c.mu.Lock()
-			delete(c.fileResponseChMap, msgID)
-			c.mu.Unlock()
-			return fmt.Errorf("timeout waiting for response from agent")
-		}
-
-		// 更新偏移量，准备发送下一块
-		offset += int64(n)
+	// 计算文件哈希，用于生成会话ID及完成后校验
+	fileHash, err := readerChecksum(srcFile, file.Size)
+	if err != nil {
+		return errors.WithMessage(errors.New(constant.ErrFileRead), err.Error())
 	}
 
-	return nil
+	return newUploadTask(c, &host, srcFile, path, file.Filename, file.Size, fileHash).run()
 }
 
 func (c *Center) DownloadFile(ctx *gin.Context, hostID uint, path string) error {
@@ -951,9 +904,8 @@ func (c *Center) DownloadFile(ctx *gin.Context, hostID uint, path string) error
 		return errors.WithMessage(constant.ErrHost, err.Error())
 	}
 
-	// 查找agent conn
-	conn, err := c.getAgentConn(&host)
-	if err != nil {
+	// 查找agent conn，传输中断后会重新获取
+	if _, err := c.getAgentConn(&host); err != nil {
 		return errors.WithMessage(constant.ErrAgent, err.Error())
 	}
 
@@ -967,8 +919,8 @@ func (c *Center) DownloadFile(ctx *gin.Context, hostID uint, path string) error
 	}
 	ctx.Header("Content-Type", mimeType)
 
-	// 创建等待响应的通道（缓冲1，防止goroutine泄漏）
-	responseCh := make(chan *message.FileMessage, 1)
+	// 创建等待响应的通道，重试时可能收到过期的回复，留出缓冲
+	responseCh := make(chan *message.FileMessage, 4)
 
 	// 生成消息ID
 	msgID := utils.GenerateMsgId()
@@ -977,75 +929,103 @@ func (c *Center) DownloadFile(ctx *gin.Context, hostID uint, path string) error
 	c.mu.Lock()
 	c.fileResponseChMap[msgID] = responseCh
 	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		delete(c.fileResponseChMap, msgID)
+		c.mu.Unlock()
+	}()
 
-	var finished bool = false
 	var offset int64 = 0
 	for {
-		// 完成时，跳出
-		if finished {
-			break
+		response, err := c.fetchChunk(&host, msgID, responseCh, dir, fileName, offset, 256*1024)
+		if err != nil {
+			return err
 		}
 
-		go func() {
-			// 构造要发送的消息
-			msg, err := message.CreateFileMessage(
-				msgID,
-				message.Download,
-				0,
-				dir,
-				fileName,
-				0,
-				offset,
-				256*1024,
-				nil,
-			)
-			if err != nil {
-				global.LOG.Error("Failed to create file message: %s %d %d, %v", msg.FileName, msg.Offset, msg.ChunkSize, err)
-				// 如果发送失败，写入空响应
-				msg.Status = message.FileErr
-				select {
-				case responseCh <- msg:
-				default:
-				}
-				return
-			}
+		// 写入response
+		if _, err := ctx.Writer.Write(response.Chunk[:response.ChunkSize]); err != nil {
+			return errors.WithMessage(constant.ErrInternalServer, err.Error())
+		}
+		// 如果已经完成
+		if response.Status == message.FileDone {
+			return nil
+		}
+		// 继续请求下一块
+		offset = response.Offset + int64(response.ChunkSize)
+	}
+}
 
-			err = message.SendFileMessage(*conn, msg)
-			if err != nil {
-				global.LOG.Error("Failed to send file chunk: %s %d %d, %v", msg.FileName, msg.Offset, msg.ChunkSize, err)
-				// 如果发送失败，写入空响应
-				msg.Status = message.FileErr
-				select {
-				case responseCh <- msg:
-				default:
-				}
-			}
-		}()
+// fetchChunk 向 agent 请求指定偏移量的文件块，超时、连接断开或块校验失败时重新请求
+func (c *Center) fetchChunk(host *model.Host, msgID string, responseCh chan *message.FileMessage, dir string, fileName string, offset int64, size int) (*message.FileMessage, error) {
+	var lastErr error
+	for retries := 0; retries <= downloadMaxRetries; retries++ {
+		if retries > 0 {
+			global.LOG.Warn("Download %s interrupted at %d, retry: %v", filepath.Join(dir, fileName), offset, lastErr)
+			time.Sleep(time.Duration(retries) * time.Second)
+		}
+
+		conn, err := c.getAgentConn(host)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		// 构造要发送的消息
+		msg, err := message.CreateFileMessage(
+			msgID,
+			message.Download,
+			0,
+			dir,
+			fileName,
+			0,
+			offset,
+			0,
+			nil,
+		)
+		if err != nil {
+			return nil, errors.WithMessage(constant.ErrInternalServer, err.Error())
+		}
+		// 请求的块大小
+		msg.ChunkSize = size
+		if err := message.SendFileMessage(*conn, msg); err != nil {
+			global.LOG.Error("Failed to send file chunk: %s %d %d, %v", msg.FileName, msg.Offset, msg.ChunkSize, err)
+			lastErr = err
+			continue
+		}
+
+		response, err := waitDownloadResponse(responseCh, offset)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if response.Status == message.FileErr {
+			return nil, errDownloadChunk
+		}
+		if response.ChunkHash != "" && message.FileChecksum(response.Chunk[:response.ChunkSize]) != response.ChunkHash {
+			lastErr = errors.New("chunk checksum mismatch")
+			continue
+		}
+		return response, nil
+	}
+	return nil, fmt.Errorf("download interrupted at %d: %v", offset, lastErr)
+}
 
+// waitDownloadResponse 等待指定偏移量的回复，忽略重试前请求的过期回复
+func waitDownloadResponse(responseCh chan *message.FileMessage, offset int64) (*message.FileMessage, error) {
+	timeout := time.After(executeTimeout)
+	for {
 		select {
-		case response := <-responseCh:
-			if response.Status == message.FileErr {
-				return errors.New("failed to download file chunk")
-			}
-			// 写入response
-			if _, err := ctx.Writer.Write(response.Chunk[:response.ChunkSize]); err != nil {
-				return errors.WithMessage(constant.ErrInternalServer, err.Error())
+		case response, ok := <-responseCh:
+			if !ok {
+				return nil, errors.New("download channel closed")
 			}
-			// 如果已经完成
-			if response.Status == message.FileDone {
-				finished = true
-			} else {
-				// 继续请求下一块
-				offset = response.Offset + int64(response.ChunkSize)
+			if response.Offset == offset {
+				return response, nil
 			}
-		case <-time.After(10 * time.Second): // 设置超时时间
-			c.mu.Lock()
-			delete(c.fileResponseChMap, msgID)
-			c.mu.Unlock()
-			return fmt.Errorf("timeout waiting for response from agent")
+		case <-timeout:
+			return nil, fmt.Errorf("timeout waiting for response from agent")
 		}
 	}
-	return nil
 }
 
 func (c *Center) GetAgentConn(host *model.Host) (*net.Conn, error) {
@@ -1093,6 +1073,21 @@ func (c *Center) GetSessionToken(session string) (string, bool) {
 }
 
 func (c *Center) ExecuteAction(req core.HostAction) (*core.Action, error) {
+	return c.ExecuteActionTimeout(req, executeTimeout)
+}
+
+// ExecuteActionTimeout 发送 action 并在 timeout 内等待结果
+func (c *Center) ExecuteActionTimeout(req core.HostAction, timeout time.Duration) (result *core.Action, err error) {
+	if !isReadOnlyAction(req.Action.Action) {
+		defer func() {
+			ok := result != nil && result.Result
+			msg := ""
+			if result != nil && !result.Result {
+				msg = result.Data
+			}
+			auditDispatch(req.HostID, req.Action.Action, req.Action.Data, err, ok, msg)
+		}()
+	}
 
 	//找host
 	host, err := HostRepo.Get(HostRepo.WithByID(req.HostID))
@@ -1133,9 +1128,10 @@ func (c *Center) ExecuteAction(req core.HostAction) (*core.Action, error) {
 	c.responseChMap[msgID] = responseCh
 	c.mu.Unlock()
 
+	start := time.Now()
 	go func() {
-		err = message.SendMessage(*conn, msg)
-		if err != nil {
+		// 使用局部 err，避免与返回值并发读写
+		if err := message.SendMessage(*conn, msg); err != nil {
 			global.LOG.Error("Failed to send action message: %v", err)
 			select {
 			case responseCh <- "":
@@ -1149,13 +1145,20 @@ func (c *Center) ExecuteAction(req core.HostAction) (*core.Action, error) {
 	case response := <-responseCh:
 		var action core.Action
 		if err := json.Unmarshal([]byte(response), &action); err != nil {
+			observeAction(req.Action.Action, "error", start)
 			return nil, err
 		}
+		if action.Result {
+			observeAction(req.Action.Action, "success", start)
+		} else {
+			observeAction(req.Action.Action, "failed", start)
+		}
 		return &action, nil
-	case <-time.After(10 * time.Second): // 设置一个超时时间
+	case <-time.After(timeout):
 		c.mu.Lock()
 		delete(c.responseChMap, msgID)
 		c.mu.Unlock()
+		observeAction(req.Action.Action, "timeout", start)
 		return &core.Action{
 			Action: req.Action.Action,
 			Result: false,
@@ -1164,8 +1167,36 @@ func (c *Center) ExecuteAction(req core.HostAction) (*core.Action, error) {
 	}
 }
 
+// observeAction 记录 action 的往返耗时
+func observeAction(action string, result string, start time.Time) {
+	monitor.ActionDuration.WithLabelValues(action, result).Observe(time.Since(start).Seconds())
+}
+
+// PendingResponses 返回仍在等待 agent 结果的请求数
+func (c *Center) PendingResponses() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.responseChMap)
+}
+
 func (c *Center) ExecuteCommand(req core.Command) (string, error) {
+	return c.ExecuteCommandTimeout(req, executeTimeout)
+}
+
+// ExecuteCommandTimeout 发送命令并在 timeout 内等待结果
+func (c *Center) ExecuteCommandTimeout(req core.Command, timeout time.Duration) (result string, err error) {
+	defer func() {
+		auditDispatch(req.HostID, "command", utils.RedactCommand(req.Command), err, result != cmdErrorResult, "command failed")
+	}()
+	return c.executeCommand(req, timeout)
+}
 
+// ExecuteSystemCommand 供告警等系统内部的定时检查使用，不写入审计
+func (c *Center) ExecuteSystemCommand(req core.Command) (string, error) {
+	return c.executeCommand(req, executeTimeout)
+}
+
+func (c *Center) executeCommand(req core.Command, timeout time.Duration) (string, error) {
 	//找host
 	host, err := HostRepo.Get(HostRepo.WithByID(req.HostID))
 	if err != nil {
@@ -1201,8 +1232,8 @@ func (c *Center) ExecuteCommand(req core.Command) (string, error) {
 	c.mu.Unlock()
 
 	go func() {
-		err = message.SendMessage(*conn, msg)
-		if err != nil {
+		// 使用局部 err，避免与返回值并发读写
+		if err := message.SendMessage(*conn, msg); err != nil {
 			global.LOG.Error("Failed to send command message: %v", err)
 			select {
 			case responseCh <- "":
@@ -1215,7 +1246,7 @@ func (c *Center) ExecuteCommand(req core.Command) (string, error) {
 	select {
 	case response := <-responseCh:
 		return response, nil
-	case <-time.After(10 * time.Second): // 设置一个超时时间
+	case <-time.After(timeout):
 		c.mu.Lock()
 		delete(c.responseChMap, msgID)
 		c.mu.Unlock()
@@ -1244,7 +1275,11 @@ func (c *Center) getAgentConn(host *model.Host) (*net.Conn, error) {
 	return &conn, nil
 }
 
-func (c *Center) ExecuteCommandGroup(req core.CommandGroup) ([]string, error) {
+func (c *Center) ExecuteCommandGroup(req core.CommandGroup) (results []string, err error) {
+	defer func() {
+		failed := len(results) == 1 && results[0] == cmdErrorResult
+		auditDispatch(req.HostID, "command_group", utils.RedactCommand(strings.Join(req.Commands, "\n")), err, !failed, "command failed")
+	}()
 
 	if len(req.Commands) < 1 {
 		return []string{}, constant.ErrInvalidParams
@@ -1292,8 +1327,8 @@ func (c *Center) ExecuteCommandGroup(req core.CommandGroup) ([]string, error) {
 
 	go func() {
 		global.LOG.Info("send msg data: %s", msg.Data)
-		err = message.SendMessage(*conn, msg)
-		if err != nil {
+		// 使用局部 err，避免与返回值并发读写
+		if err := message.SendMessage(*conn, msg); err != nil {
 			global.LOG.Error("Failed to send command message: %v", err)
 			select {
 			case responseCh <- "":
@@ -1306,7 +1341,6 @@ func (c *Center) ExecuteCommandGroup(req core.CommandGroup) ([]string, error) {
 	select {
 	case response := <-responseCh:
 		global.LOG.Info("recv msg data: %s", response)
-		var results []string
 		if strings.Contains(response, message.Separator) {
 			results = strings.Split(response, message.Separator)
 		} else {
@@ -17,6 +17,11 @@ var (
 	GroupRepo     = repo.NewGroupRepo()
 	HostRepo      = repo.NewHostRepo()
 	HostGroupRepo = repo.NewHostGroupRepo()
+	AuditLogRepo  = repo.NewAuditLogRepo()
+
+	HostMetricRepo = repo.NewHostMetricRepo()
+
+	TerminalRecordingRepo = repo.NewTerminalRecordingRepo()
 
 	CONFMAN   *config.Manager
 	SSH       ISSHService
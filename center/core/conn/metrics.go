@@ -0,0 +1,211 @@
+package conn
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	core "github.com/sensdata/idb/core/model"
+)
+
+// MetricRollup 指标降采样的粒度（秒）及保留时长
+type MetricRollup struct {
+	Resolution int
+	Retention  time.Duration
+}
+
+// MetricRollups 按粒度从小到大排列，心跳先聚合为 1 分钟数据，再合并到更粗的粒度
+var MetricRollups = []MetricRollup{
+	{Resolution: 60, Retention: 24 * time.Hour},
+	{Resolution: 300, Retention: 7 * 24 * time.Hour},
+	{Resolution: 3600, Retention: 90 * 24 * time.Hour},
+}
+
+// metricCleanupInterval 清理过期指标的间隔
+const metricCleanupInterval = time.Hour
+
+// metricBucket 内存中尚未写入的 1 分钟指标，保存各项累加值
+type metricBucket struct {
+	timestamp      int64
+	samples        int
+	cpu            float64
+	cpuMax         float64
+	memory         float64
+	memoryMax      float64
+	disk           float64
+	rx             float64
+	tx             float64
+	goroutines     float64
+	openFDs        float64
+	activeSessions float64
+}
+
+type metricStore struct {
+	mu        sync.Mutex
+	buckets   map[uint]*metricBucket
+	persistMu sync.Mutex // 保证同一时间桶的读取与合并不会交错
+}
+
+func newMetricStore() *metricStore {
+	return &metricStore{buckets: make(map[uint]*metricBucket)}
+}
+
+// record 记录一次心跳，进入新的分钟时写入上一分钟的数据
+func (s *metricStore) record(hostID uint, now time.Time, hb *core.Heartbeat) {
+	ts := now.Unix()
+	start := ts - ts%int64(MetricRollups[0].Resolution)
+
+	s.mu.Lock()
+	var finished *metricBucket
+	b := s.buckets[hostID]
+	if b != nil && b.timestamp != start {
+		finished = b
+		b = nil
+	}
+	if b == nil {
+		b = &metricBucket{timestamp: start}
+		s.buckets[hostID] = b
+	}
+	b.samples++
+	b.cpu += hb.Cpu
+	b.cpuMax = max(b.cpuMax, hb.Cpu)
+	b.memory += hb.Memory
+	b.memoryMax = max(b.memoryMax, hb.Memory)
+	b.disk += hb.Disk
+	b.rx += hb.Rx
+	b.tx += hb.Tx
+	b.goroutines += float64(hb.Goroutines)
+	b.openFDs += float64(hb.OpenFDs)
+	b.activeSessions += float64(hb.ActiveSessions)
+	s.mu.Unlock()
+
+	if finished != nil {
+		s.persist(hostID, finished)
+	}
+}
+
+// flush 写入已结束的时间桶，all 为 true 时写入全部
+func (s *metricStore) flush(now time.Time, all bool) {
+	current := now.Unix() - now.Unix()%int64(MetricRollups[0].Resolution)
+
+	s.mu.Lock()
+	finished := make(map[uint]*metricBucket)
+	for hostID, b := range s.buckets {
+		if all || b.timestamp < current {
+			finished[hostID] = b
+			delete(s.buckets, hostID)
+		}
+	}
+	s.mu.Unlock()
+
+	for hostID, b := range finished {
+		s.persist(hostID, b)
+	}
+}
+
+// persist 将 1 分钟数据写入各粒度，已存在的时间桶按样本数加权合并
+func (s *metricStore) persist(hostID uint, b *metricBucket) {
+	s.persistMu.Lock()
+	defer s.persistMu.Unlock()
+
+	// 设备已删除时丢弃
+	if _, err := HostRepo.Get(HostRepo.WithByID(hostID)); err != nil {
+		return
+	}
+
+	n := float64(b.samples)
+	sample := model.HostMetric{
+		HostID:         hostID,
+		Samples:        b.samples,
+		Cpu:            b.cpu / n,
+		CpuMax:         b.cpuMax,
+		Memory:         b.memory / n,
+		MemoryMax:      b.memoryMax,
+		Disk:           b.disk / n,
+		Rx:             b.rx / n,
+		Tx:             b.tx / n,
+		Goroutines:     b.goroutines / n,
+		OpenFDs:        b.openFDs / n,
+		ActiveSessions: b.activeSessions / n,
+	}
+	for _, rollup := range MetricRollups {
+		metric := sample
+		metric.Resolution = rollup.Resolution
+		metric.Timestamp = b.timestamp - b.timestamp%int64(rollup.Resolution)
+
+		existing, err := HostMetricRepo.Get(
+			HostMetricRepo.WithByHostID(hostID),
+			HostMetricRepo.WithByResolution(rollup.Resolution),
+			HostMetricRepo.WithByTimestamp(metric.Timestamp),
+		)
+		if err == nil {
+			metric = MergeHostMetric(existing, metric)
+			err = HostMetricRepo.Save(&metric)
+		} else {
+			err = HostMetricRepo.Create(&metric)
+		}
+		if err != nil {
+			global.LOG.Error("Failed to save metric of host %d at %d/%ds: %v", hostID, metric.Timestamp, rollup.Resolution, err)
+		}
+	}
+}
+
+// cleanup 删除超出保留时长的指标
+func (s *metricStore) cleanup(now time.Time) {
+	for _, rollup := range MetricRollups {
+		before := now.Add(-rollup.Retention).Unix()
+		if err := HostMetricRepo.Delete(
+			HostMetricRepo.WithByResolution(rollup.Resolution),
+			HostMetricRepo.WithByTimestampBefore(before),
+		); err != nil {
+			global.LOG.Error("Failed to clean up %ds metrics: %v", rollup.Resolution, err)
+		}
+	}
+}
+
+func (s *metricStore) run(done chan struct{}) {
+	ticker := time.NewTicker(time.Duration(MetricRollups[0].Resolution) * time.Second)
+	defer ticker.Stop()
+
+	s.cleanup(time.Now())
+	lastCleanup := time.Now()
+	for {
+		select {
+		case <-done:
+			s.flush(time.Now(), true)
+			return
+		case now := <-ticker.C:
+			// 设备离线后不再有心跳触发写入，由定时任务补写
+			s.flush(now, false)
+			if now.Sub(lastCleanup) >= metricCleanupInterval {
+				s.cleanup(now)
+				lastCleanup = now
+			}
+		}
+	}
+}
+
+// MergeHostMetric 按样本数加权合并同一设备的两段指标，保留 a 的 ID、粒度及时间桶
+func MergeHostMetric(a model.HostMetric, b model.HostMetric) model.HostMetric {
+	total := a.Samples + b.Samples
+	if total == 0 {
+		return a
+	}
+	wa, wb := float64(a.Samples)/float64(total), float64(b.Samples)/float64(total)
+	avg := func(x, y float64) float64 { return x*wa + y*wb }
+
+	merged := a
+	merged.Samples = total
+	merged.Cpu = avg(a.Cpu, b.Cpu)
+	merged.CpuMax = max(a.CpuMax, b.CpuMax)
+	merged.Memory = avg(a.Memory, b.Memory)
+	merged.MemoryMax = max(a.MemoryMax, b.MemoryMax)
+	merged.Disk = avg(a.Disk, b.Disk)
+	merged.Rx = avg(a.Rx, b.Rx)
+	merged.Tx = avg(a.Tx, b.Tx)
+	merged.Goroutines = avg(a.Goroutines, b.Goroutines)
+	merged.OpenFDs = avg(a.OpenFDs, b.OpenFDs)
+	merged.ActiveSessions = avg(a.ActiveSessions, b.ActiveSessions)
+	return merged
+}
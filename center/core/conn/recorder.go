@@ -0,0 +1,180 @@
+package conn
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"github.com/sensdata/idb/core/constant"
+	"github.com/sensdata/idb/core/utils"
+)
+
+// asciicast v2 事件类型
+const (
+	castOutput = "o"
+	castInput  = "i"
+	castResize = "r"
+)
+
+// TerminalRecorder 将终端会话按 asciicast v2 格式写入文件
+// 方法均可在 nil 上调用，未开启录制时会话无需判断
+type TerminalRecorder struct {
+	mu          sync.Mutex
+	file        *os.File
+	start       time.Time
+	recordingID uint
+	size        int64
+	closed      bool
+}
+
+type castHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// newTerminalRecorder 设备或当前用户开启了终端录制时创建录像，否则返回 nil
+func newTerminalRecorder(c *gin.Context, host *model.Host, sessionType string, session string, cols int, rows int) *TerminalRecorder {
+	var userID uint
+	var userName string
+	if claims, ok := c.Get("user"); ok {
+		if userClaims, ok := claims.(*utils.Claims); ok {
+			userID = userClaims.ID
+			userName = userClaims.Name
+		}
+	}
+	if !shouldRecordTerminal(host, userID) {
+		return nil
+	}
+
+	start := time.Now()
+	dir := filepath.Join(constant.CenterRecordingDir, fmt.Sprintf("%d", host.ID))
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		global.LOG.Error("Failed to create recording dir %s: %v", dir, err)
+		return nil
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s.cast", start.Format("20060102-150405"), session))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		global.LOG.Error("Failed to create recording file %s: %v", path, err)
+		return nil
+	}
+
+	recording := model.TerminalRecording{
+		HostID:   host.ID,
+		UserID:   userID,
+		UserName: userName,
+		Type:     sessionType,
+		Session:  session,
+		Path:     path,
+		Cols:     cols,
+		Rows:     rows,
+	}
+	if err := TerminalRecordingRepo.Create(&recording); err != nil {
+		global.LOG.Error("Failed to save recording of session %s: %v", session, err)
+		file.Close()
+		_ = os.Remove(path)
+		return nil
+	}
+
+	r := &TerminalRecorder{
+		file:        file,
+		start:       start,
+		recordingID: recording.ID,
+	}
+	header, _ := json.Marshal(castHeader{
+		Version:   2,
+		Width:     cols,
+		Height:    rows,
+		Timestamp: start.Unix(),
+		Title:     fmt.Sprintf("%s@%s", userName, host.Name),
+		Env:       map[string]string{"TERM": "xterm"},
+	})
+	r.writeLine(header)
+	global.LOG.Info("Recording terminal session %s to %s", session, path)
+	return r
+}
+
+func shouldRecordTerminal(host *model.Host, userID uint) bool {
+	if host.RecordTerminal {
+		return true
+	}
+	if userID == 0 {
+		return false
+	}
+	user, err := UserRepo.Get(UserRepo.WithByID(userID))
+	if err != nil {
+		return false
+	}
+	return user.RecordTerminal
+}
+
+func (r *TerminalRecorder) Output(data string) {
+	r.event(castOutput, data)
+}
+
+func (r *TerminalRecorder) Input(data string) {
+	r.event(castInput, data)
+}
+
+func (r *TerminalRecorder) Resize(cols int, rows int) {
+	r.event(castResize, fmt.Sprintf("%dx%d", cols, rows))
+}
+
+func (r *TerminalRecorder) event(code string, data string) {
+	if r == nil || len(data) == 0 {
+		return
+	}
+	line, err := json.Marshal([]interface{}{time.Since(r.start).Seconds(), code, data})
+	if err != nil {
+		global.LOG.Error("Failed to encode recording event: %v", err)
+		return
+	}
+	r.writeLine(line)
+}
+
+func (r *TerminalRecorder) writeLine(line []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return
+	}
+	n, err := r.file.Write(append(line, '\n'))
+	r.size += int64(n)
+	if err != nil {
+		global.LOG.Error("Failed to write recording: %v", err)
+	}
+}
+
+// Close 关闭录像文件并更新时长与大小
+func (r *TerminalRecorder) Close() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return
+	}
+	r.closed = true
+	if err := r.file.Close(); err != nil {
+		global.LOG.Error("Failed to close recording file: %v", err)
+	}
+	upMap := map[string]interface{}{
+		"size":     r.size,
+		"duration": time.Since(r.start).Seconds(),
+		"finished": true,
+	}
+	if err := TerminalRecordingRepo.Update(r.recordingID, upMap); err != nil {
+		global.LOG.Error("Failed to update recording %d: %v", r.recordingID, err)
+	}
+}
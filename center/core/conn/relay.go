@@ -0,0 +1,77 @@
+package conn
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/core/constant"
+	"github.com/sensdata/idb/core/message"
+	"github.com/sensdata/idb/core/utils"
+)
+
+// RelayFile 从源设备读取文件并经 center 上传到目标设备，使用与上传相同的续传及校验机制
+func (c *Center) RelayFile(srcHostID uint, srcPath string, size int64, fileHash string, dstHostID uint, dstPath string, cancel <-chan struct{}, progress func(received int64)) error {
+	srcHost, err := HostRepo.Get(HostRepo.WithByID(srcHostID))
+	if err != nil || srcHost.ID == 0 {
+		return errors.WithMessage(constant.ErrHost, fmt.Sprintf("source host %d not found", srcHostID))
+	}
+	dstHost, err := HostRepo.Get(HostRepo.WithByID(dstHostID))
+	if err != nil || dstHost.ID == 0 {
+		return errors.WithMessage(constant.ErrHost, fmt.Sprintf("dest host %d not found", dstHostID))
+	}
+
+	src := &remoteFile{
+		center: c,
+		host:   &srcHost,
+		dir:    filepath.Dir(srcPath),
+		name:   filepath.Base(srcPath),
+	}
+	task := newUploadTask(c, &dstHost, src, filepath.Dir(dstPath), filepath.Base(dstPath), size, fileHash)
+	task.cancel = cancel
+	task.progress = progress
+	return task.run()
+}
+
+// remoteFile 通过 Download 消息按偏移量读取 agent 上的文件
+type remoteFile struct {
+	center *Center
+	host   *model.Host
+	dir    string
+	name   string
+}
+
+func (r *remoteFile) ReadAt(p []byte, off int64) (int, error) {
+	// 读到文件末尾时 center 会关闭回复通道，因此每次读取使用新的消息ID
+	msgID := utils.GenerateMsgId()
+	responseCh := make(chan *message.FileMessage, 4)
+	r.center.mu.Lock()
+	r.center.fileResponseChMap[msgID] = responseCh
+	r.center.mu.Unlock()
+	defer func() {
+		r.center.mu.Lock()
+		delete(r.center.fileResponseChMap, msgID)
+		r.center.mu.Unlock()
+	}()
+
+	n := 0
+	for n < len(p) {
+		rsp, err := r.center.fetchChunk(r.host, msgID, responseCh, r.dir, r.name, off+int64(n), len(p)-n)
+		if errors.Is(err, errDownloadChunk) {
+			return n, &uploadAbort{msg: fmt.Sprintf("failed to read %s on host %d", filepath.Join(r.dir, r.name), r.host.ID)}
+		}
+		if err != nil {
+			return n, err
+		}
+		n += copy(p[n:], rsp.Chunk[:rsp.ChunkSize])
+		if rsp.Status == message.FileDone {
+			break
+		}
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
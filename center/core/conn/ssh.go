@@ -1084,6 +1084,15 @@ func (s *SSHService) handleInstalledStatus(host *model.Host) {
 	global.LOG.Info("Host agent status: %s", installed)
 }
 
+// GetPrivateKeyContent 读取设备配置的私钥文件内容
+func GetPrivateKeyContent(path string) (string, error) {
+	fileInfo, err := getPrivateKey(path)
+	if err != nil {
+		return "", err
+	}
+	return fileInfo.Content, nil
+}
+
 func getPrivateKey(path string) (*core.FileInfo, error) {
 	var fileInfo core.FileInfo
 
@@ -0,0 +1,241 @@
+package conn
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"github.com/sensdata/idb/core/message"
+)
+
+const (
+	uploadChunkSize  = 256 * 1024       // 256KB 块大小
+	uploadWindow     = 16               // 未确认的最大块数
+	uploadAckTimeout = 30 * time.Second // 超过该时间未收到确认时重新查询进度并续传
+	uploadMaxRetries = 10               // 无进展的最大连续重试次数
+)
+
+// uploadAbort 无法通过续传恢复的错误
+type uploadAbort struct {
+	msg string
+}
+
+func (e *uploadAbort) Error() string {
+	return e.msg
+}
+
+var errUploadCanceled = &uploadAbort{msg: "upload canceled"}
+
+// uploadTask 向 agent 上传一个文件
+//
+// 会话ID由目标位置及文件内容决定，中断后重新上传同一文件会从 agent 已接收的位置继续。
+// 每次连接先发送 UploadQuery 获取已接收的偏移量，然后在窗口内连续发送块，
+// agent 确认时返回已连续写入的字节数，据此推进窗口。
+type uploadTask struct {
+	center     *Center
+	host       *model.Host
+	src        io.ReaderAt
+	path       string
+	name       string
+	size       int64
+	fileHash   string
+	id         string
+	received   int64
+	responseCh chan *message.FileMessage
+	cancel     <-chan struct{}      // 可选，关闭后中止上传
+	progress   func(received int64) // 可选，agent 确认的字节数增加时调用
+}
+
+func newUploadTask(c *Center, host *model.Host, src io.ReaderAt, path string, name string, size int64, fileHash string) *uploadTask {
+	id := sha256.Sum256([]byte(fmt.Sprintf("%d|%s|%s|%d|%s", host.ID, path, name, size, fileHash)))
+	return &uploadTask{
+		center:     c,
+		host:       host,
+		src:        src,
+		path:       path,
+		name:       name,
+		size:       size,
+		fileHash:   fileHash,
+		id:         hex.EncodeToString(id[:16]),
+		responseCh: make(chan *message.FileMessage, uploadWindow*2),
+	}
+}
+
+// readerChecksum 计算 src 前 size 字节的 SHA-256
+func readerChecksum(src io.ReaderAt, size int64) (string, error) {
+	hash := sha256.New()
+	if _, err := io.Copy(hash, io.NewSectionReader(src, 0, size)); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func (t *uploadTask) run() error {
+	// 同一文件的上传共用会话，同时只能有一个
+	t.center.mu.Lock()
+	if _, exists := t.center.fileResponseChMap[t.id]; exists {
+		t.center.mu.Unlock()
+		return errors.New("the same file is being uploaded")
+	}
+	t.center.fileResponseChMap[t.id] = t.responseCh
+	t.center.mu.Unlock()
+	defer func() {
+		t.center.mu.Lock()
+		if t.center.fileResponseChMap[t.id] == t.responseCh {
+			delete(t.center.fileResponseChMap, t.id)
+		}
+		t.center.mu.Unlock()
+	}()
+
+	retries := 0
+	for {
+		start := t.received
+		done, err := t.attempt()
+		if done {
+			return nil
+		}
+		if _, ok := err.(*uploadAbort); ok {
+			return err
+		}
+		if t.received > start {
+			retries = 0
+		} else {
+			retries++
+		}
+		if retries >= uploadMaxRetries {
+			return errors.WithMessage(err, "upload interrupted")
+		}
+		global.LOG.Warn("Upload %s to %s interrupted at %d/%d, retry: %v", t.name, t.path, t.received, t.size, err)
+		select {
+		case <-t.cancel:
+			return errUploadCanceled
+		case <-time.After(time.Duration(min(retries+1, 6)) * 5 * time.Second):
+		}
+	}
+}
+
+// attempt 在当前连接上查询进度并发送剩余部分，返回是否已完成
+func (t *uploadTask) attempt() (bool, error) {
+	conn, err := t.center.getAgentConn(t.host)
+	if err != nil {
+		return false, err
+	}
+
+	query := t.message(message.UploadQuery, 0, nil)
+	query.FileHash = t.fileHash
+	if err := message.SendFileMessage(*conn, query); err != nil {
+		return false, err
+	}
+	for {
+		rsp, err := t.wait()
+		if err != nil {
+			return false, err
+		}
+		// 忽略上一次连接遗留的块确认
+		if rsp.Type != message.UploadQuery {
+			continue
+		}
+		if done, err := t.handle(rsp); done || err != nil {
+			return done, err
+		}
+		break
+	}
+
+	buffer := make([]byte, uploadChunkSize)
+	next := t.received
+	for {
+		for next < t.size && next-t.received < uploadWindow*uploadChunkSize {
+			select {
+			case <-t.cancel:
+				return false, errUploadCanceled
+			default:
+			}
+			n, err := t.src.ReadAt(buffer, next)
+			if err != nil && err != io.EOF {
+				// 源文件在其他设备上时，读取失败多为与源设备的连接中断，可以续传
+				if _, remote := t.src.(*remoteFile); remote {
+					if _, abort := err.(*uploadAbort); !abort {
+						return false, errors.WithMessage(err, "failed to read source file")
+					}
+				}
+				return false, &uploadAbort{msg: err.Error()}
+			}
+			msg := t.message(message.Upload, next, buffer[:n])
+			if err := message.SendFileMessage(*conn, msg); err != nil {
+				return false, err
+			}
+			next += int64(n)
+		}
+
+		rsp, err := t.wait()
+		if err != nil {
+			return false, err
+		}
+		if rsp.Type != message.Upload {
+			continue
+		}
+		if done, err := t.handle(rsp); done || err != nil {
+			return done, err
+		}
+	}
+}
+
+func (t *uploadTask) wait() (*message.FileMessage, error) {
+	select {
+	case rsp, ok := <-t.responseCh:
+		if !ok {
+			return nil, &uploadAbort{msg: "upload channel closed"}
+		}
+		return rsp, nil
+	case <-t.cancel:
+		return nil, errUploadCanceled
+	case <-time.After(uploadAckTimeout):
+		return nil, errors.New("timeout waiting for response from agent")
+	}
+}
+
+// handle 处理 agent 的回复，更新已接收的偏移量
+func (t *uploadTask) handle(rsp *message.FileMessage) (bool, error) {
+	switch rsp.Status {
+	case message.FileDone:
+		t.received = t.size
+		if t.progress != nil {
+			t.progress(t.received)
+		}
+		return true, nil
+	case message.FileRetry:
+		return false, errors.New(rsp.Error)
+	case message.FileErr:
+		return false, &uploadAbort{msg: rsp.Error}
+	}
+	if rsp.Received > t.received {
+		t.received = rsp.Received
+		if t.progress != nil {
+			t.progress(t.received)
+		}
+	}
+	return false, nil
+}
+
+func (t *uploadTask) message(msgType message.FileMessageType, offset int64, chunk []byte) *message.FileMessage {
+	msg := &message.FileMessage{
+		MsgID:     t.id,
+		Type:      msgType,
+		Path:      t.path,
+		FileName:  t.name,
+		TotalSize: t.size,
+		Offset:    offset,
+		ChunkSize: len(chunk),
+		Chunk:     chunk,
+		UploadID:  t.id,
+	}
+	if chunk != nil {
+		msg.ChunkHash = message.FileChecksum(chunk)
+	}
+	return msg
+}
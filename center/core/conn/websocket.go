@@ -106,11 +106,16 @@ func (s *WebSocketService) HandleSshTerminal(c *gin.Context) error {
 	}
 	defer sws.Close()
 
+	sws.recorder = newTerminalRecorder(c, &host, "ssh", utils.GenerateMsgId(), cols, rows)
+	defer sws.recorder.Close()
+
 	quitChan := make(chan bool, 3)
 	sws.Start(quitChan)
 	go sws.Wait(quitChan)
+	auditTerminal(c, uint(hostID), "terminal_start", `{"type":"ssh"}`)
 
 	<-quitChan
+	auditTerminal(c, uint(hostID), "terminal_stop", `{"type":"ssh"}`)
 
 	global.LOG.Info("handle ssh terminal end")
 	return nil
@@ -262,13 +267,19 @@ func (s *WebSocketService) HandleAgentTerminal(c *gin.Context) error {
 	}
 	defer aws.Close()
 
+	aws.recorder = newTerminalRecorder(c, &host, string(sessionType), aws.Session, cols, rows)
+	defer aws.recorder.Close()
+
 	quitChan := make(chan bool, 3)
 	// 将 aws 记录到center中
 	CENTER.RegisterAgentSession(aws)
 	CENTER.RegisterSessionToken(aws.Session, token)
 	aws.Start(quitChan)
+	params := fmt.Sprintf(`{"type":%q,"session":%q}`, sessionType, aws.Session)
+	auditTerminal(c, uint(hostID), "terminal_start", params)
 	// 等待quitChan
 	<-quitChan
+	auditTerminal(c, uint(hostID), "terminal_stop", params)
 	// 将 aws 从center中清除
 	CENTER.UnregisterSessionToken(aws.Session)
 	CENTER.UnregisterAgentSession(aws.Session)
@@ -22,6 +22,7 @@ type SshWebSocketSession struct {
 	wsConn          *websocket.Conn
 	isAdmin         bool
 	IsFlagged       bool
+	recorder        *TerminalRecorder
 }
 
 func NewSshWebSocketSession(cols, rows int, isAdmin bool, sshClient *ssh.Client, wsConn *websocket.Conn) (*SshWebSocketSession, error) {
@@ -127,6 +128,7 @@ func (sws *SshWebSocketSession) receiveWsMsg(exitCh chan bool) {
 					if err := sws.session.WindowChange(msgObj.Rows, msgObj.Cols); err != nil {
 						global.LOG.Error("ssh pty change windows size failed, err: %v", err)
 					}
+					sws.recorder.Resize(msgObj.Cols, msgObj.Rows)
 				}
 			case message.WsMessageCmd:
 				// decodeBytes, err := base64.StdEncoding.DecodeString(msgObj.Data)
@@ -134,6 +136,7 @@ func (sws *SshWebSocketSession) receiveWsMsg(exitCh chan bool) {
 				// 	global.LOG.Error("websock cmd string base64 decoding failed, err: %v", err)
 				// }
 				sws.socketInputToSshPipe([]byte(msgObj.Data))
+				sws.recorder.Input(msgObj.Data)
 			case message.WsMessageHeartbeat:
 				// 接收到心跳包后将心跳包原样返回，可以用于网络延迟检测等情况
 				err = wsConn.WriteMessage(websocket.TextMessage, wsData)
@@ -180,6 +183,7 @@ func (sws *SshWebSocketSession) sendComboOutput(exitCh chan bool) {
 				if err != nil {
 					global.LOG.Error("ssh sending combo output to webSocket failed, err: %v", err)
 				}
+				sws.recorder.Output(string(bs))
 				_, err = sws.logBuff.Write(bs)
 				if err != nil {
 					global.LOG.Error("combo output to log buffer failed, err: %v", err)
@@ -28,6 +28,7 @@ type AgentWebSocketSession struct {
 	token              string
 	hostID             uint
 	sessionType        message.SessionType
+	recorder           *TerminalRecorder
 }
 
 func NewAgentWebSocketSession(cols, rows int, agentConn *net.Conn, wsConn *websocket.Conn, agentSecret string, token string, hostID uint, sessionType message.SessionType) (*AgentWebSocketSession, error) {
@@ -152,6 +153,7 @@ func (aws *AgentWebSocketSession) receiveWsMsg(exitCh chan bool) {
 				}
 
 			case message.WsMessageCmd:
+				aws.recorder.Input(msgObj.Data)
 				err := aws.sendToAgent(
 					utils.GenerateMsgId(),
 					message.WsMessageCmd,
@@ -162,6 +164,7 @@ func (aws *AgentWebSocketSession) receiveWsMsg(exitCh chan bool) {
 				}
 
 			case message.WsMessageResize:
+				aws.recorder.Resize(msgObj.Cols, msgObj.Rows)
 				err := aws.sendToAgent(
 					utils.GenerateMsgId(),
 					message.WsMessageResize,
@@ -261,6 +264,7 @@ func (aws *AgentWebSocketSession) sendComboOutput(exitCh chan bool) {
 				global.LOG.Info("Response channel closed, exiting waitForTerminalResponse")
 				return
 			}
+			isOutput := response.Type == message.WsMessageCmd
 			message := message.WsMessage{
 				Code:      response.Data.Code,
 				Msg:       response.Data.Msg,
@@ -279,6 +283,9 @@ func (aws *AgentWebSocketSession) sendComboOutput(exitCh chan bool) {
 				global.LOG.Error("sending terminal message to webSocket failed, err: %v", err)
 				return
 			}
+			if isOutput {
+				aws.recorder.Output(response.Data.Data)
+			}
 			global.LOG.Info("Send to ws: %s", wsData)
 		}
 	}
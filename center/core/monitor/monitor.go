@@ -0,0 +1,54 @@
+package monitor
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace 导出指标的名称前缀
+const namespace = "idb"
+
+var (
+	// Registry 独立的注册表，不使用默认注册表，避免第三方库注册的指标混入
+	Registry = prometheus.NewRegistry()
+
+	// RequestDuration center api 请求耗时，route 为路由模板
+	RequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "center",
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of center API requests.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	// ActionDuration 下发 action 到 agent 并收到结果的耗时
+	ActionDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "center",
+		Name:      "action_duration_seconds",
+		Help:      "Round-trip latency of actions executed on agents.",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"action", "result"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		RequestDuration,
+		ActionDuration,
+	)
+}
+
+// Register 注册额外的采集器
+func Register(c prometheus.Collector) error {
+	return Registry.Register(c)
+}
+
+// Handler 以 OpenMetrics 或文本格式输出全部指标
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{EnableOpenMetrics: true})
+}
@@ -29,6 +29,23 @@ const (
 	MysqlManager_GetRootPassword_FullMethodName   = "/proto.MysqlManager/GetRootPassword"
 	MysqlManager_SetRootPassword_FullMethodName   = "/proto.MysqlManager/SetRootPassword"
 	MysqlManager_GetConnectionInfo_FullMethodName = "/proto.MysqlManager/GetConnectionInfo"
+	MysqlManager_Dump_FullMethodName              = "/proto.MysqlManager/Dump"
+	MysqlManager_ListDumps_FullMethodName         = "/proto.MysqlManager/ListDumps"
+	MysqlManager_DeleteDump_FullMethodName        = "/proto.MysqlManager/DeleteDump"
+	MysqlManager_RestoreDump_FullMethodName       = "/proto.MysqlManager/RestoreDump"
+	MysqlManager_GetDumpSchedule_FullMethodName   = "/proto.MysqlManager/GetDumpSchedule"
+	MysqlManager_SetDumpSchedule_FullMethodName   = "/proto.MysqlManager/SetDumpSchedule"
+	MysqlManager_ListDatabases_FullMethodName     = "/proto.MysqlManager/ListDatabases"
+	MysqlManager_CreateDatabase_FullMethodName    = "/proto.MysqlManager/CreateDatabase"
+	MysqlManager_DropDatabase_FullMethodName      = "/proto.MysqlManager/DropDatabase"
+	MysqlManager_ListUsers_FullMethodName         = "/proto.MysqlManager/ListUsers"
+	MysqlManager_CreateUser_FullMethodName        = "/proto.MysqlManager/CreateUser"
+	MysqlManager_DropUser_FullMethodName          = "/proto.MysqlManager/DropUser"
+	MysqlManager_GrantPrivileges_FullMethodName   = "/proto.MysqlManager/GrantPrivileges"
+	MysqlManager_RevokePrivileges_FullMethodName  = "/proto.MysqlManager/RevokePrivileges"
+	MysqlManager_SetUserPassword_FullMethodName   = "/proto.MysqlManager/SetUserPassword"
+	MysqlManager_ListConnections_FullMethodName   = "/proto.MysqlManager/ListConnections"
+	MysqlManager_GetSlowLog_FullMethodName        = "/proto.MysqlManager/GetSlowLog"
 )
 
 // MysqlManagerClient is the client API for MysqlManager service.
@@ -45,6 +62,23 @@ type MysqlManagerClient interface {
 	GetRootPassword(ctx context.Context, in *GetRootPasswordRequest, opts ...grpc.CallOption) (*GetRootPasswordResponse, error)
 	SetRootPassword(ctx context.Context, in *SetRootPasswordRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error)
 	GetConnectionInfo(ctx context.Context, in *GetConnectionInfoRequest, opts ...grpc.CallOption) (*GetConnectionInfoResponse, error)
+	Dump(ctx context.Context, in *DumpRequest, opts ...grpc.CallOption) (*DumpResponse, error)
+	ListDumps(ctx context.Context, in *ListDumpsRequest, opts ...grpc.CallOption) (*ListDumpsResponse, error)
+	DeleteDump(ctx context.Context, in *DeleteDumpRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error)
+	RestoreDump(ctx context.Context, in *RestoreDumpRequest, opts ...grpc.CallOption) (*DumpResponse, error)
+	GetDumpSchedule(ctx context.Context, in *GetDumpScheduleRequest, opts ...grpc.CallOption) (*GetDumpScheduleResponse, error)
+	SetDumpSchedule(ctx context.Context, in *SetDumpScheduleRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error)
+	ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error)
+	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error)
+	DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error)
+	DropUser(ctx context.Context, in *DropUserRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error)
+	GrantPrivileges(ctx context.Context, in *PrivilegesRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error)
+	RevokePrivileges(ctx context.Context, in *PrivilegesRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error)
+	SetUserPassword(ctx context.Context, in *SetUserPasswordRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error)
+	ListConnections(ctx context.Context, in *ListConnectionsRequest, opts ...grpc.CallOption) (*ListConnectionsResponse, error)
+	GetSlowLog(ctx context.Context, in *GetSlowLogRequest, opts ...grpc.CallOption) (*GetSlowLogResponse, error)
 }
 
 type mysqlManagerClient struct {
@@ -145,6 +179,159 @@ func (c *mysqlManagerClient) GetConnectionInfo(ctx context.Context, in *GetConne
 	return out, nil
 }
 
+func (c *mysqlManagerClient) Dump(ctx context.Context, in *DumpRequest, opts ...grpc.CallOption) (*DumpResponse, error) {
+	out := new(DumpResponse)
+	err := c.cc.Invoke(ctx, MysqlManager_Dump_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mysqlManagerClient) ListDumps(ctx context.Context, in *ListDumpsRequest, opts ...grpc.CallOption) (*ListDumpsResponse, error) {
+	out := new(ListDumpsResponse)
+	err := c.cc.Invoke(ctx, MysqlManager_ListDumps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mysqlManagerClient) DeleteDump(ctx context.Context, in *DeleteDumpRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error) {
+	out := new(MysqlCommonResponse)
+	err := c.cc.Invoke(ctx, MysqlManager_DeleteDump_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mysqlManagerClient) RestoreDump(ctx context.Context, in *RestoreDumpRequest, opts ...grpc.CallOption) (*DumpResponse, error) {
+	out := new(DumpResponse)
+	err := c.cc.Invoke(ctx, MysqlManager_RestoreDump_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mysqlManagerClient) GetDumpSchedule(ctx context.Context, in *GetDumpScheduleRequest, opts ...grpc.CallOption) (*GetDumpScheduleResponse, error) {
+	out := new(GetDumpScheduleResponse)
+	err := c.cc.Invoke(ctx, MysqlManager_GetDumpSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mysqlManagerClient) SetDumpSchedule(ctx context.Context, in *SetDumpScheduleRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error) {
+	out := new(MysqlCommonResponse)
+	err := c.cc.Invoke(ctx, MysqlManager_SetDumpSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mysqlManagerClient) ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error) {
+	out := new(ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, MysqlManager_ListDatabases_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mysqlManagerClient) CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error) {
+	out := new(MysqlCommonResponse)
+	err := c.cc.Invoke(ctx, MysqlManager_CreateDatabase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mysqlManagerClient) DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error) {
+	out := new(MysqlCommonResponse)
+	err := c.cc.Invoke(ctx, MysqlManager_DropDatabase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mysqlManagerClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, MysqlManager_ListUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mysqlManagerClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error) {
+	out := new(MysqlCommonResponse)
+	err := c.cc.Invoke(ctx, MysqlManager_CreateUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mysqlManagerClient) DropUser(ctx context.Context, in *DropUserRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error) {
+	out := new(MysqlCommonResponse)
+	err := c.cc.Invoke(ctx, MysqlManager_DropUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mysqlManagerClient) GrantPrivileges(ctx context.Context, in *PrivilegesRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error) {
+	out := new(MysqlCommonResponse)
+	err := c.cc.Invoke(ctx, MysqlManager_GrantPrivileges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mysqlManagerClient) RevokePrivileges(ctx context.Context, in *PrivilegesRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error) {
+	out := new(MysqlCommonResponse)
+	err := c.cc.Invoke(ctx, MysqlManager_RevokePrivileges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mysqlManagerClient) SetUserPassword(ctx context.Context, in *SetUserPasswordRequest, opts ...grpc.CallOption) (*MysqlCommonResponse, error) {
+	out := new(MysqlCommonResponse)
+	err := c.cc.Invoke(ctx, MysqlManager_SetUserPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mysqlManagerClient) ListConnections(ctx context.Context, in *ListConnectionsRequest, opts ...grpc.CallOption) (*ListConnectionsResponse, error) {
+	out := new(ListConnectionsResponse)
+	err := c.cc.Invoke(ctx, MysqlManager_ListConnections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mysqlManagerClient) GetSlowLog(ctx context.Context, in *GetSlowLogRequest, opts ...grpc.CallOption) (*GetSlowLogResponse, error) {
+	out := new(GetSlowLogResponse)
+	err := c.cc.Invoke(ctx, MysqlManager_GetSlowLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MysqlManagerServer is the server API for MysqlManager service.
 // All implementations must embed UnimplementedMysqlManagerServer
 // for forward compatibility
@@ -159,6 +346,23 @@ type MysqlManagerServer interface {
 	GetRootPassword(context.Context, *GetRootPasswordRequest) (*GetRootPasswordResponse, error)
 	SetRootPassword(context.Context, *SetRootPasswordRequest) (*MysqlCommonResponse, error)
 	GetConnectionInfo(context.Context, *GetConnectionInfoRequest) (*GetConnectionInfoResponse, error)
+	Dump(context.Context, *DumpRequest) (*DumpResponse, error)
+	ListDumps(context.Context, *ListDumpsRequest) (*ListDumpsResponse, error)
+	DeleteDump(context.Context, *DeleteDumpRequest) (*MysqlCommonResponse, error)
+	RestoreDump(context.Context, *RestoreDumpRequest) (*DumpResponse, error)
+	GetDumpSchedule(context.Context, *GetDumpScheduleRequest) (*GetDumpScheduleResponse, error)
+	SetDumpSchedule(context.Context, *SetDumpScheduleRequest) (*MysqlCommonResponse, error)
+	ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error)
+	CreateDatabase(context.Context, *CreateDatabaseRequest) (*MysqlCommonResponse, error)
+	DropDatabase(context.Context, *DropDatabaseRequest) (*MysqlCommonResponse, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	CreateUser(context.Context, *CreateUserRequest) (*MysqlCommonResponse, error)
+	DropUser(context.Context, *DropUserRequest) (*MysqlCommonResponse, error)
+	GrantPrivileges(context.Context, *PrivilegesRequest) (*MysqlCommonResponse, error)
+	RevokePrivileges(context.Context, *PrivilegesRequest) (*MysqlCommonResponse, error)
+	SetUserPassword(context.Context, *SetUserPasswordRequest) (*MysqlCommonResponse, error)
+	ListConnections(context.Context, *ListConnectionsRequest) (*ListConnectionsResponse, error)
+	GetSlowLog(context.Context, *GetSlowLogRequest) (*GetSlowLogResponse, error)
 	mustEmbedUnimplementedMysqlManagerServer()
 }
 
@@ -196,6 +400,57 @@ func (UnimplementedMysqlManagerServer) SetRootPassword(context.Context, *SetRoot
 func (UnimplementedMysqlManagerServer) GetConnectionInfo(context.Context, *GetConnectionInfoRequest) (*GetConnectionInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConnectionInfo not implemented")
 }
+func (UnimplementedMysqlManagerServer) Dump(context.Context, *DumpRequest) (*DumpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dump not implemented")
+}
+func (UnimplementedMysqlManagerServer) ListDumps(context.Context, *ListDumpsRequest) (*ListDumpsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDumps not implemented")
+}
+func (UnimplementedMysqlManagerServer) DeleteDump(context.Context, *DeleteDumpRequest) (*MysqlCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDump not implemented")
+}
+func (UnimplementedMysqlManagerServer) RestoreDump(context.Context, *RestoreDumpRequest) (*DumpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDump not implemented")
+}
+func (UnimplementedMysqlManagerServer) GetDumpSchedule(context.Context, *GetDumpScheduleRequest) (*GetDumpScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDumpSchedule not implemented")
+}
+func (UnimplementedMysqlManagerServer) SetDumpSchedule(context.Context, *SetDumpScheduleRequest) (*MysqlCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDumpSchedule not implemented")
+}
+func (UnimplementedMysqlManagerServer) ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
+func (UnimplementedMysqlManagerServer) CreateDatabase(context.Context, *CreateDatabaseRequest) (*MysqlCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (UnimplementedMysqlManagerServer) DropDatabase(context.Context, *DropDatabaseRequest) (*MysqlCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (UnimplementedMysqlManagerServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedMysqlManagerServer) CreateUser(context.Context, *CreateUserRequest) (*MysqlCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedMysqlManagerServer) DropUser(context.Context, *DropUserRequest) (*MysqlCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropUser not implemented")
+}
+func (UnimplementedMysqlManagerServer) GrantPrivileges(context.Context, *PrivilegesRequest) (*MysqlCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantPrivileges not implemented")
+}
+func (UnimplementedMysqlManagerServer) RevokePrivileges(context.Context, *PrivilegesRequest) (*MysqlCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePrivileges not implemented")
+}
+func (UnimplementedMysqlManagerServer) SetUserPassword(context.Context, *SetUserPasswordRequest) (*MysqlCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserPassword not implemented")
+}
+func (UnimplementedMysqlManagerServer) ListConnections(context.Context, *ListConnectionsRequest) (*ListConnectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnections not implemented")
+}
+func (UnimplementedMysqlManagerServer) GetSlowLog(context.Context, *GetSlowLogRequest) (*GetSlowLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSlowLog not implemented")
+}
 func (UnimplementedMysqlManagerServer) mustEmbedUnimplementedMysqlManagerServer() {}
 
 // UnsafeMysqlManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -389,6 +644,312 @@ func _MysqlManager_GetConnectionInfo_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MysqlManager_Dump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MysqlManagerServer).Dump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MysqlManager_Dump_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MysqlManagerServer).Dump(ctx, req.(*DumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MysqlManager_ListDumps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDumpsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MysqlManagerServer).ListDumps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MysqlManager_ListDumps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MysqlManagerServer).ListDumps(ctx, req.(*ListDumpsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MysqlManager_DeleteDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MysqlManagerServer).DeleteDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MysqlManager_DeleteDump_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MysqlManagerServer).DeleteDump(ctx, req.(*DeleteDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MysqlManager_RestoreDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MysqlManagerServer).RestoreDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MysqlManager_RestoreDump_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MysqlManagerServer).RestoreDump(ctx, req.(*RestoreDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MysqlManager_GetDumpSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDumpScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MysqlManagerServer).GetDumpSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MysqlManager_GetDumpSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MysqlManagerServer).GetDumpSchedule(ctx, req.(*GetDumpScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MysqlManager_SetDumpSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDumpScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MysqlManagerServer).SetDumpSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MysqlManager_SetDumpSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MysqlManagerServer).SetDumpSchedule(ctx, req.(*SetDumpScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MysqlManager_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MysqlManagerServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MysqlManager_ListDatabases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MysqlManagerServer).ListDatabases(ctx, req.(*ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MysqlManager_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MysqlManagerServer).CreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MysqlManager_CreateDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MysqlManagerServer).CreateDatabase(ctx, req.(*CreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MysqlManager_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MysqlManagerServer).DropDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MysqlManager_DropDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MysqlManagerServer).DropDatabase(ctx, req.(*DropDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MysqlManager_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MysqlManagerServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MysqlManager_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MysqlManagerServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MysqlManager_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MysqlManagerServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MysqlManager_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MysqlManagerServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MysqlManager_DropUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MysqlManagerServer).DropUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MysqlManager_DropUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MysqlManagerServer).DropUser(ctx, req.(*DropUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MysqlManager_GrantPrivileges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrivilegesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MysqlManagerServer).GrantPrivileges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MysqlManager_GrantPrivileges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MysqlManagerServer).GrantPrivileges(ctx, req.(*PrivilegesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MysqlManager_RevokePrivileges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrivilegesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MysqlManagerServer).RevokePrivileges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MysqlManager_RevokePrivileges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MysqlManagerServer).RevokePrivileges(ctx, req.(*PrivilegesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MysqlManager_SetUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MysqlManagerServer).SetUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MysqlManager_SetUserPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MysqlManagerServer).SetUserPassword(ctx, req.(*SetUserPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MysqlManager_ListConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConnectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MysqlManagerServer).ListConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MysqlManager_ListConnections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MysqlManagerServer).ListConnections(ctx, req.(*ListConnectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MysqlManager_GetSlowLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSlowLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MysqlManagerServer).GetSlowLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MysqlManager_GetSlowLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MysqlManagerServer).GetSlowLog(ctx, req.(*GetSlowLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MysqlManager_ServiceDesc is the grpc.ServiceDesc for MysqlManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -436,6 +997,74 @@ var MysqlManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetConnectionInfo",
 			Handler:    _MysqlManager_GetConnectionInfo_Handler,
 		},
+		{
+			MethodName: "Dump",
+			Handler:    _MysqlManager_Dump_Handler,
+		},
+		{
+			MethodName: "ListDumps",
+			Handler:    _MysqlManager_ListDumps_Handler,
+		},
+		{
+			MethodName: "DeleteDump",
+			Handler:    _MysqlManager_DeleteDump_Handler,
+		},
+		{
+			MethodName: "RestoreDump",
+			Handler:    _MysqlManager_RestoreDump_Handler,
+		},
+		{
+			MethodName: "GetDumpSchedule",
+			Handler:    _MysqlManager_GetDumpSchedule_Handler,
+		},
+		{
+			MethodName: "SetDumpSchedule",
+			Handler:    _MysqlManager_SetDumpSchedule_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _MysqlManager_ListDatabases_Handler,
+		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _MysqlManager_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _MysqlManager_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _MysqlManager_ListUsers_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _MysqlManager_CreateUser_Handler,
+		},
+		{
+			MethodName: "DropUser",
+			Handler:    _MysqlManager_DropUser_Handler,
+		},
+		{
+			MethodName: "GrantPrivileges",
+			Handler:    _MysqlManager_GrantPrivileges_Handler,
+		},
+		{
+			MethodName: "RevokePrivileges",
+			Handler:    _MysqlManager_RevokePrivileges_Handler,
+		},
+		{
+			MethodName: "SetUserPassword",
+			Handler:    _MysqlManager_SetUserPassword_Handler,
+		},
+		{
+			MethodName: "ListConnections",
+			Handler:    _MysqlManager_ListConnections_Handler,
+		},
+		{
+			MethodName: "GetSlowLog",
+			Handler:    _MysqlManager_GetSlowLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mysqlmanager.proto",
@@ -19,11 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	PostgreSql_PGGetComposes_FullMethodName = "/proto.PostgreSql/PGGetComposes"
-	PostgreSql_PGOperation_FullMethodName   = "/proto.PostgreSql/PGOperation"
-	PostgreSql_PGSetPort_FullMethodName     = "/proto.PostgreSql/PGSetPort"
-	PostgreSql_PGGetConf_FullMethodName     = "/proto.PostgreSql/PGGetConf"
-	PostgreSql_PGSetConf_FullMethodName     = "/proto.PostgreSql/PGSetConf"
+	PostgreSql_PGGetComposes_FullMethodName      = "/proto.PostgreSql/PGGetComposes"
+	PostgreSql_PGOperation_FullMethodName        = "/proto.PostgreSql/PGOperation"
+	PostgreSql_PGSetPort_FullMethodName          = "/proto.PostgreSql/PGSetPort"
+	PostgreSql_PGGetConf_FullMethodName          = "/proto.PostgreSql/PGGetConf"
+	PostgreSql_PGSetConf_FullMethodName          = "/proto.PostgreSql/PGSetConf"
+	PostgreSql_PGDump_FullMethodName             = "/proto.PostgreSql/PGDump"
+	PostgreSql_PGListDumps_FullMethodName        = "/proto.PostgreSql/PGListDumps"
+	PostgreSql_PGDeleteDump_FullMethodName       = "/proto.PostgreSql/PGDeleteDump"
+	PostgreSql_PGRestoreDump_FullMethodName      = "/proto.PostgreSql/PGRestoreDump"
+	PostgreSql_PGGetDumpSchedule_FullMethodName  = "/proto.PostgreSql/PGGetDumpSchedule"
+	PostgreSql_PGSetDumpSchedule_FullMethodName  = "/proto.PostgreSql/PGSetDumpSchedule"
+	PostgreSql_PGListDatabases_FullMethodName    = "/proto.PostgreSql/PGListDatabases"
+	PostgreSql_PGCreateDatabase_FullMethodName   = "/proto.PostgreSql/PGCreateDatabase"
+	PostgreSql_PGDropDatabase_FullMethodName     = "/proto.PostgreSql/PGDropDatabase"
+	PostgreSql_PGListUsers_FullMethodName        = "/proto.PostgreSql/PGListUsers"
+	PostgreSql_PGCreateUser_FullMethodName       = "/proto.PostgreSql/PGCreateUser"
+	PostgreSql_PGDropUser_FullMethodName         = "/proto.PostgreSql/PGDropUser"
+	PostgreSql_PGGrantPrivileges_FullMethodName  = "/proto.PostgreSql/PGGrantPrivileges"
+	PostgreSql_PGRevokePrivileges_FullMethodName = "/proto.PostgreSql/PGRevokePrivileges"
+	PostgreSql_PGSetUserPassword_FullMethodName  = "/proto.PostgreSql/PGSetUserPassword"
+	PostgreSql_PGListConnections_FullMethodName  = "/proto.PostgreSql/PGListConnections"
+	PostgreSql_PGGetSlowLog_FullMethodName       = "/proto.PostgreSql/PGGetSlowLog"
 )
 
 // PostgreSqlClient is the client API for PostgreSql service.
@@ -35,6 +52,23 @@ type PostgreSqlClient interface {
 	PGSetPort(ctx context.Context, in *PGSetPortRequest, opts ...grpc.CallOption) (*PGCommonResponse, error)
 	PGGetConf(ctx context.Context, in *PGGetConfRequest, opts ...grpc.CallOption) (*PGGetConfResponse, error)
 	PGSetConf(ctx context.Context, in *PGSetConfRequest, opts ...grpc.CallOption) (*PGCommonResponse, error)
+	PGDump(ctx context.Context, in *PGDumpRequest, opts ...grpc.CallOption) (*PGDumpResponse, error)
+	PGListDumps(ctx context.Context, in *PGListDumpsRequest, opts ...grpc.CallOption) (*PGListDumpsResponse, error)
+	PGDeleteDump(ctx context.Context, in *PGDeleteDumpRequest, opts ...grpc.CallOption) (*PGCommonResponse, error)
+	PGRestoreDump(ctx context.Context, in *PGRestoreDumpRequest, opts ...grpc.CallOption) (*PGDumpResponse, error)
+	PGGetDumpSchedule(ctx context.Context, in *PGGetDumpScheduleRequest, opts ...grpc.CallOption) (*PGGetDumpScheduleResponse, error)
+	PGSetDumpSchedule(ctx context.Context, in *PGSetDumpScheduleRequest, opts ...grpc.CallOption) (*PGCommonResponse, error)
+	PGListDatabases(ctx context.Context, in *PGListDatabasesRequest, opts ...grpc.CallOption) (*PGListDatabasesResponse, error)
+	PGCreateDatabase(ctx context.Context, in *PGCreateDatabaseRequest, opts ...grpc.CallOption) (*PGCommonResponse, error)
+	PGDropDatabase(ctx context.Context, in *PGDropDatabaseRequest, opts ...grpc.CallOption) (*PGCommonResponse, error)
+	PGListUsers(ctx context.Context, in *PGListUsersRequest, opts ...grpc.CallOption) (*PGListUsersResponse, error)
+	PGCreateUser(ctx context.Context, in *PGCreateUserRequest, opts ...grpc.CallOption) (*PGCommonResponse, error)
+	PGDropUser(ctx context.Context, in *PGDropUserRequest, opts ...grpc.CallOption) (*PGCommonResponse, error)
+	PGGrantPrivileges(ctx context.Context, in *PGPrivilegesRequest, opts ...grpc.CallOption) (*PGCommonResponse, error)
+	PGRevokePrivileges(ctx context.Context, in *PGPrivilegesRequest, opts ...grpc.CallOption) (*PGCommonResponse, error)
+	PGSetUserPassword(ctx context.Context, in *PGSetUserPasswordRequest, opts ...grpc.CallOption) (*PGCommonResponse, error)
+	PGListConnections(ctx context.Context, in *PGListConnectionsRequest, opts ...grpc.CallOption) (*PGListConnectionsResponse, error)
+	PGGetSlowLog(ctx context.Context, in *PGGetSlowLogRequest, opts ...grpc.CallOption) (*PGGetSlowLogResponse, error)
 }
 
 type postgreSqlClient struct {
@@ -90,6 +124,159 @@ func (c *postgreSqlClient) PGSetConf(ctx context.Context, in *PGSetConfRequest,
 	return out, nil
 }
 
+func (c *postgreSqlClient) PGDump(ctx context.Context, in *PGDumpRequest, opts ...grpc.CallOption) (*PGDumpResponse, error) {
+	out := new(PGDumpResponse)
+	err := c.cc.Invoke(ctx, PostgreSql_PGDump_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postgreSqlClient) PGListDumps(ctx context.Context, in *PGListDumpsRequest, opts ...grpc.CallOption) (*PGListDumpsResponse, error) {
+	out := new(PGListDumpsResponse)
+	err := c.cc.Invoke(ctx, PostgreSql_PGListDumps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postgreSqlClient) PGDeleteDump(ctx context.Context, in *PGDeleteDumpRequest, opts ...grpc.CallOption) (*PGCommonResponse, error) {
+	out := new(PGCommonResponse)
+	err := c.cc.Invoke(ctx, PostgreSql_PGDeleteDump_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postgreSqlClient) PGRestoreDump(ctx context.Context, in *PGRestoreDumpRequest, opts ...grpc.CallOption) (*PGDumpResponse, error) {
+	out := new(PGDumpResponse)
+	err := c.cc.Invoke(ctx, PostgreSql_PGRestoreDump_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postgreSqlClient) PGGetDumpSchedule(ctx context.Context, in *PGGetDumpScheduleRequest, opts ...grpc.CallOption) (*PGGetDumpScheduleResponse, error) {
+	out := new(PGGetDumpScheduleResponse)
+	err := c.cc.Invoke(ctx, PostgreSql_PGGetDumpSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postgreSqlClient) PGSetDumpSchedule(ctx context.Context, in *PGSetDumpScheduleRequest, opts ...grpc.CallOption) (*PGCommonResponse, error) {
+	out := new(PGCommonResponse)
+	err := c.cc.Invoke(ctx, PostgreSql_PGSetDumpSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postgreSqlClient) PGListDatabases(ctx context.Context, in *PGListDatabasesRequest, opts ...grpc.CallOption) (*PGListDatabasesResponse, error) {
+	out := new(PGListDatabasesResponse)
+	err := c.cc.Invoke(ctx, PostgreSql_PGListDatabases_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postgreSqlClient) PGCreateDatabase(ctx context.Context, in *PGCreateDatabaseRequest, opts ...grpc.CallOption) (*PGCommonResponse, error) {
+	out := new(PGCommonResponse)
+	err := c.cc.Invoke(ctx, PostgreSql_PGCreateDatabase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postgreSqlClient) PGDropDatabase(ctx context.Context, in *PGDropDatabaseRequest, opts ...grpc.CallOption) (*PGCommonResponse, error) {
+	out := new(PGCommonResponse)
+	err := c.cc.Invoke(ctx, PostgreSql_PGDropDatabase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postgreSqlClient) PGListUsers(ctx context.Context, in *PGListUsersRequest, opts ...grpc.CallOption) (*PGListUsersResponse, error) {
+	out := new(PGListUsersResponse)
+	err := c.cc.Invoke(ctx, PostgreSql_PGListUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postgreSqlClient) PGCreateUser(ctx context.Context, in *PGCreateUserRequest, opts ...grpc.CallOption) (*PGCommonResponse, error) {
+	out := new(PGCommonResponse)
+	err := c.cc.Invoke(ctx, PostgreSql_PGCreateUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postgreSqlClient) PGDropUser(ctx context.Context, in *PGDropUserRequest, opts ...grpc.CallOption) (*PGCommonResponse, error) {
+	out := new(PGCommonResponse)
+	err := c.cc.Invoke(ctx, PostgreSql_PGDropUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postgreSqlClient) PGGrantPrivileges(ctx context.Context, in *PGPrivilegesRequest, opts ...grpc.CallOption) (*PGCommonResponse, error) {
+	out := new(PGCommonResponse)
+	err := c.cc.Invoke(ctx, PostgreSql_PGGrantPrivileges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postgreSqlClient) PGRevokePrivileges(ctx context.Context, in *PGPrivilegesRequest, opts ...grpc.CallOption) (*PGCommonResponse, error) {
+	out := new(PGCommonResponse)
+	err := c.cc.Invoke(ctx, PostgreSql_PGRevokePrivileges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postgreSqlClient) PGSetUserPassword(ctx context.Context, in *PGSetUserPasswordRequest, opts ...grpc.CallOption) (*PGCommonResponse, error) {
+	out := new(PGCommonResponse)
+	err := c.cc.Invoke(ctx, PostgreSql_PGSetUserPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postgreSqlClient) PGListConnections(ctx context.Context, in *PGListConnectionsRequest, opts ...grpc.CallOption) (*PGListConnectionsResponse, error) {
+	out := new(PGListConnectionsResponse)
+	err := c.cc.Invoke(ctx, PostgreSql_PGListConnections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postgreSqlClient) PGGetSlowLog(ctx context.Context, in *PGGetSlowLogRequest, opts ...grpc.CallOption) (*PGGetSlowLogResponse, error) {
+	out := new(PGGetSlowLogResponse)
+	err := c.cc.Invoke(ctx, PostgreSql_PGGetSlowLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PostgreSqlServer is the server API for PostgreSql service.
 // All implementations must embed UnimplementedPostgreSqlServer
 // for forward compatibility
@@ -99,6 +286,23 @@ type PostgreSqlServer interface {
 	PGSetPort(context.Context, *PGSetPortRequest) (*PGCommonResponse, error)
 	PGGetConf(context.Context, *PGGetConfRequest) (*PGGetConfResponse, error)
 	PGSetConf(context.Context, *PGSetConfRequest) (*PGCommonResponse, error)
+	PGDump(context.Context, *PGDumpRequest) (*PGDumpResponse, error)
+	PGListDumps(context.Context, *PGListDumpsRequest) (*PGListDumpsResponse, error)
+	PGDeleteDump(context.Context, *PGDeleteDumpRequest) (*PGCommonResponse, error)
+	PGRestoreDump(context.Context, *PGRestoreDumpRequest) (*PGDumpResponse, error)
+	PGGetDumpSchedule(context.Context, *PGGetDumpScheduleRequest) (*PGGetDumpScheduleResponse, error)
+	PGSetDumpSchedule(context.Context, *PGSetDumpScheduleRequest) (*PGCommonResponse, error)
+	PGListDatabases(context.Context, *PGListDatabasesRequest) (*PGListDatabasesResponse, error)
+	PGCreateDatabase(context.Context, *PGCreateDatabaseRequest) (*PGCommonResponse, error)
+	PGDropDatabase(context.Context, *PGDropDatabaseRequest) (*PGCommonResponse, error)
+	PGListUsers(context.Context, *PGListUsersRequest) (*PGListUsersResponse, error)
+	PGCreateUser(context.Context, *PGCreateUserRequest) (*PGCommonResponse, error)
+	PGDropUser(context.Context, *PGDropUserRequest) (*PGCommonResponse, error)
+	PGGrantPrivileges(context.Context, *PGPrivilegesRequest) (*PGCommonResponse, error)
+	PGRevokePrivileges(context.Context, *PGPrivilegesRequest) (*PGCommonResponse, error)
+	PGSetUserPassword(context.Context, *PGSetUserPasswordRequest) (*PGCommonResponse, error)
+	PGListConnections(context.Context, *PGListConnectionsRequest) (*PGListConnectionsResponse, error)
+	PGGetSlowLog(context.Context, *PGGetSlowLogRequest) (*PGGetSlowLogResponse, error)
 	mustEmbedUnimplementedPostgreSqlServer()
 }
 
@@ -121,6 +325,57 @@ func (UnimplementedPostgreSqlServer) PGGetConf(context.Context, *PGGetConfReques
 func (UnimplementedPostgreSqlServer) PGSetConf(context.Context, *PGSetConfRequest) (*PGCommonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PGSetConf not implemented")
 }
+func (UnimplementedPostgreSqlServer) PGDump(context.Context, *PGDumpRequest) (*PGDumpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PGDump not implemented")
+}
+func (UnimplementedPostgreSqlServer) PGListDumps(context.Context, *PGListDumpsRequest) (*PGListDumpsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PGListDumps not implemented")
+}
+func (UnimplementedPostgreSqlServer) PGDeleteDump(context.Context, *PGDeleteDumpRequest) (*PGCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PGDeleteDump not implemented")
+}
+func (UnimplementedPostgreSqlServer) PGRestoreDump(context.Context, *PGRestoreDumpRequest) (*PGDumpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PGRestoreDump not implemented")
+}
+func (UnimplementedPostgreSqlServer) PGGetDumpSchedule(context.Context, *PGGetDumpScheduleRequest) (*PGGetDumpScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PGGetDumpSchedule not implemented")
+}
+func (UnimplementedPostgreSqlServer) PGSetDumpSchedule(context.Context, *PGSetDumpScheduleRequest) (*PGCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PGSetDumpSchedule not implemented")
+}
+func (UnimplementedPostgreSqlServer) PGListDatabases(context.Context, *PGListDatabasesRequest) (*PGListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PGListDatabases not implemented")
+}
+func (UnimplementedPostgreSqlServer) PGCreateDatabase(context.Context, *PGCreateDatabaseRequest) (*PGCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PGCreateDatabase not implemented")
+}
+func (UnimplementedPostgreSqlServer) PGDropDatabase(context.Context, *PGDropDatabaseRequest) (*PGCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PGDropDatabase not implemented")
+}
+func (UnimplementedPostgreSqlServer) PGListUsers(context.Context, *PGListUsersRequest) (*PGListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PGListUsers not implemented")
+}
+func (UnimplementedPostgreSqlServer) PGCreateUser(context.Context, *PGCreateUserRequest) (*PGCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PGCreateUser not implemented")
+}
+func (UnimplementedPostgreSqlServer) PGDropUser(context.Context, *PGDropUserRequest) (*PGCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PGDropUser not implemented")
+}
+func (UnimplementedPostgreSqlServer) PGGrantPrivileges(context.Context, *PGPrivilegesRequest) (*PGCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PGGrantPrivileges not implemented")
+}
+func (UnimplementedPostgreSqlServer) PGRevokePrivileges(context.Context, *PGPrivilegesRequest) (*PGCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PGRevokePrivileges not implemented")
+}
+func (UnimplementedPostgreSqlServer) PGSetUserPassword(context.Context, *PGSetUserPasswordRequest) (*PGCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PGSetUserPassword not implemented")
+}
+func (UnimplementedPostgreSqlServer) PGListConnections(context.Context, *PGListConnectionsRequest) (*PGListConnectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PGListConnections not implemented")
+}
+func (UnimplementedPostgreSqlServer) PGGetSlowLog(context.Context, *PGGetSlowLogRequest) (*PGGetSlowLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PGGetSlowLog not implemented")
+}
 func (UnimplementedPostgreSqlServer) mustEmbedUnimplementedPostgreSqlServer() {}
 
 // UnsafePostgreSqlServer may be embedded to opt out of forward compatibility for this service.
@@ -224,6 +479,312 @@ func _PostgreSql_PGSetConf_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostgreSql_PGDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PGDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostgreSqlServer).PGDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostgreSql_PGDump_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostgreSqlServer).PGDump(ctx, req.(*PGDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostgreSql_PGListDumps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PGListDumpsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostgreSqlServer).PGListDumps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostgreSql_PGListDumps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostgreSqlServer).PGListDumps(ctx, req.(*PGListDumpsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostgreSql_PGDeleteDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PGDeleteDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostgreSqlServer).PGDeleteDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostgreSql_PGDeleteDump_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostgreSqlServer).PGDeleteDump(ctx, req.(*PGDeleteDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostgreSql_PGRestoreDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PGRestoreDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostgreSqlServer).PGRestoreDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostgreSql_PGRestoreDump_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostgreSqlServer).PGRestoreDump(ctx, req.(*PGRestoreDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostgreSql_PGGetDumpSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PGGetDumpScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostgreSqlServer).PGGetDumpSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostgreSql_PGGetDumpSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostgreSqlServer).PGGetDumpSchedule(ctx, req.(*PGGetDumpScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostgreSql_PGSetDumpSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PGSetDumpScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostgreSqlServer).PGSetDumpSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostgreSql_PGSetDumpSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostgreSqlServer).PGSetDumpSchedule(ctx, req.(*PGSetDumpScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostgreSql_PGListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PGListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostgreSqlServer).PGListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostgreSql_PGListDatabases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostgreSqlServer).PGListDatabases(ctx, req.(*PGListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostgreSql_PGCreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PGCreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostgreSqlServer).PGCreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostgreSql_PGCreateDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostgreSqlServer).PGCreateDatabase(ctx, req.(*PGCreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostgreSql_PGDropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PGDropDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostgreSqlServer).PGDropDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostgreSql_PGDropDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostgreSqlServer).PGDropDatabase(ctx, req.(*PGDropDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostgreSql_PGListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PGListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostgreSqlServer).PGListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostgreSql_PGListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostgreSqlServer).PGListUsers(ctx, req.(*PGListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostgreSql_PGCreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PGCreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostgreSqlServer).PGCreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostgreSql_PGCreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostgreSqlServer).PGCreateUser(ctx, req.(*PGCreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostgreSql_PGDropUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PGDropUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostgreSqlServer).PGDropUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostgreSql_PGDropUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostgreSqlServer).PGDropUser(ctx, req.(*PGDropUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostgreSql_PGGrantPrivileges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PGPrivilegesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostgreSqlServer).PGGrantPrivileges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostgreSql_PGGrantPrivileges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostgreSqlServer).PGGrantPrivileges(ctx, req.(*PGPrivilegesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostgreSql_PGRevokePrivileges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PGPrivilegesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostgreSqlServer).PGRevokePrivileges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostgreSql_PGRevokePrivileges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostgreSqlServer).PGRevokePrivileges(ctx, req.(*PGPrivilegesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostgreSql_PGSetUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PGSetUserPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostgreSqlServer).PGSetUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostgreSql_PGSetUserPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostgreSqlServer).PGSetUserPassword(ctx, req.(*PGSetUserPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostgreSql_PGListConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PGListConnectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostgreSqlServer).PGListConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostgreSql_PGListConnections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostgreSqlServer).PGListConnections(ctx, req.(*PGListConnectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostgreSql_PGGetSlowLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PGGetSlowLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostgreSqlServer).PGGetSlowLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostgreSql_PGGetSlowLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostgreSqlServer).PGGetSlowLog(ctx, req.(*PGGetSlowLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PostgreSql_ServiceDesc is the grpc.ServiceDesc for PostgreSql service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -251,6 +812,74 @@ var PostgreSql_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PGSetConf",
 			Handler:    _PostgreSql_PGSetConf_Handler,
 		},
+		{
+			MethodName: "PGDump",
+			Handler:    _PostgreSql_PGDump_Handler,
+		},
+		{
+			MethodName: "PGListDumps",
+			Handler:    _PostgreSql_PGListDumps_Handler,
+		},
+		{
+			MethodName: "PGDeleteDump",
+			Handler:    _PostgreSql_PGDeleteDump_Handler,
+		},
+		{
+			MethodName: "PGRestoreDump",
+			Handler:    _PostgreSql_PGRestoreDump_Handler,
+		},
+		{
+			MethodName: "PGGetDumpSchedule",
+			Handler:    _PostgreSql_PGGetDumpSchedule_Handler,
+		},
+		{
+			MethodName: "PGSetDumpSchedule",
+			Handler:    _PostgreSql_PGSetDumpSchedule_Handler,
+		},
+		{
+			MethodName: "PGListDatabases",
+			Handler:    _PostgreSql_PGListDatabases_Handler,
+		},
+		{
+			MethodName: "PGCreateDatabase",
+			Handler:    _PostgreSql_PGCreateDatabase_Handler,
+		},
+		{
+			MethodName: "PGDropDatabase",
+			Handler:    _PostgreSql_PGDropDatabase_Handler,
+		},
+		{
+			MethodName: "PGListUsers",
+			Handler:    _PostgreSql_PGListUsers_Handler,
+		},
+		{
+			MethodName: "PGCreateUser",
+			Handler:    _PostgreSql_PGCreateUser_Handler,
+		},
+		{
+			MethodName: "PGDropUser",
+			Handler:    _PostgreSql_PGDropUser_Handler,
+		},
+		{
+			MethodName: "PGGrantPrivileges",
+			Handler:    _PostgreSql_PGGrantPrivileges_Handler,
+		},
+		{
+			MethodName: "PGRevokePrivileges",
+			Handler:    _PostgreSql_PGRevokePrivileges_Handler,
+		},
+		{
+			MethodName: "PGSetUserPassword",
+			Handler:    _PostgreSql_PGSetUserPassword_Handler,
+		},
+		{
+			MethodName: "PGListConnections",
+			Handler:    _PostgreSql_PGListConnections_Handler,
+		},
+		{
+			MethodName: "PGGetSlowLog",
+			Handler:    _PostgreSql_PGGetSlowLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "postgresql.proto",
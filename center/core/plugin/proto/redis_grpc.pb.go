@@ -28,6 +28,21 @@ const (
 	Redis_RedisSetRemoteAccess_FullMethodName = "/proto.Redis/RedisSetRemoteAccess"
 	Redis_RedisGetRootPassword_FullMethodName = "/proto.Redis/RedisGetRootPassword"
 	Redis_RedisSetRootPassword_FullMethodName = "/proto.Redis/RedisSetRootPassword"
+	Redis_RedisDump_FullMethodName            = "/proto.Redis/RedisDump"
+	Redis_RedisListDumps_FullMethodName       = "/proto.Redis/RedisListDumps"
+	Redis_RedisDeleteDump_FullMethodName      = "/proto.Redis/RedisDeleteDump"
+	Redis_RedisRestoreDump_FullMethodName     = "/proto.Redis/RedisRestoreDump"
+	Redis_RedisGetDumpSchedule_FullMethodName = "/proto.Redis/RedisGetDumpSchedule"
+	Redis_RedisSetDumpSchedule_FullMethodName = "/proto.Redis/RedisSetDumpSchedule"
+	Redis_RedisInfo_FullMethodName            = "/proto.Redis/RedisInfo"
+	Redis_RedisKeyspace_FullMethodName        = "/proto.Redis/RedisKeyspace"
+	Redis_RedisScanKeys_FullMethodName        = "/proto.Redis/RedisScanKeys"
+	Redis_RedisGetKey_FullMethodName          = "/proto.Redis/RedisGetKey"
+	Redis_RedisSetTTL_FullMethodName          = "/proto.Redis/RedisSetTTL"
+	Redis_RedisSlowlog_FullMethodName         = "/proto.Redis/RedisSlowlog"
+	Redis_RedisClients_FullMethodName         = "/proto.Redis/RedisClients"
+	Redis_RedisKillClient_FullMethodName      = "/proto.Redis/RedisKillClient"
+	Redis_RedisBigKeys_FullMethodName         = "/proto.Redis/RedisBigKeys"
 )
 
 // RedisClient is the client API for Redis service.
@@ -43,6 +58,21 @@ type RedisClient interface {
 	RedisSetRemoteAccess(ctx context.Context, in *RedisSetRemoteAccessRequest, opts ...grpc.CallOption) (*RedisCommonResponse, error)
 	RedisGetRootPassword(ctx context.Context, in *RedisGetRootPasswordRequest, opts ...grpc.CallOption) (*RedisGetRootPasswordResponse, error)
 	RedisSetRootPassword(ctx context.Context, in *RedisSetRootPasswordRequest, opts ...grpc.CallOption) (*RedisCommonResponse, error)
+	RedisDump(ctx context.Context, in *RedisDumpRequest, opts ...grpc.CallOption) (*RedisDumpResponse, error)
+	RedisListDumps(ctx context.Context, in *RedisListDumpsRequest, opts ...grpc.CallOption) (*RedisListDumpsResponse, error)
+	RedisDeleteDump(ctx context.Context, in *RedisDeleteDumpRequest, opts ...grpc.CallOption) (*RedisCommonResponse, error)
+	RedisRestoreDump(ctx context.Context, in *RedisRestoreDumpRequest, opts ...grpc.CallOption) (*RedisDumpResponse, error)
+	RedisGetDumpSchedule(ctx context.Context, in *RedisGetDumpScheduleRequest, opts ...grpc.CallOption) (*RedisGetDumpScheduleResponse, error)
+	RedisSetDumpSchedule(ctx context.Context, in *RedisSetDumpScheduleRequest, opts ...grpc.CallOption) (*RedisCommonResponse, error)
+	RedisInfo(ctx context.Context, in *RedisInfoRequest, opts ...grpc.CallOption) (*RedisInfoResponse, error)
+	RedisKeyspace(ctx context.Context, in *RedisKeyspaceRequest, opts ...grpc.CallOption) (*RedisKeyspaceResponse, error)
+	RedisScanKeys(ctx context.Context, in *RedisScanKeysRequest, opts ...grpc.CallOption) (*RedisScanKeysResponse, error)
+	RedisGetKey(ctx context.Context, in *RedisGetKeyRequest, opts ...grpc.CallOption) (*RedisGetKeyResponse, error)
+	RedisSetTTL(ctx context.Context, in *RedisSetTTLRequest, opts ...grpc.CallOption) (*RedisCommonResponse, error)
+	RedisSlowlog(ctx context.Context, in *RedisSlowlogRequest, opts ...grpc.CallOption) (*RedisSlowlogResponse, error)
+	RedisClients(ctx context.Context, in *RedisClientsRequest, opts ...grpc.CallOption) (*RedisClientsResponse, error)
+	RedisKillClient(ctx context.Context, in *RedisKillClientRequest, opts ...grpc.CallOption) (*RedisCommonResponse, error)
+	RedisBigKeys(ctx context.Context, in *RedisBigKeysRequest, opts ...grpc.CallOption) (*RedisBigKeysResponse, error)
 }
 
 type redisClient struct {
@@ -134,6 +164,141 @@ func (c *redisClient) RedisSetRootPassword(ctx context.Context, in *RedisSetRoot
 	return out, nil
 }
 
+func (c *redisClient) RedisDump(ctx context.Context, in *RedisDumpRequest, opts ...grpc.CallOption) (*RedisDumpResponse, error) {
+	out := new(RedisDumpResponse)
+	err := c.cc.Invoke(ctx, Redis_RedisDump_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redisClient) RedisListDumps(ctx context.Context, in *RedisListDumpsRequest, opts ...grpc.CallOption) (*RedisListDumpsResponse, error) {
+	out := new(RedisListDumpsResponse)
+	err := c.cc.Invoke(ctx, Redis_RedisListDumps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redisClient) RedisDeleteDump(ctx context.Context, in *RedisDeleteDumpRequest, opts ...grpc.CallOption) (*RedisCommonResponse, error) {
+	out := new(RedisCommonResponse)
+	err := c.cc.Invoke(ctx, Redis_RedisDeleteDump_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redisClient) RedisRestoreDump(ctx context.Context, in *RedisRestoreDumpRequest, opts ...grpc.CallOption) (*RedisDumpResponse, error) {
+	out := new(RedisDumpResponse)
+	err := c.cc.Invoke(ctx, Redis_RedisRestoreDump_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redisClient) RedisGetDumpSchedule(ctx context.Context, in *RedisGetDumpScheduleRequest, opts ...grpc.CallOption) (*RedisGetDumpScheduleResponse, error) {
+	out := new(RedisGetDumpScheduleResponse)
+	err := c.cc.Invoke(ctx, Redis_RedisGetDumpSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redisClient) RedisSetDumpSchedule(ctx context.Context, in *RedisSetDumpScheduleRequest, opts ...grpc.CallOption) (*RedisCommonResponse, error) {
+	out := new(RedisCommonResponse)
+	err := c.cc.Invoke(ctx, Redis_RedisSetDumpSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redisClient) RedisInfo(ctx context.Context, in *RedisInfoRequest, opts ...grpc.CallOption) (*RedisInfoResponse, error) {
+	out := new(RedisInfoResponse)
+	err := c.cc.Invoke(ctx, Redis_RedisInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redisClient) RedisKeyspace(ctx context.Context, in *RedisKeyspaceRequest, opts ...grpc.CallOption) (*RedisKeyspaceResponse, error) {
+	out := new(RedisKeyspaceResponse)
+	err := c.cc.Invoke(ctx, Redis_RedisKeyspace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redisClient) RedisScanKeys(ctx context.Context, in *RedisScanKeysRequest, opts ...grpc.CallOption) (*RedisScanKeysResponse, error) {
+	out := new(RedisScanKeysResponse)
+	err := c.cc.Invoke(ctx, Redis_RedisScanKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redisClient) RedisGetKey(ctx context.Context, in *RedisGetKeyRequest, opts ...grpc.CallOption) (*RedisGetKeyResponse, error) {
+	out := new(RedisGetKeyResponse)
+	err := c.cc.Invoke(ctx, Redis_RedisGetKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redisClient) RedisSetTTL(ctx context.Context, in *RedisSetTTLRequest, opts ...grpc.CallOption) (*RedisCommonResponse, error) {
+	out := new(RedisCommonResponse)
+	err := c.cc.Invoke(ctx, Redis_RedisSetTTL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redisClient) RedisSlowlog(ctx context.Context, in *RedisSlowlogRequest, opts ...grpc.CallOption) (*RedisSlowlogResponse, error) {
+	out := new(RedisSlowlogResponse)
+	err := c.cc.Invoke(ctx, Redis_RedisSlowlog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redisClient) RedisClients(ctx context.Context, in *RedisClientsRequest, opts ...grpc.CallOption) (*RedisClientsResponse, error) {
+	out := new(RedisClientsResponse)
+	err := c.cc.Invoke(ctx, Redis_RedisClients_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redisClient) RedisKillClient(ctx context.Context, in *RedisKillClientRequest, opts ...grpc.CallOption) (*RedisCommonResponse, error) {
+	out := new(RedisCommonResponse)
+	err := c.cc.Invoke(ctx, Redis_RedisKillClient_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redisClient) RedisBigKeys(ctx context.Context, in *RedisBigKeysRequest, opts ...grpc.CallOption) (*RedisBigKeysResponse, error) {
+	out := new(RedisBigKeysResponse)
+	err := c.cc.Invoke(ctx, Redis_RedisBigKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RedisServer is the server API for Redis service.
 // All implementations must embed UnimplementedRedisServer
 // for forward compatibility
@@ -147,6 +312,21 @@ type RedisServer interface {
 	RedisSetRemoteAccess(context.Context, *RedisSetRemoteAccessRequest) (*RedisCommonResponse, error)
 	RedisGetRootPassword(context.Context, *RedisGetRootPasswordRequest) (*RedisGetRootPasswordResponse, error)
 	RedisSetRootPassword(context.Context, *RedisSetRootPasswordRequest) (*RedisCommonResponse, error)
+	RedisDump(context.Context, *RedisDumpRequest) (*RedisDumpResponse, error)
+	RedisListDumps(context.Context, *RedisListDumpsRequest) (*RedisListDumpsResponse, error)
+	RedisDeleteDump(context.Context, *RedisDeleteDumpRequest) (*RedisCommonResponse, error)
+	RedisRestoreDump(context.Context, *RedisRestoreDumpRequest) (*RedisDumpResponse, error)
+	RedisGetDumpSchedule(context.Context, *RedisGetDumpScheduleRequest) (*RedisGetDumpScheduleResponse, error)
+	RedisSetDumpSchedule(context.Context, *RedisSetDumpScheduleRequest) (*RedisCommonResponse, error)
+	RedisInfo(context.Context, *RedisInfoRequest) (*RedisInfoResponse, error)
+	RedisKeyspace(context.Context, *RedisKeyspaceRequest) (*RedisKeyspaceResponse, error)
+	RedisScanKeys(context.Context, *RedisScanKeysRequest) (*RedisScanKeysResponse, error)
+	RedisGetKey(context.Context, *RedisGetKeyRequest) (*RedisGetKeyResponse, error)
+	RedisSetTTL(context.Context, *RedisSetTTLRequest) (*RedisCommonResponse, error)
+	RedisSlowlog(context.Context, *RedisSlowlogRequest) (*RedisSlowlogResponse, error)
+	RedisClients(context.Context, *RedisClientsRequest) (*RedisClientsResponse, error)
+	RedisKillClient(context.Context, *RedisKillClientRequest) (*RedisCommonResponse, error)
+	RedisBigKeys(context.Context, *RedisBigKeysRequest) (*RedisBigKeysResponse, error)
 	mustEmbedUnimplementedRedisServer()
 }
 
@@ -181,6 +361,51 @@ func (UnimplementedRedisServer) RedisGetRootPassword(context.Context, *RedisGetR
 func (UnimplementedRedisServer) RedisSetRootPassword(context.Context, *RedisSetRootPasswordRequest) (*RedisCommonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RedisSetRootPassword not implemented")
 }
+func (UnimplementedRedisServer) RedisDump(context.Context, *RedisDumpRequest) (*RedisDumpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedisDump not implemented")
+}
+func (UnimplementedRedisServer) RedisListDumps(context.Context, *RedisListDumpsRequest) (*RedisListDumpsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedisListDumps not implemented")
+}
+func (UnimplementedRedisServer) RedisDeleteDump(context.Context, *RedisDeleteDumpRequest) (*RedisCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedisDeleteDump not implemented")
+}
+func (UnimplementedRedisServer) RedisRestoreDump(context.Context, *RedisRestoreDumpRequest) (*RedisDumpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedisRestoreDump not implemented")
+}
+func (UnimplementedRedisServer) RedisGetDumpSchedule(context.Context, *RedisGetDumpScheduleRequest) (*RedisGetDumpScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedisGetDumpSchedule not implemented")
+}
+func (UnimplementedRedisServer) RedisSetDumpSchedule(context.Context, *RedisSetDumpScheduleRequest) (*RedisCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedisSetDumpSchedule not implemented")
+}
+func (UnimplementedRedisServer) RedisInfo(context.Context, *RedisInfoRequest) (*RedisInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedisInfo not implemented")
+}
+func (UnimplementedRedisServer) RedisKeyspace(context.Context, *RedisKeyspaceRequest) (*RedisKeyspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedisKeyspace not implemented")
+}
+func (UnimplementedRedisServer) RedisScanKeys(context.Context, *RedisScanKeysRequest) (*RedisScanKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedisScanKeys not implemented")
+}
+func (UnimplementedRedisServer) RedisGetKey(context.Context, *RedisGetKeyRequest) (*RedisGetKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedisGetKey not implemented")
+}
+func (UnimplementedRedisServer) RedisSetTTL(context.Context, *RedisSetTTLRequest) (*RedisCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedisSetTTL not implemented")
+}
+func (UnimplementedRedisServer) RedisSlowlog(context.Context, *RedisSlowlogRequest) (*RedisSlowlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedisSlowlog not implemented")
+}
+func (UnimplementedRedisServer) RedisClients(context.Context, *RedisClientsRequest) (*RedisClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedisClients not implemented")
+}
+func (UnimplementedRedisServer) RedisKillClient(context.Context, *RedisKillClientRequest) (*RedisCommonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedisKillClient not implemented")
+}
+func (UnimplementedRedisServer) RedisBigKeys(context.Context, *RedisBigKeysRequest) (*RedisBigKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedisBigKeys not implemented")
+}
 func (UnimplementedRedisServer) mustEmbedUnimplementedRedisServer() {}
 
 // UnsafeRedisServer may be embedded to opt out of forward compatibility for this service.
@@ -356,6 +581,276 @@ func _Redis_RedisSetRootPassword_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Redis_RedisDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedisDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedisServer).RedisDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Redis_RedisDump_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedisServer).RedisDump(ctx, req.(*RedisDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Redis_RedisListDumps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedisListDumpsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedisServer).RedisListDumps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Redis_RedisListDumps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedisServer).RedisListDumps(ctx, req.(*RedisListDumpsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Redis_RedisDeleteDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedisDeleteDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedisServer).RedisDeleteDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Redis_RedisDeleteDump_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedisServer).RedisDeleteDump(ctx, req.(*RedisDeleteDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Redis_RedisRestoreDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedisRestoreDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedisServer).RedisRestoreDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Redis_RedisRestoreDump_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedisServer).RedisRestoreDump(ctx, req.(*RedisRestoreDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Redis_RedisGetDumpSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedisGetDumpScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedisServer).RedisGetDumpSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Redis_RedisGetDumpSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedisServer).RedisGetDumpSchedule(ctx, req.(*RedisGetDumpScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Redis_RedisSetDumpSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedisSetDumpScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedisServer).RedisSetDumpSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Redis_RedisSetDumpSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedisServer).RedisSetDumpSchedule(ctx, req.(*RedisSetDumpScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Redis_RedisInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedisInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedisServer).RedisInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Redis_RedisInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedisServer).RedisInfo(ctx, req.(*RedisInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Redis_RedisKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedisKeyspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedisServer).RedisKeyspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Redis_RedisKeyspace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedisServer).RedisKeyspace(ctx, req.(*RedisKeyspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Redis_RedisScanKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedisScanKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedisServer).RedisScanKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Redis_RedisScanKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedisServer).RedisScanKeys(ctx, req.(*RedisScanKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Redis_RedisGetKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedisGetKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedisServer).RedisGetKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Redis_RedisGetKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedisServer).RedisGetKey(ctx, req.(*RedisGetKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Redis_RedisSetTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedisSetTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedisServer).RedisSetTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Redis_RedisSetTTL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedisServer).RedisSetTTL(ctx, req.(*RedisSetTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Redis_RedisSlowlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedisSlowlogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedisServer).RedisSlowlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Redis_RedisSlowlog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedisServer).RedisSlowlog(ctx, req.(*RedisSlowlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Redis_RedisClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedisClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedisServer).RedisClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Redis_RedisClients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedisServer).RedisClients(ctx, req.(*RedisClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Redis_RedisKillClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedisKillClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedisServer).RedisKillClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Redis_RedisKillClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedisServer).RedisKillClient(ctx, req.(*RedisKillClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Redis_RedisBigKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedisBigKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedisServer).RedisBigKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Redis_RedisBigKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedisServer).RedisBigKeys(ctx, req.(*RedisBigKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Redis_ServiceDesc is the grpc.ServiceDesc for Redis service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -399,6 +894,66 @@ var Redis_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RedisSetRootPassword",
 			Handler:    _Redis_RedisSetRootPassword_Handler,
 		},
+		{
+			MethodName: "RedisDump",
+			Handler:    _Redis_RedisDump_Handler,
+		},
+		{
+			MethodName: "RedisListDumps",
+			Handler:    _Redis_RedisListDumps_Handler,
+		},
+		{
+			MethodName: "RedisDeleteDump",
+			Handler:    _Redis_RedisDeleteDump_Handler,
+		},
+		{
+			MethodName: "RedisRestoreDump",
+			Handler:    _Redis_RedisRestoreDump_Handler,
+		},
+		{
+			MethodName: "RedisGetDumpSchedule",
+			Handler:    _Redis_RedisGetDumpSchedule_Handler,
+		},
+		{
+			MethodName: "RedisSetDumpSchedule",
+			Handler:    _Redis_RedisSetDumpSchedule_Handler,
+		},
+		{
+			MethodName: "RedisInfo",
+			Handler:    _Redis_RedisInfo_Handler,
+		},
+		{
+			MethodName: "RedisKeyspace",
+			Handler:    _Redis_RedisKeyspace_Handler,
+		},
+		{
+			MethodName: "RedisScanKeys",
+			Handler:    _Redis_RedisScanKeys_Handler,
+		},
+		{
+			MethodName: "RedisGetKey",
+			Handler:    _Redis_RedisGetKey_Handler,
+		},
+		{
+			MethodName: "RedisSetTTL",
+			Handler:    _Redis_RedisSetTTL_Handler,
+		},
+		{
+			MethodName: "RedisSlowlog",
+			Handler:    _Redis_RedisSlowlog_Handler,
+		},
+		{
+			MethodName: "RedisClients",
+			Handler:    _Redis_RedisClients_Handler,
+		},
+		{
+			MethodName: "RedisKillClient",
+			Handler:    _Redis_RedisKillClient_Handler,
+		},
+		{
+			MethodName: "RedisBigKeys",
+			Handler:    _Redis_RedisBigKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "redis.proto",
@@ -26,6 +26,7 @@ type IPluginService interface {
 	Start() error
 	Stop() error
 	GetPlugin(name string) (*PluginInstance, error)
+	Health() map[string]bool
 }
 
 type PluginServer struct {
@@ -89,6 +90,24 @@ func (s *PluginServer) GetPlugin(name string) (*PluginInstance, error) {
 	return nil, fmt.Errorf("plugin %s not loaded", name)
 }
 
+// Health 返回已启用插件的进程是否存活，未加载的插件视为异常
+func (s *PluginServer) Health() map[string]bool {
+	health := make(map[string]bool)
+	if s.registry == nil {
+		return health
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, entry := range s.registry.Plugins {
+		if !entry.Enabled {
+			continue
+		}
+		p, ok := s.plugins[entry.Name]
+		health[entry.Name] = ok && !p.Client.Exited()
+	}
+	return health
+}
+
 func loadRegistry(data []byte) (*Registry, error) {
 	var reg Registry
 	if err := yaml.Unmarshal(data, &reg); err != nil {
@@ -168,6 +187,7 @@ func (s *PluginServer) loadPlugins() {
 		WorkDir:  constant.CenterBinDir,
 		WorkHost: defaultHost.ID,
 		AppDir:   constant.AgentDockerDir,
+		Token:    global.InternalToken,
 	}
 
 	// 转成 JSON
@@ -182,7 +202,7 @@ func (s *PluginServer) loadPlugins() {
 
 	// 写入临时文件
 	tmpFile := "/tmp/plugin_boot_config"
-	if err := os.WriteFile(tmpFile, []byte(encoded), 0644); err != nil {
+	if err := os.WriteFile(tmpFile, []byte(encoded), 0600); err != nil {
 		global.LOG.Error("failed to write config file: %v", err)
 		return
 	}
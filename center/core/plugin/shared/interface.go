@@ -29,4 +29,5 @@ type PluginInitConfig struct {
 	WorkDir  string `json:"work_dir"`
 	WorkHost uint   `json:"work_host"`
 	AppDir   string `json:"app_dir"`
+	Token    string `json:"token"` // 回调 API 时放入 Idb-Internal-Token 请求头
 }
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/go-plugin"
 	"github.com/sensdata/idb/center/core/plugin/proto"
@@ -20,6 +21,23 @@ type MysqlManager interface {
 	GetRootPassword(hostID uint64, req model.GetRootPasswordRequest) (*model.GetRootPasswordResponse, error)
 	SetRootPassword(hostID uint64, req model.SetRootPasswordRequest) error
 	GetConnectionInfo(hostID uint64, req model.GetConnectionInfoRequest) (*model.GetConnectionInfoResponse, error)
+	Dump(hostID uint64, req model.DumpRequest) (*model.DumpTaskResponse, error)
+	ListDumps(hostID uint64, req model.ListDumpsRequest) (*model.ListDumpsResponse, error)
+	DeleteDump(hostID uint64, req model.DeleteDumpRequest) error
+	RestoreDump(hostID uint64, req model.RestoreDumpRequest) (*model.DumpTaskResponse, error)
+	GetDumpSchedule(hostID uint64, req model.GetDumpScheduleRequest) (*model.DumpSchedule, error)
+	SetDumpSchedule(hostID uint64, req model.DumpSchedule) error
+	ListDatabases(hostID uint64, req model.ListDatabasesRequest) (*model.ListDatabasesResponse, error)
+	CreateDatabase(hostID uint64, req model.CreateDatabaseRequest) error
+	DropDatabase(hostID uint64, req model.DropDatabaseRequest) error
+	ListUsers(hostID uint64, req model.ListDatabaseUsersRequest) (*model.ListDatabaseUsersResponse, error)
+	CreateUser(hostID uint64, req model.CreateDatabaseUserRequest) error
+	DropUser(hostID uint64, req model.DropDatabaseUserRequest) error
+	GrantPrivileges(hostID uint64, req model.DatabasePrivilegesRequest) error
+	RevokePrivileges(hostID uint64, req model.DatabasePrivilegesRequest) error
+	SetUserPassword(hostID uint64, req model.SetDatabaseUserPasswordRequest) error
+	ListConnections(hostID uint64, req model.ListDatabaseConnectionsRequest) (*model.ListDatabaseConnectionsResponse, error)
+	GetSlowLog(hostID uint64, req model.GetSlowLogRequest) (*model.GetSlowLogResponse, error)
 }
 
 type MysqlManagerPlugin struct {
@@ -185,6 +203,299 @@ func (c *MysqlManagerGRPCClient) GetConnectionInfo(hostID uint64, req model.GetC
 	}, nil
 }
 
+func (c *MysqlManagerGRPCClient) Dump(hostID uint64, req model.DumpRequest) (*model.DumpTaskResponse, error) {
+	resp, err := c.client.Dump(context.Background(), &proto.DumpRequest{
+		HostId:   uint32(hostID),
+		Name:     req.Name,
+		Database: req.Database,
+		Compress: req.Compress,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.DumpTaskResponse{
+		LogPath: resp.LogPath,
+	}, nil
+}
+
+func (c *MysqlManagerGRPCClient) ListDumps(hostID uint64, req model.ListDumpsRequest) (*model.ListDumpsResponse, error) {
+	resp, err := c.client.ListDumps(context.Background(), &proto.ListDumpsRequest{
+		HostId:   uint32(hostID),
+		Name:     req.Name,
+		Page:     int32(req.Page),
+		PageSize: int32(req.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := model.ListDumpsResponse{Total: int(resp.Total), Items: []*model.DumpInfo{}}
+	for _, item := range resp.Items {
+		result.Items = append(result.Items, &model.DumpInfo{
+			File:       item.File,
+			Database:   item.Database,
+			Size:       item.Size,
+			Compressed: item.Compressed,
+			CreatedAt:  time.Unix(item.CreatedAt, 0),
+		})
+	}
+	return &result, nil
+}
+
+func (c *MysqlManagerGRPCClient) DeleteDump(hostID uint64, req model.DeleteDumpRequest) error {
+	_, err := c.client.DeleteDump(context.Background(), &proto.DeleteDumpRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+		File:   req.File,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *MysqlManagerGRPCClient) RestoreDump(hostID uint64, req model.RestoreDumpRequest) (*model.DumpTaskResponse, error) {
+	resp, err := c.client.RestoreDump(context.Background(), &proto.RestoreDumpRequest{
+		HostId:         uint32(hostID),
+		Name:           req.Name,
+		File:           req.File,
+		Database:       req.Database,
+		CreateDatabase: req.CreateDatabase,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.DumpTaskResponse{
+		LogPath: resp.LogPath,
+	}, nil
+}
+
+func (c *MysqlManagerGRPCClient) GetDumpSchedule(hostID uint64, req model.GetDumpScheduleRequest) (*model.DumpSchedule, error) {
+	resp, err := c.client.GetDumpSchedule(context.Background(), &proto.GetDumpScheduleRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.DumpSchedule{
+		Name:      req.Name,
+		Enabled:   resp.Enabled,
+		Schedule:  resp.Schedule,
+		Databases: resp.Databases,
+		Compress:  resp.Compress,
+		Retention: int(resp.Retention),
+	}, nil
+}
+
+func (c *MysqlManagerGRPCClient) SetDumpSchedule(hostID uint64, req model.DumpSchedule) error {
+	_, err := c.client.SetDumpSchedule(context.Background(), &proto.SetDumpScheduleRequest{
+		HostId:    uint32(hostID),
+		Name:      req.Name,
+		Enabled:   req.Enabled,
+		Schedule:  req.Schedule,
+		Databases: req.Databases,
+		Compress:  req.Compress,
+		Retention: int32(req.Retention),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *MysqlManagerGRPCClient) ListDatabases(hostID uint64, req model.ListDatabasesRequest) (*model.ListDatabasesResponse, error) {
+	resp, err := c.client.ListDatabases(context.Background(), &proto.ListDatabasesRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := model.ListDatabasesResponse{Total: int(resp.Total), Items: []*model.DatabaseInfo{}}
+	for _, item := range resp.Items {
+		result.Items = append(result.Items, &model.DatabaseInfo{
+			Name:      item.Name,
+			Charset:   item.Charset,
+			Collation: item.Collation,
+			Owner:     item.Owner,
+			Size:      item.Size,
+		})
+	}
+	return &result, nil
+}
+
+func (c *MysqlManagerGRPCClient) CreateDatabase(hostID uint64, req model.CreateDatabaseRequest) error {
+	_, err := c.client.CreateDatabase(context.Background(), &proto.CreateDatabaseRequest{
+		HostId:    uint32(hostID),
+		Name:      req.Name,
+		Database:  req.Database,
+		Charset:   req.Charset,
+		Collation: req.Collation,
+		Owner:     req.Owner,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *MysqlManagerGRPCClient) DropDatabase(hostID uint64, req model.DropDatabaseRequest) error {
+	_, err := c.client.DropDatabase(context.Background(), &proto.DropDatabaseRequest{
+		HostId:   uint32(hostID),
+		Name:     req.Name,
+		Database: req.Database,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *MysqlManagerGRPCClient) ListUsers(hostID uint64, req model.ListDatabaseUsersRequest) (*model.ListDatabaseUsersResponse, error) {
+	resp, err := c.client.ListUsers(context.Background(), &proto.ListUsersRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := model.ListDatabaseUsersResponse{Total: int(resp.Total), Items: []*model.DatabaseUser{}}
+	for _, item := range resp.Items {
+		user := &model.DatabaseUser{
+			User:   item.User,
+			Host:   item.Host,
+			Grants: []*model.DatabaseGrant{},
+		}
+		for _, grant := range item.Grants {
+			user.Grants = append(user.Grants, &model.DatabaseGrant{
+				Database:   grant.Database,
+				Privileges: grant.Privileges,
+			})
+		}
+		result.Items = append(result.Items, user)
+	}
+	return &result, nil
+}
+
+func (c *MysqlManagerGRPCClient) CreateUser(hostID uint64, req model.CreateDatabaseUserRequest) error {
+	var grants []*proto.DatabaseGrant
+	for _, grant := range req.Grants {
+		grants = append(grants, &proto.DatabaseGrant{
+			Database:   grant.Database,
+			Privileges: grant.Privileges,
+		})
+	}
+	_, err := c.client.CreateUser(context.Background(), &proto.CreateUserRequest{
+		HostId:   uint32(hostID),
+		Name:     req.Name,
+		User:     req.User,
+		Host:     req.Host,
+		Password: req.Password,
+		Grants:   grants,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *MysqlManagerGRPCClient) DropUser(hostID uint64, req model.DropDatabaseUserRequest) error {
+	_, err := c.client.DropUser(context.Background(), &proto.DropUserRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+		User:   req.User,
+		Host:   req.Host,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *MysqlManagerGRPCClient) GrantPrivileges(hostID uint64, req model.DatabasePrivilegesRequest) error {
+	_, err := c.client.GrantPrivileges(context.Background(), &proto.PrivilegesRequest{
+		HostId:     uint32(hostID),
+		Name:       req.Name,
+		User:       req.User,
+		Host:       req.Host,
+		Database:   req.Database,
+		Privileges: req.Privileges,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *MysqlManagerGRPCClient) RevokePrivileges(hostID uint64, req model.DatabasePrivilegesRequest) error {
+	_, err := c.client.RevokePrivileges(context.Background(), &proto.PrivilegesRequest{
+		HostId:     uint32(hostID),
+		Name:       req.Name,
+		User:       req.User,
+		Host:       req.Host,
+		Database:   req.Database,
+		Privileges: req.Privileges,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *MysqlManagerGRPCClient) SetUserPassword(hostID uint64, req model.SetDatabaseUserPasswordRequest) error {
+	_, err := c.client.SetUserPassword(context.Background(), &proto.SetUserPasswordRequest{
+		HostId:   uint32(hostID),
+		Name:     req.Name,
+		User:     req.User,
+		Host:     req.Host,
+		Password: req.Password,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *MysqlManagerGRPCClient) ListConnections(hostID uint64, req model.ListDatabaseConnectionsRequest) (*model.ListDatabaseConnectionsResponse, error) {
+	resp, err := c.client.ListConnections(context.Background(), &proto.ListConnectionsRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := model.ListDatabaseConnectionsResponse{Total: int(resp.Total), Items: []*model.DatabaseConnection{}}
+	for _, item := range resp.Items {
+		result.Items = append(result.Items, &model.DatabaseConnection{
+			ID:       item.Id,
+			User:     item.User,
+			Host:     item.Host,
+			Database: item.Database,
+			State:    item.State,
+			Time:     item.Time,
+			Query:    item.Query,
+		})
+	}
+	return &result, nil
+}
+
+func (c *MysqlManagerGRPCClient) GetSlowLog(hostID uint64, req model.GetSlowLogRequest) (*model.GetSlowLogResponse, error) {
+	resp, err := c.client.GetSlowLog(context.Background(), &proto.GetSlowLogRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+		Lines:  int32(req.Lines),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.GetSlowLogResponse{
+		Enabled:   resp.Enabled,
+		Threshold: resp.Threshold,
+		Path:      resp.Path,
+		Content:   resp.Content,
+	}, nil
+}
+
 type MysqlManagerGRPCServer struct {
 	Impl MysqlManager
 	*proto.UnimplementedMysqlManagerServer
@@ -371,3 +682,372 @@ func (s *MysqlManagerGRPCServer) GetConnectionInfo(ctx context.Context, req *pro
 		},
 	}, nil
 }
+
+func (s *MysqlManagerGRPCServer) Dump(ctx context.Context, req *proto.DumpRequest) (*proto.DumpResponse, error) {
+	result, err := s.Impl.Dump(
+		uint64(req.HostId),
+		model.DumpRequest{
+			Name:     req.Name,
+			Database: req.Database,
+			Compress: req.Compress,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.DumpResponse{
+		LogPath: result.LogPath,
+	}, nil
+}
+
+func (s *MysqlManagerGRPCServer) ListDumps(ctx context.Context, req *proto.ListDumpsRequest) (*proto.ListDumpsResponse, error) {
+	var resp proto.ListDumpsResponse
+
+	result, err := s.Impl.ListDumps(
+		uint64(req.HostId),
+		model.ListDumpsRequest{
+			Name:     req.Name,
+			Page:     int(req.Page),
+			PageSize: int(req.PageSize),
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	for _, item := range result.Items {
+		resp.Items = append(resp.Items, &proto.DumpInfo{
+			File:       item.File,
+			Database:   item.Database,
+			Size:       item.Size,
+			Compressed: item.Compressed,
+			CreatedAt:  item.CreatedAt.Unix(),
+		})
+	}
+	resp.Total = int64(result.Total)
+	return &resp, nil
+}
+
+func (s *MysqlManagerGRPCServer) DeleteDump(ctx context.Context, req *proto.DeleteDumpRequest) (*proto.MysqlCommonResponse, error) {
+	var resp proto.MysqlCommonResponse
+
+	err := s.Impl.DeleteDump(
+		uint64(req.HostId),
+		model.DeleteDumpRequest{
+			Name: req.Name,
+			File: req.File,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *MysqlManagerGRPCServer) RestoreDump(ctx context.Context, req *proto.RestoreDumpRequest) (*proto.DumpResponse, error) {
+	result, err := s.Impl.RestoreDump(
+		uint64(req.HostId),
+		model.RestoreDumpRequest{
+			Name:           req.Name,
+			File:           req.File,
+			Database:       req.Database,
+			CreateDatabase: req.CreateDatabase,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.DumpResponse{
+		LogPath: result.LogPath,
+	}, nil
+}
+
+func (s *MysqlManagerGRPCServer) GetDumpSchedule(ctx context.Context, req *proto.GetDumpScheduleRequest) (*proto.GetDumpScheduleResponse, error) {
+	result, err := s.Impl.GetDumpSchedule(
+		uint64(req.HostId),
+		model.GetDumpScheduleRequest{
+			Name: req.Name,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetDumpScheduleResponse{
+		Enabled:   result.Enabled,
+		Schedule:  result.Schedule,
+		Databases: result.Databases,
+		Compress:  result.Compress,
+		Retention: int32(result.Retention),
+	}, nil
+}
+
+func (s *MysqlManagerGRPCServer) SetDumpSchedule(ctx context.Context, req *proto.SetDumpScheduleRequest) (*proto.MysqlCommonResponse, error) {
+	var resp proto.MysqlCommonResponse
+
+	err := s.Impl.SetDumpSchedule(
+		uint64(req.HostId),
+		model.DumpSchedule{
+			Name:      req.Name,
+			Enabled:   req.Enabled,
+			Schedule:  req.Schedule,
+			Databases: req.Databases,
+			Compress:  req.Compress,
+			Retention: int(req.Retention),
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *MysqlManagerGRPCServer) ListDatabases(ctx context.Context, req *proto.ListDatabasesRequest) (*proto.ListDatabasesResponse, error) {
+	var resp proto.ListDatabasesResponse
+
+	result, err := s.Impl.ListDatabases(
+		uint64(req.HostId),
+		model.ListDatabasesRequest{
+			Name: req.Name,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	for _, item := range result.Items {
+		resp.Items = append(resp.Items, &proto.DatabaseInfo{
+			Name:      item.Name,
+			Charset:   item.Charset,
+			Collation: item.Collation,
+			Owner:     item.Owner,
+			Size:      item.Size,
+		})
+	}
+	resp.Total = int64(result.Total)
+	return &resp, nil
+}
+
+func (s *MysqlManagerGRPCServer) CreateDatabase(ctx context.Context, req *proto.CreateDatabaseRequest) (*proto.MysqlCommonResponse, error) {
+	var resp proto.MysqlCommonResponse
+
+	err := s.Impl.CreateDatabase(
+		uint64(req.HostId),
+		model.CreateDatabaseRequest{
+			Name:      req.Name,
+			Database:  req.Database,
+			Charset:   req.Charset,
+			Collation: req.Collation,
+			Owner:     req.Owner,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *MysqlManagerGRPCServer) DropDatabase(ctx context.Context, req *proto.DropDatabaseRequest) (*proto.MysqlCommonResponse, error) {
+	var resp proto.MysqlCommonResponse
+
+	err := s.Impl.DropDatabase(
+		uint64(req.HostId),
+		model.DropDatabaseRequest{
+			Name:     req.Name,
+			Database: req.Database,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *MysqlManagerGRPCServer) ListUsers(ctx context.Context, req *proto.ListUsersRequest) (*proto.ListUsersResponse, error) {
+	var resp proto.ListUsersResponse
+
+	result, err := s.Impl.ListUsers(
+		uint64(req.HostId),
+		model.ListDatabaseUsersRequest{
+			Name: req.Name,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	for _, item := range result.Items {
+		user := &proto.DatabaseUser{
+			User: item.User,
+			Host: item.Host,
+		}
+		for _, grant := range item.Grants {
+			user.Grants = append(user.Grants, &proto.DatabaseGrant{
+				Database:   grant.Database,
+				Privileges: grant.Privileges,
+			})
+		}
+		resp.Items = append(resp.Items, user)
+	}
+	resp.Total = int64(result.Total)
+	return &resp, nil
+}
+
+func (s *MysqlManagerGRPCServer) CreateUser(ctx context.Context, req *proto.CreateUserRequest) (*proto.MysqlCommonResponse, error) {
+	var resp proto.MysqlCommonResponse
+
+	var grants []*model.DatabaseGrant
+	for _, grant := range req.Grants {
+		grants = append(grants, &model.DatabaseGrant{
+			Database:   grant.Database,
+			Privileges: grant.Privileges,
+		})
+	}
+	err := s.Impl.CreateUser(
+		uint64(req.HostId),
+		model.CreateDatabaseUserRequest{
+			Name:     req.Name,
+			User:     req.User,
+			Host:     req.Host,
+			Password: req.Password,
+			Grants:   grants,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *MysqlManagerGRPCServer) DropUser(ctx context.Context, req *proto.DropUserRequest) (*proto.MysqlCommonResponse, error) {
+	var resp proto.MysqlCommonResponse
+
+	err := s.Impl.DropUser(
+		uint64(req.HostId),
+		model.DropDatabaseUserRequest{
+			Name: req.Name,
+			User: req.User,
+			Host: req.Host,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *MysqlManagerGRPCServer) GrantPrivileges(ctx context.Context, req *proto.PrivilegesRequest) (*proto.MysqlCommonResponse, error) {
+	var resp proto.MysqlCommonResponse
+
+	err := s.Impl.GrantPrivileges(
+		uint64(req.HostId),
+		model.DatabasePrivilegesRequest{
+			Name:       req.Name,
+			User:       req.User,
+			Host:       req.Host,
+			Database:   req.Database,
+			Privileges: req.Privileges,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *MysqlManagerGRPCServer) RevokePrivileges(ctx context.Context, req *proto.PrivilegesRequest) (*proto.MysqlCommonResponse, error) {
+	var resp proto.MysqlCommonResponse
+
+	err := s.Impl.RevokePrivileges(
+		uint64(req.HostId),
+		model.DatabasePrivilegesRequest{
+			Name:       req.Name,
+			User:       req.User,
+			Host:       req.Host,
+			Database:   req.Database,
+			Privileges: req.Privileges,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *MysqlManagerGRPCServer) SetUserPassword(ctx context.Context, req *proto.SetUserPasswordRequest) (*proto.MysqlCommonResponse, error) {
+	var resp proto.MysqlCommonResponse
+
+	err := s.Impl.SetUserPassword(
+		uint64(req.HostId),
+		model.SetDatabaseUserPasswordRequest{
+			Name:     req.Name,
+			User:     req.User,
+			Host:     req.Host,
+			Password: req.Password,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *MysqlManagerGRPCServer) ListConnections(ctx context.Context, req *proto.ListConnectionsRequest) (*proto.ListConnectionsResponse, error) {
+	var resp proto.ListConnectionsResponse
+
+	result, err := s.Impl.ListConnections(
+		uint64(req.HostId),
+		model.ListDatabaseConnectionsRequest{
+			Name: req.Name,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	for _, item := range result.Items {
+		resp.Items = append(resp.Items, &proto.DBConnection{
+			Id:       item.ID,
+			User:     item.User,
+			Host:     item.Host,
+			Database: item.Database,
+			State:    item.State,
+			Time:     item.Time,
+			Query:    item.Query,
+		})
+	}
+	resp.Total = int64(result.Total)
+	return &resp, nil
+}
+
+func (s *MysqlManagerGRPCServer) GetSlowLog(ctx context.Context, req *proto.GetSlowLogRequest) (*proto.GetSlowLogResponse, error) {
+	result, err := s.Impl.GetSlowLog(
+		uint64(req.HostId),
+		model.GetSlowLogRequest{
+			Name:  req.Name,
+			Lines: int(req.Lines),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetSlowLogResponse{
+		Enabled:   result.Enabled,
+		Threshold: result.Threshold,
+		Path:      result.Path,
+		Content:   result.Content,
+	}, nil
+}
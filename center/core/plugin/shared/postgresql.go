@@ -2,6 +2,7 @@ package shared
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/go-plugin"
 	"github.com/sensdata/idb/center/core/plugin/proto"
@@ -15,6 +16,23 @@ type PostgreSql interface {
 	SetPort(hostID uint64, req model.SetPortRequest) error
 	GetConf(hostID uint64, req model.GetConfRequest) (*model.GetConfResponse, error)
 	SetConf(hostID uint64, req model.SetConfRequest) error
+	Dump(hostID uint64, req model.DumpRequest) (*model.DumpTaskResponse, error)
+	ListDumps(hostID uint64, req model.ListDumpsRequest) (*model.ListDumpsResponse, error)
+	DeleteDump(hostID uint64, req model.DeleteDumpRequest) error
+	RestoreDump(hostID uint64, req model.RestoreDumpRequest) (*model.DumpTaskResponse, error)
+	GetDumpSchedule(hostID uint64, req model.GetDumpScheduleRequest) (*model.DumpSchedule, error)
+	SetDumpSchedule(hostID uint64, req model.DumpSchedule) error
+	ListDatabases(hostID uint64, req model.ListDatabasesRequest) (*model.ListDatabasesResponse, error)
+	CreateDatabase(hostID uint64, req model.CreateDatabaseRequest) error
+	DropDatabase(hostID uint64, req model.DropDatabaseRequest) error
+	ListUsers(hostID uint64, req model.ListDatabaseUsersRequest) (*model.ListDatabaseUsersResponse, error)
+	CreateUser(hostID uint64, req model.CreateDatabaseUserRequest) error
+	DropUser(hostID uint64, req model.DropDatabaseUserRequest) error
+	GrantPrivileges(hostID uint64, req model.DatabasePrivilegesRequest) error
+	RevokePrivileges(hostID uint64, req model.DatabasePrivilegesRequest) error
+	SetUserPassword(hostID uint64, req model.SetDatabaseUserPasswordRequest) error
+	ListConnections(hostID uint64, req model.ListDatabaseConnectionsRequest) (*model.ListDatabaseConnectionsResponse, error)
+	GetSlowLog(hostID uint64, req model.GetSlowLogRequest) (*model.GetSlowLogResponse, error)
 }
 
 type PostgreSqlPlugin struct {
@@ -110,6 +128,299 @@ func (c *PostgreSqlGRPCClient) SetConf(hostID uint64, req model.SetConfRequest)
 	return nil
 }
 
+func (c *PostgreSqlGRPCClient) Dump(hostID uint64, req model.DumpRequest) (*model.DumpTaskResponse, error) {
+	resp, err := c.client.PGDump(context.Background(), &proto.PGDumpRequest{
+		HostId:   uint32(hostID),
+		Name:     req.Name,
+		Database: req.Database,
+		Compress: req.Compress,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.DumpTaskResponse{
+		LogPath: resp.LogPath,
+	}, nil
+}
+
+func (c *PostgreSqlGRPCClient) ListDumps(hostID uint64, req model.ListDumpsRequest) (*model.ListDumpsResponse, error) {
+	resp, err := c.client.PGListDumps(context.Background(), &proto.PGListDumpsRequest{
+		HostId:   uint32(hostID),
+		Name:     req.Name,
+		Page:     int32(req.Page),
+		PageSize: int32(req.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := model.ListDumpsResponse{Total: int(resp.Total), Items: []*model.DumpInfo{}}
+	for _, item := range resp.Items {
+		result.Items = append(result.Items, &model.DumpInfo{
+			File:       item.File,
+			Database:   item.Database,
+			Size:       item.Size,
+			Compressed: item.Compressed,
+			CreatedAt:  time.Unix(item.CreatedAt, 0),
+		})
+	}
+	return &result, nil
+}
+
+func (c *PostgreSqlGRPCClient) DeleteDump(hostID uint64, req model.DeleteDumpRequest) error {
+	_, err := c.client.PGDeleteDump(context.Background(), &proto.PGDeleteDumpRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+		File:   req.File,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *PostgreSqlGRPCClient) RestoreDump(hostID uint64, req model.RestoreDumpRequest) (*model.DumpTaskResponse, error) {
+	resp, err := c.client.PGRestoreDump(context.Background(), &proto.PGRestoreDumpRequest{
+		HostId:         uint32(hostID),
+		Name:           req.Name,
+		File:           req.File,
+		Database:       req.Database,
+		CreateDatabase: req.CreateDatabase,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.DumpTaskResponse{
+		LogPath: resp.LogPath,
+	}, nil
+}
+
+func (c *PostgreSqlGRPCClient) GetDumpSchedule(hostID uint64, req model.GetDumpScheduleRequest) (*model.DumpSchedule, error) {
+	resp, err := c.client.PGGetDumpSchedule(context.Background(), &proto.PGGetDumpScheduleRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.DumpSchedule{
+		Name:      req.Name,
+		Enabled:   resp.Enabled,
+		Schedule:  resp.Schedule,
+		Databases: resp.Databases,
+		Compress:  resp.Compress,
+		Retention: int(resp.Retention),
+	}, nil
+}
+
+func (c *PostgreSqlGRPCClient) SetDumpSchedule(hostID uint64, req model.DumpSchedule) error {
+	_, err := c.client.PGSetDumpSchedule(context.Background(), &proto.PGSetDumpScheduleRequest{
+		HostId:    uint32(hostID),
+		Name:      req.Name,
+		Enabled:   req.Enabled,
+		Schedule:  req.Schedule,
+		Databases: req.Databases,
+		Compress:  req.Compress,
+		Retention: int32(req.Retention),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *PostgreSqlGRPCClient) ListDatabases(hostID uint64, req model.ListDatabasesRequest) (*model.ListDatabasesResponse, error) {
+	resp, err := c.client.PGListDatabases(context.Background(), &proto.PGListDatabasesRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := model.ListDatabasesResponse{Total: int(resp.Total), Items: []*model.DatabaseInfo{}}
+	for _, item := range resp.Items {
+		result.Items = append(result.Items, &model.DatabaseInfo{
+			Name:      item.Name,
+			Charset:   item.Charset,
+			Collation: item.Collation,
+			Owner:     item.Owner,
+			Size:      item.Size,
+		})
+	}
+	return &result, nil
+}
+
+func (c *PostgreSqlGRPCClient) CreateDatabase(hostID uint64, req model.CreateDatabaseRequest) error {
+	_, err := c.client.PGCreateDatabase(context.Background(), &proto.PGCreateDatabaseRequest{
+		HostId:    uint32(hostID),
+		Name:      req.Name,
+		Database:  req.Database,
+		Charset:   req.Charset,
+		Collation: req.Collation,
+		Owner:     req.Owner,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *PostgreSqlGRPCClient) DropDatabase(hostID uint64, req model.DropDatabaseRequest) error {
+	_, err := c.client.PGDropDatabase(context.Background(), &proto.PGDropDatabaseRequest{
+		HostId:   uint32(hostID),
+		Name:     req.Name,
+		Database: req.Database,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *PostgreSqlGRPCClient) ListUsers(hostID uint64, req model.ListDatabaseUsersRequest) (*model.ListDatabaseUsersResponse, error) {
+	resp, err := c.client.PGListUsers(context.Background(), &proto.PGListUsersRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := model.ListDatabaseUsersResponse{Total: int(resp.Total), Items: []*model.DatabaseUser{}}
+	for _, item := range resp.Items {
+		user := &model.DatabaseUser{
+			User:   item.User,
+			Host:   item.Host,
+			Grants: []*model.DatabaseGrant{},
+		}
+		for _, grant := range item.Grants {
+			user.Grants = append(user.Grants, &model.DatabaseGrant{
+				Database:   grant.Database,
+				Privileges: grant.Privileges,
+			})
+		}
+		result.Items = append(result.Items, user)
+	}
+	return &result, nil
+}
+
+func (c *PostgreSqlGRPCClient) CreateUser(hostID uint64, req model.CreateDatabaseUserRequest) error {
+	var grants []*proto.PGDatabaseGrant
+	for _, grant := range req.Grants {
+		grants = append(grants, &proto.PGDatabaseGrant{
+			Database:   grant.Database,
+			Privileges: grant.Privileges,
+		})
+	}
+	_, err := c.client.PGCreateUser(context.Background(), &proto.PGCreateUserRequest{
+		HostId:   uint32(hostID),
+		Name:     req.Name,
+		User:     req.User,
+		Host:     req.Host,
+		Password: req.Password,
+		Grants:   grants,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *PostgreSqlGRPCClient) DropUser(hostID uint64, req model.DropDatabaseUserRequest) error {
+	_, err := c.client.PGDropUser(context.Background(), &proto.PGDropUserRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+		User:   req.User,
+		Host:   req.Host,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *PostgreSqlGRPCClient) GrantPrivileges(hostID uint64, req model.DatabasePrivilegesRequest) error {
+	_, err := c.client.PGGrantPrivileges(context.Background(), &proto.PGPrivilegesRequest{
+		HostId:     uint32(hostID),
+		Name:       req.Name,
+		User:       req.User,
+		Host:       req.Host,
+		Database:   req.Database,
+		Privileges: req.Privileges,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *PostgreSqlGRPCClient) RevokePrivileges(hostID uint64, req model.DatabasePrivilegesRequest) error {
+	_, err := c.client.PGRevokePrivileges(context.Background(), &proto.PGPrivilegesRequest{
+		HostId:     uint32(hostID),
+		Name:       req.Name,
+		User:       req.User,
+		Host:       req.Host,
+		Database:   req.Database,
+		Privileges: req.Privileges,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *PostgreSqlGRPCClient) SetUserPassword(hostID uint64, req model.SetDatabaseUserPasswordRequest) error {
+	_, err := c.client.PGSetUserPassword(context.Background(), &proto.PGSetUserPasswordRequest{
+		HostId:   uint32(hostID),
+		Name:     req.Name,
+		User:     req.User,
+		Host:     req.Host,
+		Password: req.Password,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *PostgreSqlGRPCClient) ListConnections(hostID uint64, req model.ListDatabaseConnectionsRequest) (*model.ListDatabaseConnectionsResponse, error) {
+	resp, err := c.client.PGListConnections(context.Background(), &proto.PGListConnectionsRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := model.ListDatabaseConnectionsResponse{Total: int(resp.Total), Items: []*model.DatabaseConnection{}}
+	for _, item := range resp.Items {
+		result.Items = append(result.Items, &model.DatabaseConnection{
+			ID:       item.Id,
+			User:     item.User,
+			Host:     item.Host,
+			Database: item.Database,
+			State:    item.State,
+			Time:     item.Time,
+			Query:    item.Query,
+		})
+	}
+	return &result, nil
+}
+
+func (c *PostgreSqlGRPCClient) GetSlowLog(hostID uint64, req model.GetSlowLogRequest) (*model.GetSlowLogResponse, error) {
+	resp, err := c.client.PGGetSlowLog(context.Background(), &proto.PGGetSlowLogRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+		Lines:  int32(req.Lines),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.GetSlowLogResponse{
+		Enabled:   resp.Enabled,
+		Threshold: resp.Threshold,
+		Path:      resp.Path,
+		Content:   resp.Content,
+	}, nil
+}
+
 type PostgreSqlGRPCServer struct {
 	Impl PostgreSql
 	*proto.UnimplementedPostgreSqlServer
@@ -208,3 +519,372 @@ func (s *PostgreSqlGRPCServer) PGSetConf(ctx context.Context, req *proto.PGSetCo
 	resp.Error = ""
 	return &resp, nil
 }
+
+func (s *PostgreSqlGRPCServer) PGDump(ctx context.Context, req *proto.PGDumpRequest) (*proto.PGDumpResponse, error) {
+	result, err := s.Impl.Dump(
+		uint64(req.HostId),
+		model.DumpRequest{
+			Name:     req.Name,
+			Database: req.Database,
+			Compress: req.Compress,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.PGDumpResponse{
+		LogPath: result.LogPath,
+	}, nil
+}
+
+func (s *PostgreSqlGRPCServer) PGListDumps(ctx context.Context, req *proto.PGListDumpsRequest) (*proto.PGListDumpsResponse, error) {
+	var resp proto.PGListDumpsResponse
+
+	result, err := s.Impl.ListDumps(
+		uint64(req.HostId),
+		model.ListDumpsRequest{
+			Name:     req.Name,
+			Page:     int(req.Page),
+			PageSize: int(req.PageSize),
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	for _, item := range result.Items {
+		resp.Items = append(resp.Items, &proto.PGDumpInfo{
+			File:       item.File,
+			Database:   item.Database,
+			Size:       item.Size,
+			Compressed: item.Compressed,
+			CreatedAt:  item.CreatedAt.Unix(),
+		})
+	}
+	resp.Total = int64(result.Total)
+	return &resp, nil
+}
+
+func (s *PostgreSqlGRPCServer) PGDeleteDump(ctx context.Context, req *proto.PGDeleteDumpRequest) (*proto.PGCommonResponse, error) {
+	var resp proto.PGCommonResponse
+
+	err := s.Impl.DeleteDump(
+		uint64(req.HostId),
+		model.DeleteDumpRequest{
+			Name: req.Name,
+			File: req.File,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *PostgreSqlGRPCServer) PGRestoreDump(ctx context.Context, req *proto.PGRestoreDumpRequest) (*proto.PGDumpResponse, error) {
+	result, err := s.Impl.RestoreDump(
+		uint64(req.HostId),
+		model.RestoreDumpRequest{
+			Name:           req.Name,
+			File:           req.File,
+			Database:       req.Database,
+			CreateDatabase: req.CreateDatabase,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.PGDumpResponse{
+		LogPath: result.LogPath,
+	}, nil
+}
+
+func (s *PostgreSqlGRPCServer) PGGetDumpSchedule(ctx context.Context, req *proto.PGGetDumpScheduleRequest) (*proto.PGGetDumpScheduleResponse, error) {
+	result, err := s.Impl.GetDumpSchedule(
+		uint64(req.HostId),
+		model.GetDumpScheduleRequest{
+			Name: req.Name,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.PGGetDumpScheduleResponse{
+		Enabled:   result.Enabled,
+		Schedule:  result.Schedule,
+		Databases: result.Databases,
+		Compress:  result.Compress,
+		Retention: int32(result.Retention),
+	}, nil
+}
+
+func (s *PostgreSqlGRPCServer) PGSetDumpSchedule(ctx context.Context, req *proto.PGSetDumpScheduleRequest) (*proto.PGCommonResponse, error) {
+	var resp proto.PGCommonResponse
+
+	err := s.Impl.SetDumpSchedule(
+		uint64(req.HostId),
+		model.DumpSchedule{
+			Name:      req.Name,
+			Enabled:   req.Enabled,
+			Schedule:  req.Schedule,
+			Databases: req.Databases,
+			Compress:  req.Compress,
+			Retention: int(req.Retention),
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *PostgreSqlGRPCServer) PGListDatabases(ctx context.Context, req *proto.PGListDatabasesRequest) (*proto.PGListDatabasesResponse, error) {
+	var resp proto.PGListDatabasesResponse
+
+	result, err := s.Impl.ListDatabases(
+		uint64(req.HostId),
+		model.ListDatabasesRequest{
+			Name: req.Name,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	for _, item := range result.Items {
+		resp.Items = append(resp.Items, &proto.PGDatabaseInfo{
+			Name:      item.Name,
+			Charset:   item.Charset,
+			Collation: item.Collation,
+			Owner:     item.Owner,
+			Size:      item.Size,
+		})
+	}
+	resp.Total = int64(result.Total)
+	return &resp, nil
+}
+
+func (s *PostgreSqlGRPCServer) PGCreateDatabase(ctx context.Context, req *proto.PGCreateDatabaseRequest) (*proto.PGCommonResponse, error) {
+	var resp proto.PGCommonResponse
+
+	err := s.Impl.CreateDatabase(
+		uint64(req.HostId),
+		model.CreateDatabaseRequest{
+			Name:      req.Name,
+			Database:  req.Database,
+			Charset:   req.Charset,
+			Collation: req.Collation,
+			Owner:     req.Owner,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *PostgreSqlGRPCServer) PGDropDatabase(ctx context.Context, req *proto.PGDropDatabaseRequest) (*proto.PGCommonResponse, error) {
+	var resp proto.PGCommonResponse
+
+	err := s.Impl.DropDatabase(
+		uint64(req.HostId),
+		model.DropDatabaseRequest{
+			Name:     req.Name,
+			Database: req.Database,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *PostgreSqlGRPCServer) PGListUsers(ctx context.Context, req *proto.PGListUsersRequest) (*proto.PGListUsersResponse, error) {
+	var resp proto.PGListUsersResponse
+
+	result, err := s.Impl.ListUsers(
+		uint64(req.HostId),
+		model.ListDatabaseUsersRequest{
+			Name: req.Name,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	for _, item := range result.Items {
+		user := &proto.PGDatabaseUser{
+			User: item.User,
+			Host: item.Host,
+		}
+		for _, grant := range item.Grants {
+			user.Grants = append(user.Grants, &proto.PGDatabaseGrant{
+				Database:   grant.Database,
+				Privileges: grant.Privileges,
+			})
+		}
+		resp.Items = append(resp.Items, user)
+	}
+	resp.Total = int64(result.Total)
+	return &resp, nil
+}
+
+func (s *PostgreSqlGRPCServer) PGCreateUser(ctx context.Context, req *proto.PGCreateUserRequest) (*proto.PGCommonResponse, error) {
+	var resp proto.PGCommonResponse
+
+	var grants []*model.DatabaseGrant
+	for _, grant := range req.Grants {
+		grants = append(grants, &model.DatabaseGrant{
+			Database:   grant.Database,
+			Privileges: grant.Privileges,
+		})
+	}
+	err := s.Impl.CreateUser(
+		uint64(req.HostId),
+		model.CreateDatabaseUserRequest{
+			Name:     req.Name,
+			User:     req.User,
+			Host:     req.Host,
+			Password: req.Password,
+			Grants:   grants,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *PostgreSqlGRPCServer) PGDropUser(ctx context.Context, req *proto.PGDropUserRequest) (*proto.PGCommonResponse, error) {
+	var resp proto.PGCommonResponse
+
+	err := s.Impl.DropUser(
+		uint64(req.HostId),
+		model.DropDatabaseUserRequest{
+			Name: req.Name,
+			User: req.User,
+			Host: req.Host,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *PostgreSqlGRPCServer) PGGrantPrivileges(ctx context.Context, req *proto.PGPrivilegesRequest) (*proto.PGCommonResponse, error) {
+	var resp proto.PGCommonResponse
+
+	err := s.Impl.GrantPrivileges(
+		uint64(req.HostId),
+		model.DatabasePrivilegesRequest{
+			Name:       req.Name,
+			User:       req.User,
+			Host:       req.Host,
+			Database:   req.Database,
+			Privileges: req.Privileges,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *PostgreSqlGRPCServer) PGRevokePrivileges(ctx context.Context, req *proto.PGPrivilegesRequest) (*proto.PGCommonResponse, error) {
+	var resp proto.PGCommonResponse
+
+	err := s.Impl.RevokePrivileges(
+		uint64(req.HostId),
+		model.DatabasePrivilegesRequest{
+			Name:       req.Name,
+			User:       req.User,
+			Host:       req.Host,
+			Database:   req.Database,
+			Privileges: req.Privileges,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *PostgreSqlGRPCServer) PGSetUserPassword(ctx context.Context, req *proto.PGSetUserPasswordRequest) (*proto.PGCommonResponse, error) {
+	var resp proto.PGCommonResponse
+
+	err := s.Impl.SetUserPassword(
+		uint64(req.HostId),
+		model.SetDatabaseUserPasswordRequest{
+			Name:     req.Name,
+			User:     req.User,
+			Host:     req.Host,
+			Password: req.Password,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *PostgreSqlGRPCServer) PGListConnections(ctx context.Context, req *proto.PGListConnectionsRequest) (*proto.PGListConnectionsResponse, error) {
+	var resp proto.PGListConnectionsResponse
+
+	result, err := s.Impl.ListConnections(
+		uint64(req.HostId),
+		model.ListDatabaseConnectionsRequest{
+			Name: req.Name,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	for _, item := range result.Items {
+		resp.Items = append(resp.Items, &proto.PGDBConnection{
+			Id:       item.ID,
+			User:     item.User,
+			Host:     item.Host,
+			Database: item.Database,
+			State:    item.State,
+			Time:     item.Time,
+			Query:    item.Query,
+		})
+	}
+	resp.Total = int64(result.Total)
+	return &resp, nil
+}
+
+func (s *PostgreSqlGRPCServer) PGGetSlowLog(ctx context.Context, req *proto.PGGetSlowLogRequest) (*proto.PGGetSlowLogResponse, error) {
+	result, err := s.Impl.GetSlowLog(
+		uint64(req.HostId),
+		model.GetSlowLogRequest{
+			Name:  req.Name,
+			Lines: int(req.Lines),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.PGGetSlowLogResponse{
+		Enabled:   result.Enabled,
+		Threshold: result.Threshold,
+		Path:      result.Path,
+		Content:   result.Content,
+	}, nil
+}
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/go-plugin"
 	"github.com/sensdata/idb/center/core/plugin/proto"
@@ -19,6 +20,21 @@ type Redis interface {
 	SetRemoteAccess(hostID uint64, req model.SetRemoteAccessRequest) error
 	GetRootPassword(hostID uint64, req model.GetRootPasswordRequest) (*model.GetRootPasswordResponse, error)
 	SetRootPassword(hostID uint64, req model.SetRootPasswordRequest) error
+	Dump(hostID uint64, req model.DumpRequest) (*model.DumpTaskResponse, error)
+	ListDumps(hostID uint64, req model.ListDumpsRequest) (*model.ListDumpsResponse, error)
+	DeleteDump(hostID uint64, req model.DeleteDumpRequest) error
+	RestoreDump(hostID uint64, req model.RestoreDumpRequest) (*model.DumpTaskResponse, error)
+	GetDumpSchedule(hostID uint64, req model.GetDumpScheduleRequest) (*model.DumpSchedule, error)
+	SetDumpSchedule(hostID uint64, req model.DumpSchedule) error
+	Info(hostID uint64, req model.RedisInfoRequest) (*model.RedisInfoResponse, error)
+	Keyspace(hostID uint64, req model.RedisKeyspaceRequest) (*model.RedisKeyspaceResponse, error)
+	ScanKeys(hostID uint64, req model.RedisScanKeysRequest) (*model.RedisScanKeysResponse, error)
+	GetKey(hostID uint64, req model.RedisGetKeyRequest) (*model.RedisKeyValue, error)
+	SetTTL(hostID uint64, req model.RedisSetTTLRequest) error
+	Slowlog(hostID uint64, req model.RedisSlowlogRequest) (*model.RedisSlowlogResponse, error)
+	Clients(hostID uint64, req model.RedisClientsRequest) (*model.RedisClientsResponse, error)
+	KillClient(hostID uint64, req model.RedisKillClientRequest) error
+	BigKeys(hostID uint64, req model.RedisBigKeysRequest) (*model.RedisBigKeysResponse, error)
 }
 
 type RedisPlugin struct {
@@ -164,6 +180,292 @@ func (c *RedisGRPCClient) SetRootPassword(hostID uint64, req model.SetRootPasswo
 	return nil
 }
 
+func (c *RedisGRPCClient) Dump(hostID uint64, req model.DumpRequest) (*model.DumpTaskResponse, error) {
+	resp, err := c.client.RedisDump(context.Background(), &proto.RedisDumpRequest{
+		HostId:   uint32(hostID),
+		Name:     req.Name,
+		Compress: req.Compress,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.DumpTaskResponse{
+		LogPath: resp.LogPath,
+	}, nil
+}
+
+func (c *RedisGRPCClient) ListDumps(hostID uint64, req model.ListDumpsRequest) (*model.ListDumpsResponse, error) {
+	resp, err := c.client.RedisListDumps(context.Background(), &proto.RedisListDumpsRequest{
+		HostId:   uint32(hostID),
+		Name:     req.Name,
+		Page:     int32(req.Page),
+		PageSize: int32(req.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := model.ListDumpsResponse{Total: int(resp.Total), Items: []*model.DumpInfo{}}
+	for _, item := range resp.Items {
+		result.Items = append(result.Items, &model.DumpInfo{
+			File:       item.File,
+			Size:       item.Size,
+			Compressed: item.Compressed,
+			CreatedAt:  time.Unix(item.CreatedAt, 0),
+		})
+	}
+	return &result, nil
+}
+
+func (c *RedisGRPCClient) DeleteDump(hostID uint64, req model.DeleteDumpRequest) error {
+	_, err := c.client.RedisDeleteDump(context.Background(), &proto.RedisDeleteDumpRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+		File:   req.File,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *RedisGRPCClient) RestoreDump(hostID uint64, req model.RestoreDumpRequest) (*model.DumpTaskResponse, error) {
+	resp, err := c.client.RedisRestoreDump(context.Background(), &proto.RedisRestoreDumpRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+		File:   req.File,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.DumpTaskResponse{
+		LogPath: resp.LogPath,
+	}, nil
+}
+
+func (c *RedisGRPCClient) GetDumpSchedule(hostID uint64, req model.GetDumpScheduleRequest) (*model.DumpSchedule, error) {
+	resp, err := c.client.RedisGetDumpSchedule(context.Background(), &proto.RedisGetDumpScheduleRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &model.DumpSchedule{
+		Name:      req.Name,
+		Enabled:   resp.Enabled,
+		Schedule:  resp.Schedule,
+		Compress:  resp.Compress,
+		Retention: int(resp.Retention),
+	}, nil
+}
+
+func (c *RedisGRPCClient) SetDumpSchedule(hostID uint64, req model.DumpSchedule) error {
+	_, err := c.client.RedisSetDumpSchedule(context.Background(), &proto.RedisSetDumpScheduleRequest{
+		HostId:    uint32(hostID),
+		Name:      req.Name,
+		Enabled:   req.Enabled,
+		Schedule:  req.Schedule,
+		Compress:  req.Compress,
+		Retention: int32(req.Retention),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *RedisGRPCClient) Info(hostID uint64, req model.RedisInfoRequest) (*model.RedisInfoResponse, error) {
+	resp, err := c.client.RedisInfo(context.Background(), &proto.RedisInfoRequest{
+		HostId:  uint32(hostID),
+		Name:    req.Name,
+		Section: req.Section,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := model.RedisInfoResponse{Sections: []*model.RedisInfoSection{}}
+	for _, item := range resp.Sections {
+		result.Sections = append(result.Sections, &model.RedisInfoSection{
+			Name:  item.Name,
+			Items: item.Items,
+		})
+	}
+	return &result, nil
+}
+
+func (c *RedisGRPCClient) Keyspace(hostID uint64, req model.RedisKeyspaceRequest) (*model.RedisKeyspaceResponse, error) {
+	resp, err := c.client.RedisKeyspace(context.Background(), &proto.RedisKeyspaceRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := model.RedisKeyspaceResponse{Items: []*model.RedisKeyspaceStat{}}
+	for _, item := range resp.Items {
+		result.Items = append(result.Items, &model.RedisKeyspaceStat{
+			DB:      int(item.Db),
+			Keys:    item.Keys,
+			Expires: item.Expires,
+			AvgTTL:  item.AvgTtl,
+		})
+	}
+	return &result, nil
+}
+
+func (c *RedisGRPCClient) ScanKeys(hostID uint64, req model.RedisScanKeysRequest) (*model.RedisScanKeysResponse, error) {
+	resp, err := c.client.RedisScanKeys(context.Background(), &proto.RedisScanKeysRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+		Db:     int32(req.DB),
+		Cursor: req.Cursor,
+		Match:  req.Match,
+		Type:   req.Type,
+		Count:  int32(req.Count),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := model.RedisScanKeysResponse{Cursor: resp.Cursor, Keys: []*model.RedisKeyBrief{}}
+	for _, item := range resp.Keys {
+		result.Keys = append(result.Keys, &model.RedisKeyBrief{
+			Key:  item.Key,
+			Type: item.Type,
+			TTL:  item.Ttl,
+		})
+	}
+	return &result, nil
+}
+
+func (c *RedisGRPCClient) GetKey(hostID uint64, req model.RedisGetKeyRequest) (*model.RedisKeyValue, error) {
+	resp, err := c.client.RedisGetKey(context.Background(), &proto.RedisGetKeyRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+		Db:     int32(req.DB),
+		Key:    req.Key,
+		Limit:  int32(req.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := model.RedisKeyValue{
+		Key:       resp.Key,
+		Type:      resp.Type,
+		TTL:       resp.Ttl,
+		Length:    resp.Length,
+		Value:     resp.Value,
+		Items:     []*model.RedisKeyItem{},
+		Truncated: resp.Truncated,
+	}
+	for _, item := range resp.Items {
+		result.Items = append(result.Items, &model.RedisKeyItem{
+			Field:  item.Field,
+			Value:  item.Value,
+			Score:  item.Score,
+			Fields: item.Fields,
+		})
+	}
+	return &result, nil
+}
+
+func (c *RedisGRPCClient) SetTTL(hostID uint64, req model.RedisSetTTLRequest) error {
+	_, err := c.client.RedisSetTTL(context.Background(), &proto.RedisSetTTLRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+		Db:     int32(req.DB),
+		Key:    req.Key,
+		Ttl:    req.TTL,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *RedisGRPCClient) Slowlog(hostID uint64, req model.RedisSlowlogRequest) (*model.RedisSlowlogResponse, error) {
+	resp, err := c.client.RedisSlowlog(context.Background(), &proto.RedisSlowlogRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+		Count:  int32(req.Count),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := model.RedisSlowlogResponse{Items: []*model.RedisSlowlogEntry{}}
+	for _, item := range resp.Items {
+		result.Items = append(result.Items, &model.RedisSlowlogEntry{
+			ID:         item.Id,
+			Timestamp:  item.Timestamp,
+			Duration:   item.Duration,
+			Args:       item.Args,
+			Client:     item.Client,
+			ClientName: item.ClientName,
+		})
+	}
+	return &result, nil
+}
+
+func (c *RedisGRPCClient) Clients(hostID uint64, req model.RedisClientsRequest) (*model.RedisClientsResponse, error) {
+	resp, err := c.client.RedisClients(context.Background(), &proto.RedisClientsRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := model.RedisClientsResponse{Items: []*model.RedisClientInfo{}}
+	for _, item := range resp.Items {
+		result.Items = append(result.Items, &model.RedisClientInfo{
+			ID:    item.Id,
+			Addr:  item.Addr,
+			Name:  item.Name,
+			Age:   item.Age,
+			Idle:  item.Idle,
+			DB:    int(item.Db),
+			Flags: item.Flags,
+			Cmd:   item.Cmd,
+			User:  item.User,
+		})
+	}
+	return &result, nil
+}
+
+func (c *RedisGRPCClient) KillClient(hostID uint64, req model.RedisKillClientRequest) error {
+	_, err := c.client.RedisKillClient(context.Background(), &proto.RedisKillClientRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+		Id:     req.ID,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *RedisGRPCClient) BigKeys(hostID uint64, req model.RedisBigKeysRequest) (*model.RedisBigKeysResponse, error) {
+	resp, err := c.client.RedisBigKeys(context.Background(), &proto.RedisBigKeysRequest{
+		HostId: uint32(hostID),
+		Name:   req.Name,
+		Db:     int32(req.DB),
+		Match:  req.Match,
+		Sample: int32(req.Sample),
+		Top:    int32(req.Top),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := model.RedisBigKeysResponse{Scanned: int(resp.Scanned), Items: []*model.RedisBigKey{}}
+	for _, item := range resp.Items {
+		result.Items = append(result.Items, &model.RedisBigKey{
+			Key:    item.Key,
+			Type:   item.Type,
+			Size:   item.Size,
+			Length: item.Length,
+		})
+	}
+	return &result, nil
+}
+
 type RedisGRPCServer struct {
 	Impl Redis
 	*proto.UnimplementedRedisServer
@@ -328,3 +630,343 @@ func (s *RedisGRPCServer) RedisSetRootPassword(ctx context.Context, req *proto.R
 	resp.Error = ""
 	return &resp, nil
 }
+
+func (s *RedisGRPCServer) RedisDump(ctx context.Context, req *proto.RedisDumpRequest) (*proto.RedisDumpResponse, error) {
+	result, err := s.Impl.Dump(
+		uint64(req.HostId),
+		model.DumpRequest{
+			Name:     req.Name,
+			Compress: req.Compress,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.RedisDumpResponse{
+		LogPath: result.LogPath,
+	}, nil
+}
+
+func (s *RedisGRPCServer) RedisListDumps(ctx context.Context, req *proto.RedisListDumpsRequest) (*proto.RedisListDumpsResponse, error) {
+	var resp proto.RedisListDumpsResponse
+
+	result, err := s.Impl.ListDumps(
+		uint64(req.HostId),
+		model.ListDumpsRequest{
+			Name:     req.Name,
+			Page:     int(req.Page),
+			PageSize: int(req.PageSize),
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	for _, item := range result.Items {
+		resp.Items = append(resp.Items, &proto.RedisDumpInfo{
+			File:       item.File,
+			Size:       item.Size,
+			Compressed: item.Compressed,
+			CreatedAt:  item.CreatedAt.Unix(),
+		})
+	}
+	resp.Total = int64(result.Total)
+	return &resp, nil
+}
+
+func (s *RedisGRPCServer) RedisDeleteDump(ctx context.Context, req *proto.RedisDeleteDumpRequest) (*proto.RedisCommonResponse, error) {
+	var resp proto.RedisCommonResponse
+
+	err := s.Impl.DeleteDump(
+		uint64(req.HostId),
+		model.DeleteDumpRequest{
+			Name: req.Name,
+			File: req.File,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *RedisGRPCServer) RedisRestoreDump(ctx context.Context, req *proto.RedisRestoreDumpRequest) (*proto.RedisDumpResponse, error) {
+	result, err := s.Impl.RestoreDump(
+		uint64(req.HostId),
+		model.RestoreDumpRequest{
+			Name: req.Name,
+			File: req.File,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.RedisDumpResponse{
+		LogPath: result.LogPath,
+	}, nil
+}
+
+func (s *RedisGRPCServer) RedisGetDumpSchedule(ctx context.Context, req *proto.RedisGetDumpScheduleRequest) (*proto.RedisGetDumpScheduleResponse, error) {
+	result, err := s.Impl.GetDumpSchedule(
+		uint64(req.HostId),
+		model.GetDumpScheduleRequest{
+			Name: req.Name,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.RedisGetDumpScheduleResponse{
+		Enabled:   result.Enabled,
+		Schedule:  result.Schedule,
+		Compress:  result.Compress,
+		Retention: int32(result.Retention),
+	}, nil
+}
+
+func (s *RedisGRPCServer) RedisSetDumpSchedule(ctx context.Context, req *proto.RedisSetDumpScheduleRequest) (*proto.RedisCommonResponse, error) {
+	var resp proto.RedisCommonResponse
+
+	err := s.Impl.SetDumpSchedule(
+		uint64(req.HostId),
+		model.DumpSchedule{
+			Name:      req.Name,
+			Enabled:   req.Enabled,
+			Schedule:  req.Schedule,
+			Compress:  req.Compress,
+			Retention: int(req.Retention),
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *RedisGRPCServer) RedisInfo(ctx context.Context, req *proto.RedisInfoRequest) (*proto.RedisInfoResponse, error) {
+	var resp proto.RedisInfoResponse
+
+	result, err := s.Impl.Info(
+		uint64(req.HostId),
+		model.RedisInfoRequest{
+			Name:    req.Name,
+			Section: req.Section,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	for _, item := range result.Sections {
+		resp.Sections = append(resp.Sections, &proto.RedisInfoSection{
+			Name:  item.Name,
+			Items: item.Items,
+		})
+	}
+	return &resp, nil
+}
+
+func (s *RedisGRPCServer) RedisKeyspace(ctx context.Context, req *proto.RedisKeyspaceRequest) (*proto.RedisKeyspaceResponse, error) {
+	var resp proto.RedisKeyspaceResponse
+
+	result, err := s.Impl.Keyspace(
+		uint64(req.HostId),
+		model.RedisKeyspaceRequest{
+			Name: req.Name,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	for _, item := range result.Items {
+		resp.Items = append(resp.Items, &proto.RedisKeyspaceStat{
+			Db:      int32(item.DB),
+			Keys:    item.Keys,
+			Expires: item.Expires,
+			AvgTtl:  item.AvgTTL,
+		})
+	}
+	return &resp, nil
+}
+
+func (s *RedisGRPCServer) RedisScanKeys(ctx context.Context, req *proto.RedisScanKeysRequest) (*proto.RedisScanKeysResponse, error) {
+	var resp proto.RedisScanKeysResponse
+
+	result, err := s.Impl.ScanKeys(
+		uint64(req.HostId),
+		model.RedisScanKeysRequest{
+			Name:   req.Name,
+			DB:     int(req.Db),
+			Cursor: req.Cursor,
+			Match:  req.Match,
+			Type:   req.Type,
+			Count:  int(req.Count),
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Cursor = result.Cursor
+	for _, item := range result.Keys {
+		resp.Keys = append(resp.Keys, &proto.RedisKeyBrief{
+			Key:  item.Key,
+			Type: item.Type,
+			Ttl:  item.TTL,
+		})
+	}
+	return &resp, nil
+}
+
+func (s *RedisGRPCServer) RedisGetKey(ctx context.Context, req *proto.RedisGetKeyRequest) (*proto.RedisGetKeyResponse, error) {
+	result, err := s.Impl.GetKey(
+		uint64(req.HostId),
+		model.RedisGetKeyRequest{
+			Name:  req.Name,
+			DB:    int(req.Db),
+			Key:   req.Key,
+			Limit: int(req.Limit),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp := proto.RedisGetKeyResponse{
+		Key:       result.Key,
+		Type:      result.Type,
+		Ttl:       result.TTL,
+		Length:    result.Length,
+		Value:     result.Value,
+		Truncated: result.Truncated,
+	}
+	for _, item := range result.Items {
+		resp.Items = append(resp.Items, &proto.RedisKeyItem{
+			Field:  item.Field,
+			Value:  item.Value,
+			Score:  item.Score,
+			Fields: item.Fields,
+		})
+	}
+	return &resp, nil
+}
+
+func (s *RedisGRPCServer) RedisSetTTL(ctx context.Context, req *proto.RedisSetTTLRequest) (*proto.RedisCommonResponse, error) {
+	var resp proto.RedisCommonResponse
+
+	err := s.Impl.SetTTL(
+		uint64(req.HostId),
+		model.RedisSetTTLRequest{
+			Name: req.Name,
+			DB:   int(req.Db),
+			Key:  req.Key,
+			TTL:  req.Ttl,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *RedisGRPCServer) RedisSlowlog(ctx context.Context, req *proto.RedisSlowlogRequest) (*proto.RedisSlowlogResponse, error) {
+	var resp proto.RedisSlowlogResponse
+
+	result, err := s.Impl.Slowlog(
+		uint64(req.HostId),
+		model.RedisSlowlogRequest{
+			Name:  req.Name,
+			Count: int(req.Count),
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	for _, item := range result.Items {
+		resp.Items = append(resp.Items, &proto.RedisSlowlogEntry{
+			Id:         item.ID,
+			Timestamp:  item.Timestamp,
+			Duration:   item.Duration,
+			Args:       item.Args,
+			Client:     item.Client,
+			ClientName: item.ClientName,
+		})
+	}
+	return &resp, nil
+}
+
+func (s *RedisGRPCServer) RedisClients(ctx context.Context, req *proto.RedisClientsRequest) (*proto.RedisClientsResponse, error) {
+	var resp proto.RedisClientsResponse
+
+	result, err := s.Impl.Clients(
+		uint64(req.HostId),
+		model.RedisClientsRequest{
+			Name: req.Name,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	for _, item := range result.Items {
+		resp.Items = append(resp.Items, &proto.RedisClientInfo{
+			Id:    item.ID,
+			Addr:  item.Addr,
+			Name:  item.Name,
+			Age:   item.Age,
+			Idle:  item.Idle,
+			Db:    int32(item.DB),
+			Flags: item.Flags,
+			Cmd:   item.Cmd,
+			User:  item.User,
+		})
+	}
+	return &resp, nil
+}
+
+func (s *RedisGRPCServer) RedisKillClient(ctx context.Context, req *proto.RedisKillClientRequest) (*proto.RedisCommonResponse, error) {
+	var resp proto.RedisCommonResponse
+
+	err := s.Impl.KillClient(
+		uint64(req.HostId),
+		model.RedisKillClientRequest{
+			Name: req.Name,
+			ID:   req.Id,
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Success = true
+	resp.Error = ""
+	return &resp, nil
+}
+
+func (s *RedisGRPCServer) RedisBigKeys(ctx context.Context, req *proto.RedisBigKeysRequest) (*proto.RedisBigKeysResponse, error) {
+	var resp proto.RedisBigKeysResponse
+
+	result, err := s.Impl.BigKeys(
+		uint64(req.HostId),
+		model.RedisBigKeysRequest{
+			Name:   req.Name,
+			DB:     int(req.Db),
+			Match:  req.Match,
+			Sample: int(req.Sample),
+			Top:    int(req.Top),
+		},
+	)
+	if err != nil {
+		return &resp, err
+	}
+	resp.Scanned = int64(result.Scanned)
+	for _, item := range result.Items {
+		resp.Items = append(resp.Items, &proto.RedisBigKey{
+			Key:    item.Key,
+			Type:   item.Type,
+			Size:   item.Size,
+			Length: item.Length,
+		})
+	}
+	return &resp, nil
+}
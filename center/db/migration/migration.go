@@ -3,11 +3,13 @@ package migration
 import (
 	_ "embed"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/go-gormigrate/gormigrate/v2"
 	"github.com/sensdata/idb/center/db/model"
 	"github.com/sensdata/idb/center/global"
+	"github.com/sensdata/idb/core/constant"
 	"github.com/sensdata/idb/core/utils"
 	"gorm.io/gorm"
 )
@@ -31,6 +33,17 @@ func Init(password string) {
 		AddTableTimezone,
 		AddTableApp,
 		AddFieldAssetDirToAppVersion,
+		AddTablePermission,
+		AddTableHostScope,
+		AddTableAuditLog,
+		AddTableTerminalRecording,
+		AddFieldTotpToUser,
+		AddTableAccessToken,
+		AddTableLoginSession,
+		AddFieldSourceToUser,
+		AddTableBatchTask,
+		AddTableHostMetric,
+		AddTableAlert,
 	})
 	if err := m.Migrate(); err != nil {
 		global.LOG.Error("migration error: %v", err)
@@ -320,3 +333,235 @@ var AddFieldAssetDirToAppVersion = &gormigrate.Migration{
 		return nil
 	},
 }
+
+var AddTablePermission = &gormigrate.Migration{
+	ID: "20261017-add-table-permission",
+	Migrate: func(db *gorm.DB) error {
+		global.LOG.Info("Adding table Permission")
+		if err := db.AutoMigrate(&model.Permission{}); err != nil {
+			return err
+		}
+
+		// 内置角色及默认权限，user 角色保持原有的全部业务权限
+		defaults := map[string][]model.Permission{
+			constant.RoleAdmin: {
+				{Resource: constant.ResourceAll, Access: constant.AccessWrite},
+			},
+			constant.RoleUser: {
+				{Resource: constant.ResourceAll, Access: constant.AccessWrite},
+				{Resource: "settings", Access: constant.AccessRead},
+			},
+			constant.RoleOperator: {
+				{Resource: constant.ResourceAll, Access: constant.AccessWrite},
+				{Resource: "settings", Access: constant.AccessRead},
+			},
+			constant.RoleReadOnly: {
+				{Resource: constant.ResourceAll, Access: constant.AccessRead},
+				{Resource: "settings", Access: constant.AccessRead},
+			},
+		}
+		descriptions := map[string]string{
+			constant.RoleOperator: "Operator role",
+			constant.RoleReadOnly: "Read-only role",
+		}
+
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			for _, name := range []string{constant.RoleAdmin, constant.RoleUser, constant.RoleOperator, constant.RoleReadOnly} {
+				var role model.Role
+				err := tx.Where("name = ?", name).First(&role).Error
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					role = model.Role{Name: name, Description: descriptions[name]}
+					if err := tx.Create(&role).Error; err != nil {
+						global.LOG.Error("Failed to insert role %s: %v", name, err)
+						return err
+					}
+				} else if err != nil {
+					return err
+				}
+				for _, perm := range defaults[name] {
+					perm.RoleID = role.ID
+					if err := tx.Create(&perm).Error; err != nil {
+						global.LOG.Error("Failed to insert permission for role %s: %v", name, err)
+						return err
+					}
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		global.LOG.Info("Table Permission added successfully")
+		return nil
+	},
+}
+
+var AddTableHostScope = &gormigrate.Migration{
+	ID: "20261017-add-table-host-scope",
+	Migrate: func(db *gorm.DB) error {
+		global.LOG.Info("Adding table HostScope")
+		if err := db.AutoMigrate(&model.HostScope{}); err != nil {
+			return err
+		}
+		global.LOG.Info("Table HostScope added successfully")
+		return nil
+	},
+}
+
+var AddTableAuditLog = &gormigrate.Migration{
+	ID: "20261017-add-table-audit-log",
+	Migrate: func(db *gorm.DB) error {
+		global.LOG.Info("Adding table AuditLog")
+		if err := db.AutoMigrate(&model.AuditLog{}); err != nil {
+			return err
+		}
+		global.LOG.Info("Table AuditLog added successfully")
+		return nil
+	},
+}
+
+var AddTableTerminalRecording = &gormigrate.Migration{
+	ID: "20261017-add-table-terminal-recording",
+	Migrate: func(db *gorm.DB) error {
+		global.LOG.Info("Adding table TerminalRecording")
+		if err := db.AutoMigrate(&model.TerminalRecording{}); err != nil {
+			return err
+		}
+
+		// 增加 RecordTerminal 字段
+		if err := db.AutoMigrate(&model.Host{}); err != nil {
+			return err
+		}
+		if err := db.AutoMigrate(&model.User{}); err != nil {
+			return err
+		}
+		global.LOG.Info("Table TerminalRecording added successfully")
+		return nil
+	},
+}
+
+var AddFieldTotpToUser = &gormigrate.Migration{
+	ID: "20261017-add-field-totp-to-user",
+	Migrate: func(db *gorm.DB) error {
+		global.LOG.Info("Adding field Totp to User table")
+
+		if err := db.AutoMigrate(&model.User{}); err != nil {
+			return err
+		}
+
+		// 默认不强制两步验证
+		var count int64
+		if err := db.Model(&model.Setting{}).Where("key = ?", "TwoFactorRequired").Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			if err := db.Create(&model.Setting{Key: "TwoFactorRequired", Value: "no"}).Error; err != nil {
+				global.LOG.Error("Failed to insert setting TwoFactorRequired: %v", err)
+				return err
+			}
+		}
+
+		global.LOG.Info("Table User added field Totp successfully")
+		return nil
+	},
+}
+
+var AddTableAccessToken = &gormigrate.Migration{
+	ID: "20261017-add-table-access-token",
+	Migrate: func(db *gorm.DB) error {
+		global.LOG.Info("Adding table AccessToken")
+		if err := db.AutoMigrate(&model.AccessToken{}); err != nil {
+			return err
+		}
+
+		// 增加 TokenID 字段
+		if err := db.AutoMigrate(&model.HostScope{}); err != nil {
+			return err
+		}
+		global.LOG.Info("Table AccessToken added successfully")
+		return nil
+	},
+}
+
+var AddTableLoginSession = &gormigrate.Migration{
+	ID: "20261017-add-table-login-session",
+	Migrate: func(db *gorm.DB) error {
+		global.LOG.Info("Adding table LoginSession")
+		if err := db.AutoMigrate(&model.LoginSession{}); err != nil {
+			return err
+		}
+		global.LOG.Info("Table LoginSession added successfully")
+		return nil
+	},
+}
+
+var AddFieldSourceToUser = &gormigrate.Migration{
+	ID: "20261017-add-field-source-to-user",
+	Migrate: func(db *gorm.DB) error {
+		global.LOG.Info("Adding field Source to User table")
+
+		if err := db.AutoMigrate(&model.User{}); err != nil {
+			return err
+		}
+
+		// 默认不启用 OIDC 登录
+		var count int64
+		if err := db.Model(&model.Setting{}).Where("key = ?", "OidcEnabled").Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			if err := db.Create(&model.Setting{Key: "OidcEnabled", Value: "no"}).Error; err != nil {
+				global.LOG.Error("Failed to insert setting OidcEnabled: %v", err)
+				return err
+			}
+		}
+
+		global.LOG.Info("Table User added field Source successfully")
+		return nil
+	},
+}
+
+var AddTableBatchTask = &gormigrate.Migration{
+	ID: "20261017-add-table-batch-task",
+	Migrate: func(db *gorm.DB) error {
+		global.LOG.Info("Adding table BatchTask")
+		if err := db.AutoMigrate(&model.BatchTask{}); err != nil {
+			return err
+		}
+
+		// 增加 Tags 字段
+		if err := db.AutoMigrate(&model.Host{}); err != nil {
+			return err
+		}
+		global.LOG.Info("Table BatchTask added successfully")
+		return nil
+	},
+}
+
+var AddTableHostMetric = &gormigrate.Migration{
+	ID: "20261017-add-table-host-metric",
+	Migrate: func(db *gorm.DB) error {
+		global.LOG.Info("Adding table HostMetric")
+		if err := db.AutoMigrate(&model.HostMetric{}); err != nil {
+			return err
+		}
+		global.LOG.Info("Table HostMetric added successfully")
+		return nil
+	},
+}
+
+var AddTableAlert = &gormigrate.Migration{
+	ID: "20261017-add-table-alert",
+	Migrate: func(db *gorm.DB) error {
+		global.LOG.Info("Adding alert tables")
+		if err := db.AutoMigrate(
+			&model.AlertRule{},
+			&model.AlertChannel{},
+			&model.AlertSilence{},
+			&model.AlertEvent{},
+		); err != nil {
+			return err
+		}
+		global.LOG.Info("Alert tables added successfully")
+		return nil
+	},
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// AccessToken 用于自动化调用的个人访问令牌，仅保存摘要
+type AccessToken struct {
+	BaseModel
+
+	UserID     uint       `gorm:"not null;index" json:"user_id"`
+	Name       string     `gorm:"type:varchar(64);not null" json:"name"`
+	Prefix     string     `gorm:"type:varchar(16)" json:"prefix"`
+	TokenHash  string     `gorm:"type:varchar(64);not null;uniqueIndex" json:"-"`
+	Access     string     `gorm:"type:varchar(16);not null" json:"access"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
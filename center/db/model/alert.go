@@ -0,0 +1,60 @@
+package model
+
+import "time"
+
+// AlertRule 告警规则，指标持续满足条件 Duration 秒后触发
+type AlertRule struct {
+	BaseModel
+
+	Name           string  `gorm:"type:varchar(64);not null" json:"name"`
+	Metric         string  `gorm:"type:varchar(32);not null" json:"metric"`
+	Operator       string  `gorm:"type:varchar(4);not null" json:"operator"`
+	Threshold      float64 `json:"threshold"`
+	Duration       int     `gorm:"not null;default:0" json:"duration"`
+	Severity       string  `gorm:"type:varchar(16);not null" json:"severity"`
+	HostIDs        string  `gorm:"type:varchar(256)" json:"host_ids"`         // 逗号分隔，为空表示全部设备
+	ChannelIDs     string  `gorm:"type:varchar(256)" json:"channel_ids"`      // 逗号分隔
+	RepeatInterval int     `gorm:"not null;default:0" json:"repeat_interval"` // 持续告警时重复通知的间隔（秒），0 表示不重复
+	Enabled        bool    `gorm:"not null;default:true" json:"enabled"`
+}
+
+// AlertChannel 通知渠道，Config 为对应类型配置的 JSON
+type AlertChannel struct {
+	BaseModel
+
+	Name    string `gorm:"type:varchar(64);not null" json:"name"`
+	Type    string `gorm:"type:varchar(16);not null" json:"type"`
+	Config  string `gorm:"type:text" json:"config"`
+	Enabled bool   `gorm:"not null;default:true" json:"enabled"`
+}
+
+// AlertSilence 静默期间触发的告警照常记录，但不发送通知，RuleID 或 HostID 为 0 表示不限
+type AlertSilence struct {
+	BaseModel
+
+	RuleID   uint      `gorm:"not null;default:0;index" json:"rule_id"`
+	HostID   uint      `gorm:"not null;default:0;index" json:"host_id"`
+	StartsAt time.Time `gorm:"not null" json:"starts_at"`
+	EndsAt   time.Time `gorm:"not null;index" json:"ends_at"`
+	Comment  string    `gorm:"type:varchar(256)" json:"comment"`
+	Creator  string    `gorm:"type:varchar(64)" json:"creator"`
+}
+
+// AlertEvent 告警记录，同一规则及设备同时只有一条 firing 记录
+type AlertEvent struct {
+	BaseModel
+
+	RuleID         uint       `gorm:"not null;index" json:"rule_id"`
+	RuleName       string     `gorm:"type:varchar(64)" json:"rule_name"`
+	HostID         uint       `gorm:"not null;index" json:"host_id"`
+	HostName       string     `gorm:"type:varchar(64)" json:"host_name"`
+	Metric         string     `gorm:"type:varchar(32)" json:"metric"`
+	Severity       string     `gorm:"type:varchar(16)" json:"severity"`
+	Value          float64    `json:"value"`
+	Message        string     `gorm:"type:varchar(1024)" json:"message"`
+	State          string     `gorm:"type:varchar(16);not null;index" json:"state"`
+	Silenced       bool       `gorm:"not null;default:false" json:"silenced"`
+	StartsAt       time.Time  `gorm:"not null" json:"starts_at"`
+	ResolvedAt     *time.Time `json:"resolved_at"`
+	LastNotifiedAt *time.Time `json:"last_notified_at"`
+}
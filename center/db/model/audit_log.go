@@ -0,0 +1,19 @@
+package model
+
+// AuditLog 记录一次变更操作，来源包括 REST 接口、Agent 指令下发和终端会话
+type AuditLog struct {
+	BaseModel
+
+	UserID   uint   `gorm:"not null;default:0;index" json:"user_id"`
+	UserName string `gorm:"type:varchar(64);index" json:"user_name"`
+	SourceIP string `gorm:"type:varchar(64)" json:"source_ip"`
+	HostID   uint   `gorm:"not null;default:0;index" json:"host_id"`
+	Source   string `gorm:"type:varchar(16)" json:"source"`
+	Plugin   string `gorm:"type:varchar(64)" json:"plugin"`
+	Route    string `gorm:"type:varchar(256)" json:"route"`
+	Method   string `gorm:"type:varchar(16)" json:"method"`
+	Action   string `gorm:"type:varchar(128)" json:"action"`
+	Params   string `gorm:"type:text" json:"params"`
+	Result   string `gorm:"type:varchar(16)" json:"result"`
+	Message  string `gorm:"type:text" json:"message"`
+}
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// BatchTask 批量操作记录，Results 为各设备执行结果的 JSON
+type BatchTask struct {
+	BaseModel
+
+	UserID     uint       `gorm:"not null;index" json:"user_id"`
+	UserName   string     `gorm:"type:varchar(64)" json:"user_name"`
+	Type       string     `gorm:"type:varchar(16);not null" json:"type"`
+	Content    string     `gorm:"type:text" json:"content"`
+	Status     string     `gorm:"type:varchar(16);not null" json:"status"`
+	Total      int        `gorm:"not null;default:0" json:"total"`
+	Success    int        `gorm:"not null;default:0" json:"success"`
+	Failed     int        `gorm:"not null;default:0" json:"failed"`
+	LogPath    string     `gorm:"type:varchar(256)" json:"log_path"`
+	Results    string     `gorm:"type:text" json:"results"`
+	FinishedAt *time.Time `json:"finished_at"`
+}
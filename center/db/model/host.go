@@ -27,4 +27,8 @@ type Host struct {
 	AgentMode    string `gorm:"type:varchar(16);not null" json:"agent_mode"`
 	AgentKey     string `gorm:"type:varchar(32);not null" json:"agent_key"`
 	AgentVersion string `gorm:"type:varchar(16);not null" json:"agent_version"`
+
+	RecordTerminal bool `gorm:"type:bool;not null;default:false" json:"record_terminal"`
+
+	Tags string `gorm:"type:varchar(256)" json:"tags"` // 逗号分隔，用于批量操作选择设备
 }
@@ -0,0 +1,20 @@
+package model
+
+// HostMetric 设备心跳指标的降采样数据，Timestamp 为时间桶起点（秒），Resolution 为桶宽度（秒）
+type HostMetric struct {
+	ID             uint    `gorm:"primarykey;AUTO_INCREMENT" json:"id"`
+	HostID         uint    `gorm:"not null;uniqueIndex:idx_host_metric" json:"host_id"`
+	Resolution     int     `gorm:"not null;uniqueIndex:idx_host_metric" json:"resolution"`
+	Timestamp      int64   `gorm:"not null;uniqueIndex:idx_host_metric" json:"timestamp"`
+	Samples        int     `gorm:"not null;default:0" json:"samples"`
+	Cpu            float64 `json:"cpu"`
+	CpuMax         float64 `json:"cpu_max"`
+	Memory         float64 `json:"memory"`
+	MemoryMax      float64 `json:"memory_max"`
+	Disk           float64 `json:"disk"`
+	Rx             float64 `json:"rx"`
+	Tx             float64 `json:"tx"`
+	Goroutines     float64 `json:"goroutines"`
+	OpenFDs        float64 `json:"open_fds"`
+	ActiveSessions float64 `json:"active_sessions"`
+}
@@ -0,0 +1,11 @@
+package model
+
+// HostScope 将用户、角色或访问令牌绑定到设备组，UserID、RoleID 与 TokenID 三选一
+type HostScope struct {
+	BaseModel
+
+	UserID      uint `gorm:"not null;default:0;index" json:"user_id"`
+	RoleID      uint `gorm:"not null;default:0;index" json:"role_id"`
+	TokenID     uint `gorm:"not null;default:0;index" json:"token_id"`
+	HostGroupID uint `gorm:"not null" json:"host_group_id"`
+}
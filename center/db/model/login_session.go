@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// LoginSession 登录会话，与签发的 JWT 一一对应，删除即吊销
+type LoginSession struct {
+	BaseModel
+
+	UserID     uint       `gorm:"not null;index" json:"user_id"`
+	SessionID  string     `gorm:"type:varchar(64);not null;uniqueIndex" json:"-"`
+	SourceIP   string     `gorm:"type:varchar(64)" json:"source_ip"`
+	UserAgent  string     `gorm:"type:varchar(256)" json:"user_agent"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	LastSeenAt *time.Time `json:"last_seen_at"`
+}
@@ -0,0 +1,10 @@
+package model
+
+// Permission 角色权限，Resource 对应 api/v1 下的路由组，Access 为 read 或 write
+type Permission struct {
+	BaseModel
+
+	RoleID   uint   `gorm:"not null;index" json:"role_id"`
+	Resource string `gorm:"type:varchar(64);not null" json:"resource"`
+	Access   string `gorm:"type:varchar(16);not null" json:"access"`
+}
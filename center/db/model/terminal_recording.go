@@ -0,0 +1,18 @@
+package model
+
+// TerminalRecording 终端会话录像，内容以 asciicast v2 格式保存在 Path
+type TerminalRecording struct {
+	BaseModel
+
+	HostID   uint    `gorm:"not null;index" json:"host_id"`
+	UserID   uint    `gorm:"not null;default:0" json:"user_id"`
+	UserName string  `gorm:"type:varchar(64)" json:"user_name"`
+	Type     string  `gorm:"type:varchar(16)" json:"type"`
+	Session  string  `gorm:"type:varchar(64)" json:"session"`
+	Path     string  `gorm:"type:varchar(256);not null" json:"path"`
+	Cols     int     `json:"cols"`
+	Rows     int     `json:"rows"`
+	Size     int64   `json:"size"`
+	Duration float64 `json:"duration"`
+	Finished bool    `gorm:"type:bool;not null;default:false" json:"finished"`
+}
@@ -10,4 +10,16 @@ type User struct {
 	RoleID   uint   `gorm:"not null" json:"-"`
 	GroupID  uint   `gorm:"not null" json:"-"`
 	Valid    uint   `gorm:"not null" json:"valid"`
+
+	RecordTerminal bool `gorm:"type:bool;not null;default:false" json:"record_terminal"`
+
+	// 用户来源及其在外部身份源中的唯一标识
+	Source     string `gorm:"type:varchar(16);not null;default:local" json:"source"`
+	ExternalID string `gorm:"type:varchar(256);index" json:"-"`
+
+	// 两步验证，TotpSecret 在启用前为待确认的密钥
+	TotpSecret    string `gorm:"type:varchar(64)" json:"-"`
+	TotpEnabled   bool   `gorm:"type:bool;not null;default:false" json:"totp_enabled"`
+	TotpLastStep  int64  `gorm:"not null;default:0" json:"-"`
+	RecoveryCodes string `gorm:"type:text" json:"-"` // 恢复码摘要，逗号分隔
 }
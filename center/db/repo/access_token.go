@@ -0,0 +1,78 @@
+package repo
+
+import (
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"gorm.io/gorm"
+)
+
+type AccessTokenRepo struct{}
+
+type IAccessTokenRepo interface {
+	Get(opts ...DBOption) (model.AccessToken, error)
+	GetList(opts ...DBOption) ([]model.AccessToken, error)
+	Create(token *model.AccessToken) error
+	Update(id uint, vars map[string]interface{}) error
+	Delete(opts ...DBOption) error
+	WithByID(id uint) DBOption
+	WithByUserID(userID uint) DBOption
+	WithByTokenHash(hash string) DBOption
+}
+
+func NewAccessTokenRepo() IAccessTokenRepo {
+	return &AccessTokenRepo{}
+}
+
+func (r *AccessTokenRepo) Get(opts ...DBOption) (model.AccessToken, error) {
+	var token model.AccessToken
+	db := global.DB.Model(&model.AccessToken{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.First(&token).Error
+	return token, err
+}
+
+func (r *AccessTokenRepo) GetList(opts ...DBOption) ([]model.AccessToken, error) {
+	var tokens []model.AccessToken
+	db := global.DB.Model(&model.AccessToken{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.Find(&tokens).Error
+	return tokens, err
+}
+
+func (r *AccessTokenRepo) Create(token *model.AccessToken) error {
+	return global.DB.Create(token).Error
+}
+
+func (r *AccessTokenRepo) Update(id uint, vars map[string]interface{}) error {
+	return global.DB.Model(&model.AccessToken{}).Where("id = ?", id).Updates(vars).Error
+}
+
+func (r *AccessTokenRepo) Delete(opts ...DBOption) error {
+	db := global.DB
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	return db.Delete(&model.AccessToken{}).Error
+}
+
+func (r *AccessTokenRepo) WithByID(id uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("id = ?", id)
+	}
+}
+
+func (r *AccessTokenRepo) WithByUserID(userID uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("user_id = ?", userID)
+	}
+}
+
+func (r *AccessTokenRepo) WithByTokenHash(hash string) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("token_hash = ?", hash)
+	}
+}
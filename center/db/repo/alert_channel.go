@@ -0,0 +1,71 @@
+package repo
+
+import (
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"gorm.io/gorm"
+)
+
+type AlertChannelRepo struct{}
+
+type IAlertChannelRepo interface {
+	Get(opts ...DBOption) (model.AlertChannel, error)
+	GetList(opts ...DBOption) ([]model.AlertChannel, error)
+	Create(channel *model.AlertChannel) error
+	Update(id uint, vars map[string]interface{}) error
+	Delete(opts ...DBOption) error
+	WithByID(id uint) DBOption
+	WithByIDs(ids []uint) DBOption
+}
+
+func NewAlertChannelRepo() IAlertChannelRepo {
+	return &AlertChannelRepo{}
+}
+
+func (r *AlertChannelRepo) Get(opts ...DBOption) (model.AlertChannel, error) {
+	var channel model.AlertChannel
+	db := global.DB.Model(&model.AlertChannel{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.First(&channel).Error
+	return channel, err
+}
+
+func (r *AlertChannelRepo) GetList(opts ...DBOption) ([]model.AlertChannel, error) {
+	var channels []model.AlertChannel
+	db := global.DB.Model(&model.AlertChannel{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.Find(&channels).Error
+	return channels, err
+}
+
+func (r *AlertChannelRepo) Create(channel *model.AlertChannel) error {
+	return global.DB.Create(channel).Error
+}
+
+func (r *AlertChannelRepo) Update(id uint, vars map[string]interface{}) error {
+	return global.DB.Model(&model.AlertChannel{}).Where("id = ?", id).Updates(vars).Error
+}
+
+func (r *AlertChannelRepo) Delete(opts ...DBOption) error {
+	db := global.DB
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	return db.Delete(&model.AlertChannel{}).Error
+}
+
+func (r *AlertChannelRepo) WithByID(id uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("id = ?", id)
+	}
+}
+
+func (r *AlertChannelRepo) WithByIDs(ids []uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("id IN (?)", ids)
+	}
+}
@@ -0,0 +1,98 @@
+package repo
+
+import (
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"gorm.io/gorm"
+)
+
+type AlertEventRepo struct{}
+
+type IAlertEventRepo interface {
+	Get(opts ...DBOption) (model.AlertEvent, error)
+	GetList(opts ...DBOption) ([]model.AlertEvent, error)
+	Page(page, size int, opts ...DBOption) (int64, []model.AlertEvent, error)
+	Create(event *model.AlertEvent) error
+	Update(id uint, vars map[string]interface{}) error
+	Delete(opts ...DBOption) error
+	WithByID(id uint) DBOption
+	WithByRuleID(ruleID uint) DBOption
+	WithByHostID(hostID uint) DBOption
+	WithByState(state string) DBOption
+}
+
+func NewAlertEventRepo() IAlertEventRepo {
+	return &AlertEventRepo{}
+}
+
+func (r *AlertEventRepo) Get(opts ...DBOption) (model.AlertEvent, error) {
+	var event model.AlertEvent
+	db := global.DB.Model(&model.AlertEvent{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.First(&event).Error
+	return event, err
+}
+
+func (r *AlertEventRepo) GetList(opts ...DBOption) ([]model.AlertEvent, error) {
+	var events []model.AlertEvent
+	db := global.DB.Model(&model.AlertEvent{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.Find(&events).Error
+	return events, err
+}
+
+func (r *AlertEventRepo) Page(page, size int, opts ...DBOption) (int64, []model.AlertEvent, error) {
+	var events []model.AlertEvent
+	db := global.DB.Model(&model.AlertEvent{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	count := int64(0)
+	db = db.Count(&count)
+	err := db.Order("id desc").Limit(size).Offset(size * (page - 1)).Find(&events).Error
+	return count, events, err
+}
+
+func (r *AlertEventRepo) Create(event *model.AlertEvent) error {
+	return global.DB.Create(event).Error
+}
+
+func (r *AlertEventRepo) Update(id uint, vars map[string]interface{}) error {
+	return global.DB.Model(&model.AlertEvent{}).Where("id = ?", id).Updates(vars).Error
+}
+
+func (r *AlertEventRepo) Delete(opts ...DBOption) error {
+	db := global.DB
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	return db.Delete(&model.AlertEvent{}).Error
+}
+
+func (r *AlertEventRepo) WithByID(id uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("id = ?", id)
+	}
+}
+
+func (r *AlertEventRepo) WithByRuleID(ruleID uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("rule_id = ?", ruleID)
+	}
+}
+
+func (r *AlertEventRepo) WithByHostID(hostID uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("host_id = ?", hostID)
+	}
+}
+
+func (r *AlertEventRepo) WithByState(state string) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("state = ?", state)
+	}
+}
@@ -0,0 +1,71 @@
+package repo
+
+import (
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"gorm.io/gorm"
+)
+
+type AlertRuleRepo struct{}
+
+type IAlertRuleRepo interface {
+	Get(opts ...DBOption) (model.AlertRule, error)
+	GetList(opts ...DBOption) ([]model.AlertRule, error)
+	Create(rule *model.AlertRule) error
+	Update(id uint, vars map[string]interface{}) error
+	Delete(opts ...DBOption) error
+	WithByID(id uint) DBOption
+	WithByEnabled() DBOption
+}
+
+func NewAlertRuleRepo() IAlertRuleRepo {
+	return &AlertRuleRepo{}
+}
+
+func (r *AlertRuleRepo) Get(opts ...DBOption) (model.AlertRule, error) {
+	var rule model.AlertRule
+	db := global.DB.Model(&model.AlertRule{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.First(&rule).Error
+	return rule, err
+}
+
+func (r *AlertRuleRepo) GetList(opts ...DBOption) ([]model.AlertRule, error) {
+	var rules []model.AlertRule
+	db := global.DB.Model(&model.AlertRule{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.Find(&rules).Error
+	return rules, err
+}
+
+func (r *AlertRuleRepo) Create(rule *model.AlertRule) error {
+	return global.DB.Create(rule).Error
+}
+
+func (r *AlertRuleRepo) Update(id uint, vars map[string]interface{}) error {
+	return global.DB.Model(&model.AlertRule{}).Where("id = ?", id).Updates(vars).Error
+}
+
+func (r *AlertRuleRepo) Delete(opts ...DBOption) error {
+	db := global.DB
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	return db.Delete(&model.AlertRule{}).Error
+}
+
+func (r *AlertRuleRepo) WithByID(id uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("id = ?", id)
+	}
+}
+
+func (r *AlertRuleRepo) WithByEnabled() DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("enabled = ?", true)
+	}
+}
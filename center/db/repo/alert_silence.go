@@ -0,0 +1,76 @@
+package repo
+
+import (
+	"time"
+
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"gorm.io/gorm"
+)
+
+type AlertSilenceRepo struct{}
+
+type IAlertSilenceRepo interface {
+	Get(opts ...DBOption) (model.AlertSilence, error)
+	GetList(opts ...DBOption) ([]model.AlertSilence, error)
+	Create(silence *model.AlertSilence) error
+	Delete(opts ...DBOption) error
+	WithByID(id uint) DBOption
+	WithByActive(now time.Time) DBOption
+	WithByEndedBefore(t time.Time) DBOption
+}
+
+func NewAlertSilenceRepo() IAlertSilenceRepo {
+	return &AlertSilenceRepo{}
+}
+
+func (r *AlertSilenceRepo) Get(opts ...DBOption) (model.AlertSilence, error) {
+	var silence model.AlertSilence
+	db := global.DB.Model(&model.AlertSilence{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.First(&silence).Error
+	return silence, err
+}
+
+func (r *AlertSilenceRepo) GetList(opts ...DBOption) ([]model.AlertSilence, error) {
+	var silences []model.AlertSilence
+	db := global.DB.Model(&model.AlertSilence{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.Find(&silences).Error
+	return silences, err
+}
+
+func (r *AlertSilenceRepo) Create(silence *model.AlertSilence) error {
+	return global.DB.Create(silence).Error
+}
+
+func (r *AlertSilenceRepo) Delete(opts ...DBOption) error {
+	db := global.DB
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	return db.Delete(&model.AlertSilence{}).Error
+}
+
+func (r *AlertSilenceRepo) WithByID(id uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("id = ?", id)
+	}
+}
+
+// WithByActive 生效中的静默
+func (r *AlertSilenceRepo) WithByActive(now time.Time) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("starts_at <= ? AND ends_at > ?", now, now)
+	}
+}
+
+func (r *AlertSilenceRepo) WithByEndedBefore(t time.Time) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("ends_at < ?", t)
+	}
+}
@@ -0,0 +1,70 @@
+package repo
+
+import (
+	"time"
+
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"gorm.io/gorm"
+)
+
+type AuditLogRepo struct{}
+
+type IAuditLogRepo interface {
+	Page(page, size int, opts ...DBOption) (int64, []model.AuditLog, error)
+	Create(log *model.AuditLog) error
+	WithByUserName(name string) DBOption
+	WithByHostID(hostID uint) DBOption
+	WithByCreatedAt(start, end time.Time) DBOption
+}
+
+func NewAuditLogRepo() IAuditLogRepo {
+	return &AuditLogRepo{}
+}
+
+func (r *AuditLogRepo) Page(page, size int, opts ...DBOption) (int64, []model.AuditLog, error) {
+	var logs []model.AuditLog
+	db := global.DB.Model(&model.AuditLog{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	count := int64(0)
+	db = db.Count(&count)
+	err := db.Order("id desc").Limit(size).Offset(size * (page - 1)).Find(&logs).Error
+	return count, logs, err
+}
+
+func (r *AuditLogRepo) Create(log *model.AuditLog) error {
+	return global.DB.Create(log).Error
+}
+
+func (r *AuditLogRepo) WithByUserName(name string) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		if len(name) == 0 {
+			return g
+		}
+		return g.Where("user_name = ?", name)
+	}
+}
+
+func (r *AuditLogRepo) WithByHostID(hostID uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		if hostID == 0 {
+			return g
+		}
+		return g.Where("host_id = ?", hostID)
+	}
+}
+
+// WithByCreatedAt 零值时间表示该端不限
+func (r *AuditLogRepo) WithByCreatedAt(start, end time.Time) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		if !start.IsZero() {
+			g = g.Where("created_at >= ?", start)
+		}
+		if !end.IsZero() {
+			g = g.Where("created_at <= ?", end)
+		}
+		return g
+	}
+}
@@ -0,0 +1,64 @@
+package repo
+
+import (
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"gorm.io/gorm"
+)
+
+type BatchTaskRepo struct{}
+
+type IBatchTaskRepo interface {
+	Get(opts ...DBOption) (model.BatchTask, error)
+	Page(page, size int, opts ...DBOption) (int64, []model.BatchTask, error)
+	Create(task *model.BatchTask) error
+	Update(id uint, vars map[string]interface{}) error
+	WithByID(id uint) DBOption
+	WithByUserID(userID uint) DBOption
+}
+
+func NewBatchTaskRepo() IBatchTaskRepo {
+	return &BatchTaskRepo{}
+}
+
+func (r *BatchTaskRepo) Get(opts ...DBOption) (model.BatchTask, error) {
+	var task model.BatchTask
+	db := global.DB.Model(&model.BatchTask{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.First(&task).Error
+	return task, err
+}
+
+func (r *BatchTaskRepo) Page(page, size int, opts ...DBOption) (int64, []model.BatchTask, error) {
+	var tasks []model.BatchTask
+	db := global.DB.Model(&model.BatchTask{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	count := int64(0)
+	db = db.Count(&count)
+	err := db.Order("id desc").Limit(size).Offset(size * (page - 1)).Find(&tasks).Error
+	return count, tasks, err
+}
+
+func (r *BatchTaskRepo) Create(task *model.BatchTask) error {
+	return global.DB.Create(task).Error
+}
+
+func (r *BatchTaskRepo) Update(id uint, vars map[string]interface{}) error {
+	return global.DB.Model(&model.BatchTask{}).Where("id = ?", id).Updates(vars).Error
+}
+
+func (r *BatchTaskRepo) WithByID(id uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("id = ?", id)
+	}
+}
+
+func (r *BatchTaskRepo) WithByUserID(userID uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("user_id = ?", userID)
+	}
+}
@@ -17,6 +17,7 @@ type IHostRepo interface {
 	WithByName(name string) DBOption
 	WithByAddr(addr string) DBOption
 	WithByGroupID(groupID uint) DBOption
+	WithByGroupIDs(groupIDs []uint) DBOption
 	Create(host *model.Host) error
 	Update(id uint, vars map[string]interface{}) error
 	Delete(opts ...DBOption) error
@@ -91,6 +92,16 @@ func (c *HostRepo) WithByGroupID(groupID uint) DBOption {
 	}
 }
 
+// WithByGroupIDs groupIDs 为 nil 时不过滤
+func (c *HostRepo) WithByGroupIDs(groupIDs []uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		if groupIDs == nil {
+			return g
+		}
+		return g.Where("group_id in (?)", groupIDs)
+	}
+}
+
 func (r *HostRepo) Create(user *model.Host) error {
 	return global.DB.Create(user).Error
 }
@@ -17,6 +17,7 @@ type IHostGroupRepo interface {
 	Delete(opts ...DBOption) error
 	WithByName(name string) DBOption
 	WithByID(id uint) DBOption
+	WithByIDs(ids []uint) DBOption
 }
 
 func NewHostGroupRepo() IHostGroupRepo {
@@ -82,3 +83,13 @@ func (r *HostGroupRepo) WithByID(id uint) DBOption {
 		return g.Where("id = ?", id)
 	}
 }
+
+// WithByIDs ids 为 nil 时不过滤
+func (r *HostGroupRepo) WithByIDs(ids []uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		if ids == nil {
+			return g
+		}
+		return g.Where("id in (?)", ids)
+	}
+}
@@ -0,0 +1,93 @@
+package repo
+
+import (
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"gorm.io/gorm"
+)
+
+type HostMetricRepo struct{}
+
+type IHostMetricRepo interface {
+	Get(opts ...DBOption) (model.HostMetric, error)
+	GetList(opts ...DBOption) ([]model.HostMetric, error)
+	Create(metric *model.HostMetric) error
+	Save(metric *model.HostMetric) error
+	Delete(opts ...DBOption) error
+	WithByHostID(hostID uint) DBOption
+	WithByResolution(resolution int) DBOption
+	WithByTimestamp(timestamp int64) DBOption
+	WithByTimeRange(from, to int64) DBOption
+	WithByTimestampBefore(timestamp int64) DBOption
+}
+
+func NewHostMetricRepo() IHostMetricRepo {
+	return &HostMetricRepo{}
+}
+
+func (r *HostMetricRepo) Get(opts ...DBOption) (model.HostMetric, error) {
+	var metric model.HostMetric
+	db := global.DB.Model(&model.HostMetric{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.First(&metric).Error
+	return metric, err
+}
+
+func (r *HostMetricRepo) GetList(opts ...DBOption) ([]model.HostMetric, error) {
+	var metrics []model.HostMetric
+	db := global.DB.Model(&model.HostMetric{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.Order("timestamp asc").Find(&metrics).Error
+	return metrics, err
+}
+
+func (r *HostMetricRepo) Create(metric *model.HostMetric) error {
+	return global.DB.Create(metric).Error
+}
+
+func (r *HostMetricRepo) Save(metric *model.HostMetric) error {
+	return global.DB.Save(metric).Error
+}
+
+func (r *HostMetricRepo) Delete(opts ...DBOption) error {
+	db := global.DB
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	return db.Delete(&model.HostMetric{}).Error
+}
+
+func (r *HostMetricRepo) WithByHostID(hostID uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("host_id = ?", hostID)
+	}
+}
+
+func (r *HostMetricRepo) WithByResolution(resolution int) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("resolution = ?", resolution)
+	}
+}
+
+func (r *HostMetricRepo) WithByTimestamp(timestamp int64) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("timestamp = ?", timestamp)
+	}
+}
+
+// WithByTimeRange 包含两端
+func (r *HostMetricRepo) WithByTimeRange(from, to int64) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("timestamp >= ? AND timestamp <= ?", from, to)
+	}
+}
+
+func (r *HostMetricRepo) WithByTimestampBefore(timestamp int64) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("timestamp < ?", timestamp)
+	}
+}
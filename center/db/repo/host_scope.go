@@ -0,0 +1,80 @@
+package repo
+
+import (
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"gorm.io/gorm"
+)
+
+type HostScopeRepo struct{}
+
+type IHostScopeRepo interface {
+	GetList(opts ...DBOption) ([]model.HostScope, error)
+	Replace(subject DBOption, scopes []model.HostScope) error
+	Delete(opts ...DBOption) error
+	WithByUserID(userID uint) DBOption
+	WithByRoleID(roleID uint) DBOption
+	WithByTokenID(tokenID uint) DBOption
+	WithByHostGroupIDs(groupIDs []uint) DBOption
+}
+
+func NewHostScopeRepo() IHostScopeRepo {
+	return &HostScopeRepo{}
+}
+
+func (r *HostScopeRepo) GetList(opts ...DBOption) ([]model.HostScope, error) {
+	var scopes []model.HostScope
+	db := global.DB.Model(&model.HostScope{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.Find(&scopes).Error
+	return scopes, err
+}
+
+// Replace 删除 subject 匹配的原有绑定并写入新的绑定
+func (r *HostScopeRepo) Replace(subject DBOption, scopes []model.HostScope) error {
+	return global.DB.Transaction(func(tx *gorm.DB) error {
+		if err := subject(tx).Delete(&model.HostScope{}).Error; err != nil {
+			return err
+		}
+		for i := range scopes {
+			if err := tx.Create(&scopes[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *HostScopeRepo) Delete(opts ...DBOption) error {
+	db := global.DB
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	return db.Delete(&model.HostScope{}).Error
+}
+
+func (r *HostScopeRepo) WithByUserID(userID uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("user_id = ?", userID)
+	}
+}
+
+func (r *HostScopeRepo) WithByRoleID(roleID uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("role_id = ?", roleID)
+	}
+}
+
+func (r *HostScopeRepo) WithByTokenID(tokenID uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("token_id = ?", tokenID)
+	}
+}
+
+func (r *HostScopeRepo) WithByHostGroupIDs(groupIDs []uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("host_group_id in (?)", groupIDs)
+	}
+}
@@ -0,0 +1,87 @@
+package repo
+
+import (
+	"time"
+
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"gorm.io/gorm"
+)
+
+type LoginSessionRepo struct{}
+
+type ILoginSessionRepo interface {
+	Get(opts ...DBOption) (model.LoginSession, error)
+	GetList(opts ...DBOption) ([]model.LoginSession, error)
+	Create(session *model.LoginSession) error
+	Update(id uint, vars map[string]interface{}) error
+	Delete(opts ...DBOption) error
+	WithByID(id uint) DBOption
+	WithByUserID(userID uint) DBOption
+	WithBySessionID(sessionID string) DBOption
+	WithByExpiredBefore(t time.Time) DBOption
+}
+
+func NewLoginSessionRepo() ILoginSessionRepo {
+	return &LoginSessionRepo{}
+}
+
+func (r *LoginSessionRepo) Get(opts ...DBOption) (model.LoginSession, error) {
+	var session model.LoginSession
+	db := global.DB.Model(&model.LoginSession{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.First(&session).Error
+	return session, err
+}
+
+func (r *LoginSessionRepo) GetList(opts ...DBOption) ([]model.LoginSession, error) {
+	var sessions []model.LoginSession
+	db := global.DB.Model(&model.LoginSession{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.Order("id desc").Find(&sessions).Error
+	return sessions, err
+}
+
+func (r *LoginSessionRepo) Create(session *model.LoginSession) error {
+	return global.DB.Create(session).Error
+}
+
+func (r *LoginSessionRepo) Update(id uint, vars map[string]interface{}) error {
+	return global.DB.Model(&model.LoginSession{}).Where("id = ?", id).Updates(vars).Error
+}
+
+func (r *LoginSessionRepo) Delete(opts ...DBOption) error {
+	db := global.DB
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	return db.Delete(&model.LoginSession{}).Error
+}
+
+func (r *LoginSessionRepo) WithByID(id uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("id = ?", id)
+	}
+}
+
+func (r *LoginSessionRepo) WithByUserID(userID uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("user_id = ?", userID)
+	}
+}
+
+func (r *LoginSessionRepo) WithBySessionID(sessionID string) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("session_id = ?", sessionID)
+	}
+}
+
+func (r *LoginSessionRepo) WithByExpiredBefore(t time.Time) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("expires_at < ?", t)
+	}
+}
@@ -0,0 +1,61 @@
+package repo
+
+import (
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"gorm.io/gorm"
+)
+
+type PermissionRepo struct{}
+
+type IPermissionRepo interface {
+	GetList(opts ...DBOption) ([]model.Permission, error)
+	Replace(roleID uint, permissions []model.Permission) error
+	Delete(opts ...DBOption) error
+	WithByRoleID(roleID uint) DBOption
+}
+
+func NewPermissionRepo() IPermissionRepo {
+	return &PermissionRepo{}
+}
+
+func (r *PermissionRepo) GetList(opts ...DBOption) ([]model.Permission, error) {
+	var permissions []model.Permission
+	db := global.DB.Model(&model.Permission{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.Find(&permissions).Error
+	return permissions, err
+}
+
+// Replace 用新的权限列表整体替换角色原有权限
+func (r *PermissionRepo) Replace(roleID uint, permissions []model.Permission) error {
+	return global.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("role_id = ?", roleID).Delete(&model.Permission{}).Error; err != nil {
+			return err
+		}
+		for i := range permissions {
+			permissions[i].ID = 0
+			permissions[i].RoleID = roleID
+			if err := tx.Create(&permissions[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *PermissionRepo) Delete(opts ...DBOption) error {
+	db := global.DB
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	return db.Delete(&model.Permission{}).Error
+}
+
+func (r *PermissionRepo) WithByRoleID(roleID uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("role_id = ?", roleID)
+	}
+}
@@ -11,6 +11,11 @@ type RoleRepo struct{}
 type IRoleRepo interface {
 	Get(opts ...DBOption) (model.Role, error)
 	GetList(opts ...DBOption) ([]model.Role, error)
+	Page(page, size int, opts ...DBOption) (int64, []model.Role, error)
+	Create(role *model.Role) error
+	Update(id uint, vars map[string]interface{}) error
+	Delete(opts ...DBOption) error
+	WithByID(id uint) DBOption
 	WithByName(name string) DBOption
 }
 
@@ -38,6 +43,40 @@ func (r *RoleRepo) GetList(opts ...DBOption) ([]model.Role, error) {
 	return roles, err
 }
 
+func (r *RoleRepo) Page(page, size int, opts ...DBOption) (int64, []model.Role, error) {
+	var roles []model.Role
+	db := global.DB.Model(&model.Role{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	count := int64(0)
+	db = db.Count(&count)
+	err := db.Limit(size).Offset(size * (page - 1)).Find(&roles).Error
+	return count, roles, err
+}
+
+func (r *RoleRepo) Create(role *model.Role) error {
+	return global.DB.Create(role).Error
+}
+
+func (r *RoleRepo) Update(id uint, vars map[string]interface{}) error {
+	return global.DB.Model(&model.Role{}).Where("id = ?", id).Updates(vars).Error
+}
+
+func (r *RoleRepo) Delete(opts ...DBOption) error {
+	db := global.DB
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	return db.Delete(&model.Role{}).Error
+}
+
+func (r *RoleRepo) WithByID(id uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("id = ?", id)
+	}
+}
+
 func (r *RoleRepo) WithByName(name string) DBOption {
 	return func(g *gorm.DB) *gorm.DB {
 		return g.Where("name = ?", name)
@@ -0,0 +1,64 @@
+package repo
+
+import (
+	"github.com/sensdata/idb/center/db/model"
+	"github.com/sensdata/idb/center/global"
+	"gorm.io/gorm"
+)
+
+type TerminalRecordingRepo struct{}
+
+type ITerminalRecordingRepo interface {
+	Get(opts ...DBOption) (model.TerminalRecording, error)
+	Page(page, size int, opts ...DBOption) (int64, []model.TerminalRecording, error)
+	Create(recording *model.TerminalRecording) error
+	Update(id uint, vars map[string]interface{}) error
+	WithByID(id uint) DBOption
+	WithByHostID(hostID uint) DBOption
+}
+
+func NewTerminalRecordingRepo() ITerminalRecordingRepo {
+	return &TerminalRecordingRepo{}
+}
+
+func (r *TerminalRecordingRepo) Get(opts ...DBOption) (model.TerminalRecording, error) {
+	var recording model.TerminalRecording
+	db := global.DB.Model(&model.TerminalRecording{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	err := db.First(&recording).Error
+	return recording, err
+}
+
+func (r *TerminalRecordingRepo) Page(page, size int, opts ...DBOption) (int64, []model.TerminalRecording, error) {
+	var recordings []model.TerminalRecording
+	db := global.DB.Model(&model.TerminalRecording{})
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	count := int64(0)
+	db = db.Count(&count)
+	err := db.Order("id desc").Limit(size).Offset(size * (page - 1)).Find(&recordings).Error
+	return count, recordings, err
+}
+
+func (r *TerminalRecordingRepo) Create(recording *model.TerminalRecording) error {
+	return global.DB.Create(recording).Error
+}
+
+func (r *TerminalRecordingRepo) Update(id uint, vars map[string]interface{}) error {
+	return global.DB.Model(&model.TerminalRecording{}).Where("id = ?", id).Updates(vars).Error
+}
+
+func (r *TerminalRecordingRepo) WithByID(id uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("id = ?", id)
+	}
+}
+
+func (r *TerminalRecordingRepo) WithByHostID(hostID uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("host_id = ?", hostID)
+	}
+}
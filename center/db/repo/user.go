@@ -14,6 +14,9 @@ type IUserRepo interface {
 	Page(page, size int, opts ...DBOption) (int64, []model.User, error)
 	WithByID(id uint) DBOption
 	WithByName(name string) DBOption
+	WithByRoleID(roleID uint) DBOption
+	WithBySource(source string) DBOption
+	WithByExternalID(externalID string) DBOption
 	Create(user *model.User) error
 	Update(id uint, vars map[string]interface{}) error
 	Delete(opts ...DBOption) error
@@ -67,6 +70,24 @@ func (r *UserRepo) WithByName(name string) DBOption {
 	}
 }
 
+func (r *UserRepo) WithByRoleID(roleID uint) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("role_id = ?", roleID)
+	}
+}
+
+func (r *UserRepo) WithBySource(source string) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("source = ?", source)
+	}
+}
+
+func (r *UserRepo) WithByExternalID(externalID string) DBOption {
+	return func(g *gorm.DB) *gorm.DB {
+		return g.Where("external_id = ?", externalID)
+	}
+}
+
 func (r *UserRepo) Create(user *model.User) error {
 	return global.DB.Create(user).Error
 }
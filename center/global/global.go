@@ -13,6 +13,9 @@ import (
 	"gorm.io/gorm"
 )
 
+// InternalTokenHeader 携带 InternalToken 的请求头
+const InternalTokenHeader = "Idb-Internal-Token"
+
 var (
 	Version    string    = "0.0.1"
 	Host       string    = "127.0.0.1"
@@ -20,6 +23,9 @@ var (
 	JWTKey     string    = ""
 	StartedAt  time.Time = time.Now()
 
+	// InternalToken 进程内插件回调 api 时使用的凭据，每次启动随机生成
+	InternalToken string
+
 	LOG       *log.Log
 	LogStream *logstream.LogStream
 	DB        *gorm.DB
@@ -24,6 +24,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/sensdata/idb/center/config"
+	"github.com/sensdata/idb/center/core/alert"
 	"github.com/sensdata/idb/center/core/api"
 	"github.com/sensdata/idb/center/core/command"
 	"github.com/sensdata/idb/center/core/conn"
@@ -230,6 +231,22 @@ func StartServices() error {
 	}
 	conn.CENTER = center
 
+	// 启动告警引擎
+	global.LOG.Info("Init alert")
+	alert.ENGINE = alert.NewEngine()
+	if err := alert.ENGINE.Start(); err != nil {
+		global.LOG.Error("Failed to start alert engine: %v", err)
+		return err
+	}
+
+	// 插件回调 api 的内部凭据
+	internalToken, err := utils.GenerateSecureToken(32)
+	if err != nil {
+		global.LOG.Error("Failed to generate internal token: %v", err)
+		return err
+	}
+	global.InternalToken = internalToken
+
 	// 初始化路由
 	global.LOG.Info("Init api")
 	api.API.InitRouter()
@@ -266,6 +283,13 @@ func StopServices() error {
 		global.LOG.Error("停止 API 服务器失败: %v", err)
 	}
 
+	// 停止告警引擎
+	if alert.ENGINE != nil {
+		if err := alert.ENGINE.Stop(); err != nil {
+			global.LOG.Error("停止告警引擎失败: %v", err)
+		}
+	}
+
 	// 停止Agent服务
 	if err := conn.CENTER.Stop(); err != nil {
 		global.LOG.Error("停止Agent服务失败: %v", err)
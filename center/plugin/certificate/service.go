@@ -121,7 +121,8 @@ func (s *CertificateMan) Start() {
 
 	s.restyClient = resty.New().
 		SetBaseURL(baseUrl).
-		SetHeader("Content-Type", "application/json")
+		SetHeader("Content-Type", "application/json").
+		SetHeader(global.InternalTokenHeader, global.InternalToken)
 
 	if settingInfo.Https == "yes" {
 		// 创建 TLS 配置
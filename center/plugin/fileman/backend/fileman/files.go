@@ -7,6 +7,7 @@ import (
 	"mime/multipart"
 	"net"
 	"net/http"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -18,13 +19,18 @@ import (
 	"github.com/sensdata/idb/core/constant"
 	"github.com/sensdata/idb/core/logstream/pkg/reader/adapters"
 	"github.com/sensdata/idb/core/logstream/pkg/types"
+	"github.com/sensdata/idb/core/logstream/pkg/writer"
 	"github.com/sensdata/idb/core/message"
 	"github.com/sensdata/idb/core/model"
 	"github.com/sensdata/idb/core/utils"
 )
 
 const (
-	heartbeatInterval = 10 * time.Second // 心跳间隔
+	heartbeatInterval    = 10 * time.Second       // 心跳间隔
+	progressLogInterval  = 5 * time.Second        // 传输及下载进度的日志间隔
+	wgetPollInterval     = time.Second            // 查询下载进度的间隔
+	transferPollInterval = time.Second            // 查询打包、解包进度的间隔
+	grepPollInterval     = 500 * time.Millisecond // 查询内容搜索结果的间隔
 )
 
 func (s *FileMan) sendAction(actionRequest model.HostAction) (*model.ActionResponse, error) {
@@ -177,10 +183,10 @@ func (s *FileMan) create(hostID uint64, op model.FileCreate) error {
 
 	return nil
 }
-func (s *FileMan) delete(hostID uint64, op model.FileDelete) error {
+func (s *FileMan) delete(hostID uint64, op model.FileDelete) (*model.FileDeleteResult, error) {
 	data, err := utils.ToJSONString(op)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	actionRequest := model.HostAction{
@@ -193,20 +199,27 @@ func (s *FileMan) delete(hostID uint64, op model.FileDelete) error {
 
 	actionResponse, err := s.sendAction(actionRequest)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !actionResponse.Data.Action.Result {
 		global.LOG.Error("action failed")
-		return fmt.Errorf("failed to delete")
+		return nil, fmt.Errorf("failed to delete")
 	}
 
-	return nil
+	// 旧版 agent 不返回删除结果
+	result := model.FileDeleteResult{Purged: []string{}}
+	if data := actionResponse.Data.Action.Data; data != "" {
+		if err := utils.FromJSONString(data, &result); err != nil {
+			return nil, err
+		}
+	}
+	return &result, nil
 }
-func (s *FileMan) batchDelete(hostID uint64, op model.FileBatchDelete) error {
+func (s *FileMan) batchDelete(hostID uint64, op model.FileBatchDelete) (*model.FileDeleteResult, error) {
 	data, err := utils.ToJSONString(op)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	actionRequest := model.HostAction{
@@ -219,15 +232,22 @@ func (s *FileMan) batchDelete(hostID uint64, op model.FileBatchDelete) error {
 
 	actionResponse, err := s.sendAction(actionRequest)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !actionResponse.Data.Action.Result {
 		global.LOG.Error("action failed")
-		return fmt.Errorf("failed to batch delete")
+		return nil, fmt.Errorf("failed to batch delete")
 	}
 
-	return nil
+	// 旧版 agent 不返回删除结果
+	result := model.FileDeleteResult{Purged: []string{}}
+	if data := actionResponse.Data.Action.Data; data != "" {
+		if err := utils.FromJSONString(data, &result); err != nil {
+			return nil, err
+		}
+	}
+	return &result, nil
 }
 func (s *FileMan) compress(hostID uint64, op model.FileCompress) error {
 	data, err := utils.ToJSONString(op)
@@ -878,3 +898,449 @@ func (s *FileMan) deleteFavorite(hostID uint64, req model.FavoriteDelete) error
 
 	return nil
 }
+
+// fileAction 发送文件相关的 action，失败时返回 agent 的错误信息，result 不为空时解析返回的数据
+func (s *FileMan) fileAction(hostID uint, action string, req interface{}, result interface{}) error {
+	data, err := utils.ToJSONString(req)
+	if err != nil {
+		return err
+	}
+	actionResponse, err := s.sendAction(model.HostAction{
+		HostID: hostID,
+		Action: model.Action{
+			Action: action,
+			Data:   data,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if !actionResponse.Data.Action.Result {
+		return errors.New(actionResponse.Data.Action.Data)
+	}
+	if result != nil {
+		return utils.FromJSONString(actionResponse.Data.Action.Data, result)
+	}
+	return nil
+}
+
+func (s *FileMan) transfer(hostID uint, req model.FileTransfer) (*model.FileTransferInfo, error) {
+	hostRepo := repo.NewHostRepo()
+	if _, err := hostRepo.Get(hostRepo.WithByID(req.DestHostID)); err != nil {
+		return nil, fmt.Errorf("dest host %d not found", req.DestHostID)
+	}
+	defaultHost, err := hostRepo.Get(hostRepo.WithByDefault())
+	if err != nil {
+		return nil, err
+	}
+	task, err := global.LogStream.CreateTask(types.TaskTypeFile, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &transferJob{srcHostID: hostID, destHostID: req.DestHostID, cancel: make(chan struct{})}
+	s.transfers.Store(task.ID, job)
+	go func() {
+		defer s.transfers.Delete(task.ID)
+		s.runTransfer(task.ID, hostID, req, job.cancel)
+	}()
+
+	return &model.FileTransferInfo{
+		ID:      task.ID,
+		LogHost: defaultHost.ID,
+		LogPath: task.LogPath,
+	}, nil
+}
+
+// transferJob 进行中的跨设备传输，取消时用于校验设备范围
+type transferJob struct {
+	srcHostID  uint
+	destHostID uint
+	cancel     chan struct{}
+}
+
+// getTransfer 返回源设备为 hostID 的传输
+func (s *FileMan) getTransfer(hostID uint, id string) (*transferJob, bool) {
+	value, ok := s.transfers.Load(id)
+	if !ok || value.(*transferJob).srcHostID != hostID {
+		return nil, false
+	}
+	return value.(*transferJob), true
+}
+
+func (s *FileMan) cancelTransfer(req model.FileTransferCancel) error {
+	value, ok := s.transfers.LoadAndDelete(req.ID)
+	if !ok {
+		return fmt.Errorf("transfer %s not found", req.ID)
+	}
+	close(value.(*transferJob).cancel)
+	return nil
+}
+
+// runTransfer 逐个处理源文件：在源设备打包，经 center 续传到目标设备后解包，移动时再删除源文件
+func (s *FileMan) runTransfer(taskID string, hostID uint, req model.FileTransfer, cancel <-chan struct{}) {
+	if err := global.LogStream.UpdateTaskStatus(taskID, types.TaskStatusRunning); err != nil {
+		global.LOG.Error("Failed to update task status: %v", err)
+	}
+	var w *writer.Writer
+	if tw, err := global.LogStream.GetWriter(taskID); err != nil {
+		global.LOG.Error("Failed to get log writer for task %s: %v", taskID, err)
+	} else {
+		w = &tw
+	}
+	logf := func(level types.LogLevel, format string, args ...interface{}) {
+		if w != nil {
+			if err := (*w).Write(level, fmt.Sprintf(format, args...), map[string]string{}); err != nil {
+				global.LOG.Error("Failed to write log to writer: %v", err)
+			}
+		}
+	}
+
+	defer func() {
+		for _, id := range []uint{hostID, req.DestHostID} {
+			if err := s.fileAction(id, model.File_Transfer_Clean, model.FileTransferClean{ID: taskID}, nil); err != nil {
+				global.LOG.Warn("Failed to clean transfer %s on host %d: %v", taskID, id, err)
+			}
+		}
+	}()
+
+	logf(types.LogLevelInfo, "%s %d items from host %d to host %d:%s", req.Type, len(req.Sources), hostID, req.DestHostID, req.Dest)
+	status := types.TaskStatusSuccess
+	for i, source := range req.Sources {
+		err := s.transferOne(fmt.Sprintf("%s-%d", taskID, i), hostID, source, req, cancel, logf)
+		if err == nil {
+			continue
+		}
+		select {
+		case <-cancel:
+			logf(types.LogLevelWarn, "transfer canceled")
+			status = types.TaskStatusCanceled
+		default:
+			logf(types.LogLevelError, "%s: %v", source, err)
+			status = types.TaskStatusFailed
+		}
+		break
+	}
+	if status == types.TaskStatusSuccess {
+		logf(types.LogLevelInfo, "transfer completed")
+	}
+
+	// 稍后更新状态，保证日志已被读取
+	time.Sleep(time.Second)
+	if err := global.LogStream.UpdateTaskStatus(taskID, status); err != nil {
+		global.LOG.Error("Failed to update task status to %s : %v", status, err)
+	}
+}
+
+func (s *FileMan) transferOne(id string, hostID uint, source string, req model.FileTransfer, cancel <-chan struct{}, logf func(types.LogLevel, string, ...interface{})) error {
+	logf(types.LogLevelInfo, "%s: packing", source)
+	if err := s.fileAction(hostID, model.File_Transfer_Pack, model.FileTransferPack{ID: id, Source: source}, nil); err != nil {
+		return err
+	}
+	packed, err := s.waitTransfer(hostID, model.FileTransferQuery{ID: id, Op: "pack"}, cancel)
+	if err != nil {
+		return err
+	}
+	archive := packed.Archive
+
+	logf(types.LogLevelInfo, "%s: sending %d bytes", source, archive.Size)
+	var lastLog time.Time
+	progress := func(received int64) {
+		if received < archive.Size && time.Since(lastLog) < progressLogInterval {
+			return
+		}
+		lastLog = time.Now()
+		logf(types.LogLevelInfo, "%s: %d/%d bytes", source, received, archive.Size)
+	}
+	// 与源设备的包使用不同的名称，源设备与目标设备相同时不会互相覆盖
+	remote := filepath.Join(constant.AgentDataDir, "transfer", id+".recv.tar")
+	if err := conn.CENTER.RelayFile(hostID, archive.Path, archive.Size, archive.Hash, req.DestHostID, remote, cancel, progress); err != nil {
+		return err
+	}
+	if hostID != req.DestHostID {
+		// 尽早删除源设备上的包，减少占用的空间
+		if err := s.fileAction(hostID, model.File_Transfer_Clean, model.FileTransferClean{ID: id}, nil); err != nil {
+			global.LOG.Warn("Failed to clean transfer %s on host %d: %v", id, hostID, err)
+		}
+	}
+
+	logf(types.LogLevelInfo, "%s: unpacking", source)
+	err = s.fileAction(req.DestHostID, model.File_Transfer_Unpack, model.FileTransferUnpack{
+		ID:       id,
+		Archive:  remote,
+		Dest:     req.Dest,
+		Conflict: req.Conflict,
+	}, nil)
+	if err != nil {
+		return err
+	}
+	unpacked, err := s.waitTransfer(req.DestHostID, model.FileTransferQuery{ID: id, Op: "unpack"}, cancel)
+	if err != nil {
+		return err
+	}
+	if unpacked.Unpacked.Skipped {
+		logf(types.LogLevelWarn, "%s: %s already exists, skipped", source, unpacked.Unpacked.Path)
+		return nil
+	}
+	logf(types.LogLevelInfo, "%s: saved to %s", source, unpacked.Unpacked.Path)
+
+	if req.Type == "cut" {
+		if err := s.fileAction(hostID, model.File_Delete, model.FileDelete{Path: source, ForceDelete: true}, nil); err != nil {
+			return fmt.Errorf("failed to delete source: %v", err)
+		}
+		logf(types.LogLevelInfo, "%s: source deleted", source)
+	}
+	return nil
+}
+
+// waitTransfer 定期查询 agent 上打包或解包的进度，直到完成、失败或取消
+func (s *FileMan) waitTransfer(hostID uint, query model.FileTransferQuery, cancel <-chan struct{}) (*model.FileTransferStatus, error) {
+	ticker := time.NewTicker(transferPollInterval)
+	defer ticker.Stop()
+	var failures int
+	for {
+		select {
+		case <-cancel:
+			return nil, errors.New("canceled")
+		case <-ticker.C:
+		}
+
+		var status model.FileTransferStatus
+		if err := s.fileAction(hostID, model.File_Transfer_Status, query, &status); err != nil {
+			// agent 暂时不可达时继续查询，任务丢失（如 agent 重启）时结束
+			failures++
+			if failures < 30 && !strings.Contains(err.Error(), "not found") {
+				continue
+			}
+			return nil, fmt.Errorf("failed to query %s progress: %v", query.Op, err)
+		}
+		failures = 0
+		switch status.State {
+		case "done":
+			if status.Archive == nil && status.Unpacked == nil {
+				return nil, fmt.Errorf("%s returned no result", query.Op)
+			}
+			return &status, nil
+		case "failed":
+			return nil, errors.New(status.Error)
+		}
+	}
+}
+
+func (s *FileMan) wget(hostID uint, req model.FileWget) (*model.FileWgetInfo, error) {
+	hostRepo := repo.NewHostRepo()
+	defaultHost, err := hostRepo.Get(hostRepo.WithByDefault())
+	if err != nil {
+		return nil, err
+	}
+
+	// 先在 agent 上开始下载，参数错误时直接返回
+	var res model.FileWgetRes
+	if err := s.fileAction(hostID, model.File_Wget, req, &res); err != nil {
+		return nil, err
+	}
+
+	task, err := global.LogStream.CreateTask(types.TaskTypeFile, nil)
+	if err != nil {
+		if err := s.fileAction(hostID, model.File_Wget_Cancel, model.FileWgetQuery{Key: res.Key}, nil); err != nil {
+			global.LOG.Warn("Failed to cancel wget %s on host %d: %v", res.Key, hostID, err)
+		}
+		return nil, err
+	}
+
+	cancel := make(chan struct{})
+	s.wgets.Store(task.ID, cancel)
+	go func() {
+		defer s.wgets.Delete(task.ID)
+		s.runWget(task.ID, hostID, req.Url, res.Key, cancel)
+	}()
+
+	return &model.FileWgetInfo{
+		ID:      task.ID,
+		LogHost: defaultHost.ID,
+		LogPath: task.LogPath,
+	}, nil
+}
+
+func (s *FileMan) cancelWget(req model.FileWgetCancel) error {
+	value, ok := s.wgets.LoadAndDelete(req.ID)
+	if !ok {
+		return fmt.Errorf("download %s not found", req.ID)
+	}
+	close(value.(chan struct{}))
+	return nil
+}
+
+// runWget 定期查询 agent 上的下载进度并写入日志，直到下载结束
+func (s *FileMan) runWget(taskID string, hostID uint, url string, key string, cancel <-chan struct{}) {
+	if err := global.LogStream.UpdateTaskStatus(taskID, types.TaskStatusRunning); err != nil {
+		global.LOG.Error("Failed to update task status: %v", err)
+	}
+	var w *writer.Writer
+	if tw, err := global.LogStream.GetWriter(taskID); err != nil {
+		global.LOG.Error("Failed to get log writer for task %s: %v", taskID, err)
+	} else {
+		w = &tw
+	}
+	logf := func(level types.LogLevel, format string, args ...interface{}) {
+		if w != nil {
+			if err := (*w).Write(level, fmt.Sprintf(format, args...), map[string]string{}); err != nil {
+				global.LOG.Error("Failed to write log to writer: %v", err)
+			}
+		}
+	}
+
+	logf(types.LogLevelInfo, "downloading %s on host %d", url, hostID)
+	query := model.FileWgetQuery{Key: key}
+	ticker := time.NewTicker(wgetPollInterval)
+	defer ticker.Stop()
+	var lastLog time.Time
+	var failures int
+	status := types.TaskStatusFailed
+	for {
+		select {
+		case <-cancel:
+			cancel = nil
+			if err := s.fileAction(hostID, model.File_Wget_Cancel, query, nil); err != nil {
+				logf(types.LogLevelError, "failed to cancel: %v", err)
+			}
+			continue
+		case <-ticker.C:
+		}
+
+		var st model.FileWgetStatus
+		if err := s.fileAction(hostID, model.File_Wget_Status, query, &st); err != nil {
+			// agent 暂时不可达时继续查询，下载记录丢失（如 agent 重启）时结束
+			failures++
+			if failures < 30 && !strings.Contains(err.Error(), "not found") {
+				continue
+			}
+			logf(types.LogLevelError, "failed to query progress: %v", err)
+			break
+		}
+		failures = 0
+
+		if st.State == "running" {
+			if time.Since(lastLog) >= progressLogInterval {
+				lastLog = time.Now()
+				if st.Total >= 0 {
+					logf(types.LogLevelInfo, "%d/%d bytes", st.Written, st.Total)
+				} else {
+					logf(types.LogLevelInfo, "%d bytes", st.Written)
+				}
+			}
+			continue
+		}
+		switch st.State {
+		case "done":
+			logf(types.LogLevelInfo, "saved to %s, %d bytes", st.Path, st.Written)
+			status = types.TaskStatusSuccess
+		case "canceled":
+			logf(types.LogLevelWarn, "download canceled")
+			status = types.TaskStatusCanceled
+		default:
+			logf(types.LogLevelError, "download failed: %s", st.Error)
+		}
+		break
+	}
+
+	// 稍后更新状态，保证日志已被读取
+	time.Sleep(time.Second)
+	if err := global.LogStream.UpdateTaskStatus(taskID, status); err != nil {
+		global.LOG.Error("Failed to update task status to %s : %v", status, err)
+	}
+}
+
+// grepStream 在 agent 上开始内容搜索，定期读取新的结果并通过 SSE 推送，客户端断开时取消搜索
+func (s *FileMan) grepStream(c *gin.Context, hostID uint, req model.FileGrep) error {
+	var res model.FileGrepRes
+	if err := s.fileAction(hostID, model.File_Grep, req, &res); err != nil {
+		return err
+	}
+	query := model.FileGrepQuery{Key: res.Key}
+	ctx := c.Request.Context()
+	defer func() {
+		if ctx.Err() == nil {
+			return
+		}
+		if err := s.fileAction(hostID, model.File_Grep_Cancel, query, nil); err != nil {
+			global.LOG.Warn("Failed to cancel grep %s on host %d: %v", query.Key, hostID, err)
+		}
+	}()
+
+	flusher, ok := c.Writer.(http.Flusher)
+	if !ok {
+		return fmt.Errorf("streaming not supported")
+	}
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+	c.Writer.Header().Set("Transfer-Encoding", "chunked")
+
+	poll := time.NewTicker(grepPollInterval)
+	defer poll.Stop()
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-heartbeat.C:
+			c.SSEvent("heartbeat", time.Now().Unix())
+			flusher.Flush()
+			continue
+		case <-poll.C:
+		}
+
+		var result model.FileGrepResult
+		if err := s.fileAction(hostID, model.File_Grep_Result, query, &result); err != nil {
+			c.SSEvent("error", err.Error())
+			flusher.Flush()
+			return nil
+		}
+		for _, match := range result.Matches {
+			c.SSEvent("match", match)
+		}
+		query.Offset += len(result.Matches)
+		result.Matches = nil
+		if result.Done {
+			c.SSEvent("done", result)
+			flusher.Flush()
+			return nil
+		}
+		c.SSEvent("progress", result)
+		flusher.Flush()
+	}
+}
+
+func (s *FileMan) trashList(hostID uint, req model.TrashList) (*model.PageResult, error) {
+	var pageResult model.PageResult
+	if err := s.fileAction(hostID, model.File_Trash_List, req, &pageResult); err != nil {
+		return &pageResult, err
+	}
+	return &pageResult, nil
+}
+
+func (s *FileMan) trashRestore(hostID uint, req model.TrashRestore) (*model.TrashItem, error) {
+	var item model.TrashItem
+	if err := s.fileAction(hostID, model.File_Trash_Restore, req, &item); err != nil {
+		return &item, err
+	}
+	return &item, nil
+}
+
+func (s *FileMan) trashPurge(hostID uint, req model.TrashPurge) error {
+	return s.fileAction(hostID, model.File_Trash_Purge, req, nil)
+}
+
+func (s *FileMan) trashSettings(hostID uint) (*model.TrashSettings, error) {
+	var settings model.TrashSettings
+	if err := s.fileAction(hostID, model.File_Trash_Settings, nil, &settings); err != nil {
+		return &settings, err
+	}
+	return &settings, nil
+}
+
+func (s *FileMan) setTrashSettings(hostID uint, req model.TrashSettings) error {
+	return s.fileAction(hostID, model.File_Trash_Set, req, nil)
+}
@@ -9,12 +9,14 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 
 	_ "embed"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-resty/resty/v2"
 	"github.com/sensdata/idb/center/core/api"
+	"github.com/sensdata/idb/center/core/api/middleware"
 	"github.com/sensdata/idb/center/core/api/service"
 	"github.com/sensdata/idb/center/global"
 	"gopkg.in/yaml.v2"
@@ -31,6 +33,8 @@ type FileMan struct {
 	plugin      plugin.Plugin
 	pluginConf  plugin.PluginConf
 	restyClient *resty.Client
+	transfers   sync.Map // 进行中的跨设备传输，任务ID -> *transferJob
+	wgets       sync.Map // 进行中的远程下载，任务ID -> 取消通道
 }
 
 var LOG *log.Log
@@ -95,6 +99,7 @@ func (s *FileMan) Initialize() {
 			{Method: "GET", Path: "/:host/trees", Handler: s.GetFileTree},
 			{Method: "GET", Path: "/:host", Handler: s.GetFileList},
 			{Method: "GET", Path: "/:host/search", Handler: s.SearchFile},
+			{Method: "POST", Path: "/:host/grep", Handler: s.GrepFile},
 			{Method: "POST", Path: "/:host", Handler: s.CreateFile},
 			{Method: "DELETE", Path: "/:host", Handler: s.DeleteFile},
 			{Method: "DELETE", Path: "/:host/batch", Handler: s.BatchDeleteFile},
@@ -110,6 +115,10 @@ func (s *FileMan) Initialize() {
 			{Method: "GET", Path: "/:host/size", Handler: s.Size},
 			{Method: "PUT", Path: "/:host/rename", Handler: s.ChangeFileName},
 			{Method: "PUT", Path: "/:host/move", Handler: s.MoveFile},
+			{Method: "POST", Path: "/:host/transfer", Handler: s.TransferFile},
+			{Method: "POST", Path: "/:host/transfer/cancel", Handler: s.CancelTransfer},
+			{Method: "POST", Path: "/:host/wget", Handler: s.WgetFile},
+			{Method: "POST", Path: "/:host/wget/cancel", Handler: s.CancelWget},
 			{Method: "PUT", Path: "/:host/owner", Handler: s.ChangeFileOwner},
 			{Method: "PUT", Path: "/:host/mode", Handler: s.ChangeFileMode},
 			{Method: "PUT", Path: "/:host/batch/mode", Handler: s.BatchChangeMode},
@@ -117,6 +126,11 @@ func (s *FileMan) Initialize() {
 			{Method: "GET", Path: "/:host/favorites", Handler: s.GetFavoriteList},
 			{Method: "POST", Path: "/:host/favorites", Handler: s.CreateFavorite},
 			{Method: "DELETE", Path: "/:host/favorites", Handler: s.DeleteFavorite},
+			{Method: "GET", Path: "/:host/trash", Handler: s.GetTrashList},
+			{Method: "POST", Path: "/:host/trash/restore", Handler: s.RestoreTrash},
+			{Method: "DELETE", Path: "/:host/trash", Handler: s.PurgeTrash},
+			{Method: "GET", Path: "/:host/trash/settings", Handler: s.GetTrashSettings},
+			{Method: "PUT", Path: "/:host/trash/settings", Handler: s.UpdateTrashSettings},
 		},
 	)
 
@@ -140,7 +154,8 @@ func (s *FileMan) Start() {
 
 	s.restyClient = resty.New().
 		SetBaseURL(baseUrl).
-		SetHeader("Content-Type", "application/json")
+		SetHeader("Content-Type", "application/json").
+		SetHeader(global.InternalTokenHeader, global.InternalToken)
 
 	if settingInfo.Https == "yes" {
 		// 创建 TLS 配置
@@ -378,6 +393,33 @@ func (s *FileMan) SearchFile(c *gin.Context) {
 	helper.SuccessWithData(c, files)
 }
 
+// @Tags File
+// @Summary Search file content
+// @Description Recursively search file content under a directory. Results are pushed through SSE (Server-Sent Events): "match" for each matched line, "progress" with scanned and skipped file counts, and "done" or "error" when the search ends. Closing the connection cancels the search.
+// @Accept json
+// @Produce text/event-stream
+// @Param host path uint true "Host ID"
+// @Param request body model.FileGrep true "Search options"
+// @Success 200 {string} string "SSE stream started"
+// @Router /files/{host}/grep [post]
+func (s *FileMan) GrepFile(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		helper.ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.FileGrep
+	if err := helper.CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	if err := s.grepStream(c, uint(hostID), req); err != nil {
+		helper.ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+}
+
 // @Tags File
 // @Summary Create file or directory
 // @Description Create a new file or directory
@@ -408,13 +450,13 @@ func (s *FileMan) CreateFile(c *gin.Context) {
 
 // @Tags File
 // @Summary Delete file
-// @Description Delete a file or directory
+// @Description Delete a file or directory. Items larger than the trash size limit are deleted permanently and listed in purged.
 // @Accept json
 // @Produce json
 // @Param host path uint true "Host ID"
 // @Param source query string true "Source file path"
 // @Param force_delete query bool false "Force delete flag"
-// @Success 200
+// @Success 200 {object} model.FileDeleteResult
 // @Router /files/{host} [delete]
 func (s *FileMan) DeleteFile(c *gin.Context) {
 	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
@@ -436,24 +478,25 @@ func (s *FileMan) DeleteFile(c *gin.Context) {
 		ForceDelete: forceDelete,
 	}
 
-	err = s.delete(hostID, req)
+	result, err := s.delete(hostID, req)
 	if err != nil {
 		helper.ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
 		return
 	}
 
-	helper.SuccessWithData(c, nil)
+	helper.SuccessWithData(c, result)
 }
 
 // @Tags File
 // @Summary Batch delete files
-// @Description Delete multiple files or directories
+// @Description Delete multiple files or directories. Items larger than the trash size limit are deleted permanently and listed in purged.
 // @Accept json
 // @Produce json
 // @Param host path uint true "Host ID"
 // @Param sources query string true "Comma-separated list of file paths to delete"
 // @Param is_dir query bool false "Is directory flag"
-// @Success 200
+// @Param force_delete query bool false "Delete permanently instead of moving to trash"
+// @Success 200 {object} model.FileDeleteResult
 // @Router /files/{host}/batch [delete]
 func (s *FileMan) BatchDeleteFile(c *gin.Context) {
 	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
@@ -468,17 +511,20 @@ func (s *FileMan) BatchDeleteFile(c *gin.Context) {
 		return
 	}
 
+	forceDelete, _ := strconv.ParseBool(c.Query("force_delete"))
+
 	req := model.FileBatchDelete{
-		Paths: strings.Split(sources, ","),
+		Paths:       strings.Split(sources, ","),
+		ForceDelete: forceDelete,
 	}
 
-	err = s.batchDelete(hostID, req)
+	result, err := s.batchDelete(hostID, req)
 	if err != nil {
 		helper.ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
 		return
 	}
 
-	helper.SuccessWithData(c, nil)
+	helper.SuccessWithData(c, result)
 }
 
 // @Tags File
@@ -827,21 +873,56 @@ func (s *FileMan) ChangeFileName(c *gin.Context) {
 	helper.SuccessWithData(c, nil)
 }
 
-// // @Tags File
-// // @Summary Wget file
-// // @Description 下载远端文件
-// // @Accept json
-// // @Param host path uint true "Host ID"
-// // @Param request body model.FileWget true "request"
-// // @Success 200
-// // @Router /files/{host}/wget [post]
-// func (s *FileMan) WgetFile(c *gin.Context) {
-// 	var req model.FileWget
-// 	if err := helper.CheckBindAndValidate(&req, c); err != nil {
-// 		return
-// 	}
-// 	helper.ErrorWithDetail(c, constant.CodeFailed, "not supported yet", nil)
-// }
+// @Tags File
+// @Summary Wget file
+// @Description Download a remote url to a directory on the host in the background, with optional checksum (md5, sha1 or sha256). Progress is written to the returned log task.
+// @Accept json
+// @Produce json
+// @Param host path uint true "Host ID"
+// @Param request body model.FileWget true "request"
+// @Success 200 {object} model.FileWgetInfo
+// @Router /files/{host}/wget [post]
+func (s *FileMan) WgetFile(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		helper.ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.FileWget
+	if err := helper.CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	info, err := s.wget(uint(hostID), req)
+	if err != nil {
+		helper.ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	helper.SuccessWithData(c, info)
+}
+
+// @Tags File
+// @Summary Cancel wget
+// @Description Cancel a running download, the partial file is removed
+// @Accept json
+// @Produce json
+// @Param host path uint true "Host ID"
+// @Param request body model.FileWgetCancel true "Download task ID"
+// @Success 200
+// @Router /files/{host}/wget/cancel [post]
+func (s *FileMan) CancelWget(c *gin.Context) {
+	var req model.FileWgetCancel
+	if err := helper.CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	if err := s.cancelWget(req); err != nil {
+		helper.ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	helper.SuccessWithData(c, nil)
+}
 
 // @Tags File
 // @Summary Move files
@@ -871,6 +952,76 @@ func (s *FileMan) MoveFile(c *gin.Context) {
 	helper.SuccessWithData(c, nil)
 }
 
+// @Tags File
+// @Summary Transfer files to another host
+// @Description Copy or move files and directories to another host through the center, keeping mode and owner. Progress is written to the returned log task.
+// @Accept json
+// @Produce json
+// @Param host path uint true "Source host ID"
+// @Param request body model.FileTransfer true "Transfer details"
+// @Success 200 {object} model.FileTransferInfo
+// @Router /files/{host}/transfer [post]
+func (s *FileMan) TransferFile(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		helper.ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.FileTransfer
+	if err := helper.CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+	if !middleware.HostAllowed(c, req.DestHostID) {
+		helper.ErrorWithDetail(c, constant.CodeErrForbidden, "Dest host not allowed", nil)
+		return
+	}
+
+	info, err := s.transfer(uint(hostID), req)
+	if err != nil {
+		helper.ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	helper.SuccessWithData(c, info)
+}
+
+// @Tags File
+// @Summary Cancel file transfer
+// @Description Cancel a running transfer, files already transferred are kept
+// @Accept json
+// @Produce json
+// @Param host path uint true "Source host ID"
+// @Param request body model.FileTransferCancel true "Transfer ID"
+// @Success 200
+// @Router /files/{host}/transfer/cancel [post]
+func (s *FileMan) CancelTransfer(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		helper.ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.FileTransferCancel
+	if err := helper.CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+	job, ok := s.getTransfer(uint(hostID), req.ID)
+	if !ok {
+		helper.ErrorWithDetail(c, constant.CodeFailed, fmt.Sprintf("transfer %s not found", req.ID), nil)
+		return
+	}
+	if !middleware.HostAllowed(c, job.destHostID) {
+		helper.ErrorWithDetail(c, constant.CodeErrForbidden, "Dest host not allowed", nil)
+		return
+	}
+
+	if err := s.cancelTransfer(req); err != nil {
+		helper.ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	helper.SuccessWithData(c, nil)
+}
+
 // @Tags File
 // @Summary Change file owner
 // @Description Change file user or/and group
@@ -1082,3 +1233,163 @@ func (s *FileMan) DeleteFavorite(c *gin.Context) {
 	}
 	helper.SuccessWithOutData(c)
 }
+
+// @Tags File
+// @Summary List trash
+// @Description List deleted files and content snapshots in the host's trash, newest first
+// @Accept json
+// @Produce json
+// @Param host path uint true "Host ID"
+// @Param page query uint true "Page"
+// @Param page_size query uint true "Page size"
+// @Param path query string false "Only list items deleted from this path or below"
+// @Success 200 {object} model.PageResult
+// @Router /files/{host}/trash [get]
+func (s *FileMan) GetTrashList(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		helper.ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	page, err := strconv.ParseInt(c.Query("page"), 10, 32)
+	if err != nil || page < 1 {
+		helper.ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid page", err)
+		return
+	}
+
+	pageSize, err := strconv.ParseInt(c.Query("page_size"), 10, 32)
+	if err != nil || pageSize < 1 {
+		helper.ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid page_size", err)
+		return
+	}
+
+	req := model.TrashList{
+		Page:     int(page),
+		PageSize: int(pageSize),
+		Path:     c.Query("path"),
+	}
+
+	result, err := s.trashList(uint(hostID), req)
+	if err != nil {
+		helper.ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	helper.SuccessWithData(c, result)
+}
+
+// @Tags File
+// @Summary Restore from trash
+// @Description Restore a trash item to its original path. If the path exists, it is moved to trash first when overwrite is true.
+// @Accept json
+// @Produce json
+// @Param host path uint true "Host ID"
+// @Param request body model.TrashRestore true "Restore details"
+// @Success 200 {object} model.TrashItem
+// @Router /files/{host}/trash/restore [post]
+func (s *FileMan) RestoreTrash(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		helper.ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.TrashRestore
+	if err := helper.CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	item, err := s.trashRestore(uint(hostID), req)
+	if err != nil {
+		helper.ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	helper.SuccessWithData(c, item)
+}
+
+// @Tags File
+// @Summary Purge trash
+// @Description Permanently delete trash items
+// @Accept json
+// @Produce json
+// @Param host path uint true "Host ID"
+// @Param ids query string false "Comma-separated list of trash item IDs"
+// @Param all query bool false "Empty the trash"
+// @Success 200
+// @Router /files/{host}/trash [delete]
+func (s *FileMan) PurgeTrash(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		helper.ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	all, _ := strconv.ParseBool(c.Query("all"))
+	ids := c.Query("ids")
+	if ids == "" && !all {
+		helper.ErrorWithDetail(c, constant.CodeErrBadRequest, "No ids provided", nil)
+		return
+	}
+
+	req := model.TrashPurge{All: all}
+	if ids != "" {
+		req.IDs = strings.Split(ids, ",")
+	}
+
+	if err := s.trashPurge(uint(hostID), req); err != nil {
+		helper.ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	helper.SuccessWithOutData(c)
+}
+
+// @Tags File
+// @Summary Get trash settings
+// @Description Get whether the trash is enabled and its retention limits
+// @Accept json
+// @Produce json
+// @Param host path uint true "Host ID"
+// @Success 200 {object} model.TrashSettings
+// @Router /files/{host}/trash/settings [get]
+func (s *FileMan) GetTrashSettings(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		helper.ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	settings, err := s.trashSettings(uint(hostID))
+	if err != nil {
+		helper.ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	helper.SuccessWithData(c, settings)
+}
+
+// @Tags File
+// @Summary Update trash settings
+// @Description Enable or disable the trash and set its retention limits, items beyond the new limits are purged
+// @Accept json
+// @Produce json
+// @Param host path uint true "Host ID"
+// @Param request body model.TrashSettings true "Trash settings"
+// @Success 200
+// @Router /files/{host}/trash/settings [put]
+func (s *FileMan) UpdateTrashSettings(c *gin.Context) {
+	hostID, err := strconv.ParseUint(c.Param("host"), 10, 32)
+	if err != nil {
+		helper.ErrorWithDetail(c, constant.CodeErrBadRequest, "Invalid host", err)
+		return
+	}
+
+	var req model.TrashSettings
+	if err := helper.CheckBindAndValidate(&req, c); err != nil {
+		return
+	}
+
+	if err := s.setTrashSettings(uint(hostID), req); err != nil {
+		helper.ErrorWithDetail(c, constant.CodeFailed, err.Error(), nil)
+		return
+	}
+	helper.SuccessWithOutData(c)
+}
@@ -152,7 +152,8 @@ func (s *LogRotate) Start() {
 
 	s.restyClient = resty.New().
 		SetBaseURL(baseUrl).
-		SetHeader("Content-Type", "application/json")
+		SetHeader("Content-Type", "application/json").
+		SetHeader(global.InternalTokenHeader, global.InternalToken)
 
 	if settingInfo.Https == "yes" {
 		// 创建 TLS 配置
@@ -0,0 +1,35 @@
+package constant
+
+// 告警指标
+const (
+	AlertMetricCpu                = "cpu"
+	AlertMetricMemory             = "memory"
+	AlertMetricDisk               = "disk"
+	AlertMetricRx                 = "rx"
+	AlertMetricTx                 = "tx"
+	AlertMetricAgentOffline       = "agent_offline"
+	AlertMetricContainerUnhealthy = "container_unhealthy"
+)
+
+// 告警级别
+const (
+	AlertSeverityInfo     = "info"
+	AlertSeverityWarning  = "warning"
+	AlertSeverityCritical = "critical"
+)
+
+// 告警状态
+const (
+	AlertStateFiring   = "firing"
+	AlertStateResolved = "resolved"
+)
+
+// 通知渠道类型
+const (
+	AlertChannelEmail    = "email"
+	AlertChannelWebhook  = "webhook"
+	AlertChannelSlack    = "slack"
+	AlertChannelDingTalk = "dingtalk"
+	AlertChannelFeishu   = "feishu"
+	AlertChannelWeCom    = "wecom"
+)
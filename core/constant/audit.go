@@ -0,0 +1,14 @@
+package constant
+
+// 审计日志来源
+const (
+	AuditSourceApi      = "api"
+	AuditSourceAgent    = "agent"
+	AuditSourceTerminal = "terminal"
+)
+
+// 审计日志结果
+const (
+	AuditResultSuccess = "success"
+	AuditResultFailed  = "failed"
+)
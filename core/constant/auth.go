@@ -0,0 +1,31 @@
+package constant
+
+// 内置角色
+const (
+	RoleAdmin    = "admin"
+	RoleUser     = "user"
+	RoleOperator = "operator"
+	RoleReadOnly = "readonly"
+)
+
+// 权限
+const (
+	AccessRead  = "read"
+	AccessWrite = "write"
+
+	// ResourceAll 匹配除系统资源（users、settings、audit）以外的全部路由组
+	ResourceAll = "*"
+)
+
+// AccessTokenPrefix 个人访问令牌前缀，通过 Authorization: Bearer idb_pat_... 使用
+const AccessTokenPrefix = "idb_pat_"
+
+// 用户来源，非本地用户不能使用密码登录
+const (
+	UserSourceLocal = "local"
+	UserSourceOidc  = "oidc"
+	UserSourceLdap  = "ldap"
+)
+
+// MetricsTokenPrefix Prometheus 采集令牌前缀，通过 Authorization: Bearer idb_metrics_... 使用
+const MetricsTokenPrefix = "idb_metrics_"
@@ -0,0 +1,14 @@
+package constant
+
+// 批量操作类型
+const (
+	BatchTypeCommand = "command"
+	BatchTypeScript  = "script"
+	BatchTypeAction  = "action"
+)
+
+// 批量操作状态
+const (
+	BatchStatusRunning = "running"
+	BatchStatusDone    = "done"
+)
@@ -33,6 +33,8 @@ var (
 	ErrNameIsExist              = errors.New("NameIsExist")
 	ErrInvalidAccountOrPassword = errors.New("InvalidAccountOrPassword")
 	ErrAuth                     = errors.New("ErrAuth")
+	ErrLoginLocked              = errors.New("LoginLocked")
+	ErrLdapUnavailable          = errors.New("LdapUnavailable")
 	ErrNoRecords                = errors.New("NoRecords")
 	ErrRecordExist              = errors.New("ErrRecordExist")
 	ErrRecordNotFound           = errors.New("ErrRecordNotFound")
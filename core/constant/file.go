@@ -8,6 +8,8 @@ const (
 	CenterLogDir   = "/var/log/idb"
 	CenterRunDir   = "/run/idb"
 
+	CenterRecordingDir = "/var/lib/idb/data/recordings"
+
 	CenterDb       = "idb.db"
 	CenterService  = "idb.service"
 	CenterConfig   = "idb.conf"
@@ -38,8 +38,9 @@ const (
 )
 
 const (
-	Upload   FileMessageType = "upload"
-	Download FileMessageType = "download"
+	Upload      FileMessageType = "upload"
+	Download    FileMessageType = "download"
+	UploadQuery FileMessageType = "upload_query" // 查询上传会话已接收的偏移量
 )
 
 const (
@@ -50,9 +51,10 @@ const (
 )
 
 const (
-	FileErr  int = -1
-	FileOk   int = 0
-	FileDone int = 1
+	FileErr   int = -1
+	FileOk    int = 0
+	FileDone  int = 1
+	FileRetry int = 2 // 可恢复的错误，发送端应重新查询已接收的偏移量后续传
 )
 
 // 消息数据分隔符
@@ -89,6 +91,11 @@ type FileMessage struct {
 	Offset    int64           `json:"offset"`                        // 当前文件块的起始偏移量
 	ChunkSize int             `json:"chunk_size"`                    // 当前文件块的大小
 	Chunk     []byte          `json:"chunk"`                         // 当前文件块
+	UploadID  string          `json:"upload_id,omitempty"`           // 上传会话ID，断线重连后可按该ID续传
+	ChunkHash string          `json:"chunk_hash,omitempty"`          // 当前文件块的 SHA-256
+	FileHash  string          `json:"file_hash,omitempty"`           // 整个文件的 SHA-256，完成时由接收端校验
+	Received  int64           `json:"received,omitempty"`            // 接收端已连续写入的字节数
+	Error     string          `json:"error,omitempty"`               // 错误信息
 }
 
 func (f *FileMessage) GetType() string {
@@ -177,6 +184,12 @@ func CreateFileMessage(msgID string, msgType FileMessageType, status int, path s
 	return fileMessage, nil
 }
 
+// FileChecksum 计算文件块的 SHA-256
+func FileChecksum(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
 func SendFileMessage(conn net.Conn, msg *FileMessage) error {
 	// 序列化消息
 	data, err := json.Marshal(msg)
@@ -44,6 +44,21 @@ const (
 	File_Dir_Size           string = "file_dir_size"
 	File_Upload             string = "file_upload"
 	File_Download           string = "file_download"
+	File_Transfer_Pack      string = "file_transfer_pack"
+	File_Transfer_Unpack    string = "file_transfer_unpack"
+	File_Transfer_Status    string = "file_transfer_status"
+	File_Transfer_Clean     string = "file_transfer_clean"
+	File_Wget               string = "file_wget"
+	File_Wget_Status        string = "file_wget_status"
+	File_Wget_Cancel        string = "file_wget_cancel"
+	File_Grep               string = "file_grep"
+	File_Grep_Result        string = "file_grep_result"
+	File_Grep_Cancel        string = "file_grep_cancel"
+	File_Trash_List         string = "file_trash_list"
+	File_Trash_Restore      string = "file_trash_restore"
+	File_Trash_Purge        string = "file_trash_purge"
+	File_Trash_Settings     string = "file_trash_settings"
+	File_Trash_Set          string = "file_trash_set"
 	Favorite_List           string = "favorite_list"
 	Favorite_Create         string = "favorite_create"
 	Favorite_Delete         string = "favorite_delete"
@@ -155,6 +170,16 @@ const (
 	Rsync_Delete string = "rsync_delete"
 	Rsync_Test   string = "rsync_test"
 	Rsync_Logs   string = "rsync_logs"
+
+	Backup_Create     string = "backup_create"
+	Backup_Update     string = "backup_update"
+	Backup_Delete     string = "backup_delete"
+	Backup_List       string = "backup_list"
+	Backup_Run        string = "backup_run"
+	Backup_Snapshots  string = "backup_snapshots"
+	Backup_Files      string = "backup_files"
+	Backup_Restore    string = "backup_restore"
+	Backup_Repository string = "backup_repository" // 仅供 center 恢复到其他设备时读取仓库配置
 )
 
 // Action消息结构
@@ -175,7 +200,9 @@ type ActionResponse struct {
 	Data    HostAction `json:"data"`
 }
 
+// CreateTask HostID 为日志所在的设备，用于校验设备范围，必须指定
 type CreateTask struct {
+	HostID  uint   `json:"host_id" validate:"required"`
 	LogPath string `json:"log_path"`
 }
 
@@ -0,0 +1,143 @@
+package model
+
+import "time"
+
+type AlertRuleInfo struct {
+	ID             uint      `json:"id"`
+	CreatedAt      time.Time `json:"created_at"`
+	Name           string    `json:"name"`
+	Metric         string    `json:"metric"`
+	Operator       string    `json:"operator"`
+	Threshold      float64   `json:"threshold"`
+	Duration       int       `json:"duration"`
+	Severity       string    `json:"severity"`
+	HostIDs        []uint    `json:"host_ids"`
+	ChannelIDs     []uint    `json:"channel_ids"`
+	RepeatInterval int       `json:"repeat_interval"`
+	Enabled        bool      `json:"enabled"`
+}
+
+// CreateAlertRule cpu、memory、disk 为百分比，rx、tx 为速率，agent_offline 无需阈值，container_unhealthy 比较不健康容器数量
+type CreateAlertRule struct {
+	Name           string  `json:"name" validate:"required,max=64"`
+	Metric         string  `json:"metric" validate:"required,oneof=cpu memory disk rx tx agent_offline container_unhealthy"`
+	Operator       string  `json:"operator" validate:"omitempty,oneof=> >= < <="` // 默认 >
+	Threshold      float64 `json:"threshold"`
+	Duration       int     `json:"duration" validate:"min=0,max=86400"` // 持续时间（秒）
+	Severity       string  `json:"severity" validate:"required,oneof=info warning critical"`
+	HostIDs        []uint  `json:"host_ids"` // 为空表示全部设备
+	ChannelIDs     []uint  `json:"channel_ids"`
+	RepeatInterval int     `json:"repeat_interval" validate:"min=0"` // 重复通知间隔（秒），0 表示不重复
+	Enabled        bool    `json:"enabled"`
+}
+
+type UpdateAlertRule struct {
+	ID uint `json:"id" validate:"required"`
+	CreateAlertRule
+}
+
+// AlertChannelConfig 各类通知渠道配置的并集
+type AlertChannelConfig struct {
+	// webhook、slack、钉钉、飞书、企业微信
+	URL     string            `json:"url,omitempty"`
+	Secret  string            `json:"secret,omitempty"`  // 钉钉、飞书机器人的加签密钥
+	Headers map[string]string `json:"headers,omitempty"` // 仅 webhook
+
+	// email
+	Host       string   `json:"host,omitempty"`
+	Port       int      `json:"port,omitempty"`
+	Username   string   `json:"username,omitempty"`
+	Password   string   `json:"password,omitempty"`
+	From       string   `json:"from,omitempty"`
+	To         []string `json:"to,omitempty"`
+	Encryption string   `json:"encryption,omitempty"` // none、starttls、tls，默认 none
+}
+
+// AlertChannelInfo 不返回 Secret 及 Password
+type AlertChannelInfo struct {
+	ID        uint               `json:"id"`
+	CreatedAt time.Time          `json:"created_at"`
+	Name      string             `json:"name"`
+	Type      string             `json:"type"`
+	Config    AlertChannelConfig `json:"config"`
+	Enabled   bool               `json:"enabled"`
+}
+
+type CreateAlertChannel struct {
+	Name    string             `json:"name" validate:"required,max=64"`
+	Type    string             `json:"type" validate:"required,oneof=email webhook slack dingtalk feishu wecom"`
+	Config  AlertChannelConfig `json:"config"`
+	Enabled bool               `json:"enabled"`
+}
+
+// UpdateAlertChannel Secret 及 Password 为空时保留原值
+type UpdateAlertChannel struct {
+	ID uint `json:"id" validate:"required"`
+	CreateAlertChannel
+}
+
+// TestAlertChannel 指定 ID 时，Secret 及 Password 为空则使用已保存的值
+type TestAlertChannel struct {
+	ID     uint               `json:"id"`
+	Type   string             `json:"type" validate:"required,oneof=email webhook slack dingtalk feishu wecom"`
+	Config AlertChannelConfig `json:"config"`
+}
+
+type AlertSilenceInfo struct {
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	RuleID    uint      `json:"rule_id"`
+	HostID    uint      `json:"host_id"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	Comment   string    `json:"comment"`
+	Creator   string    `json:"creator"`
+}
+
+// CreateAlertSilence RuleID 或 HostID 为 0 表示不限，StartsAt 为空表示立即生效
+type CreateAlertSilence struct {
+	RuleID   uint       `json:"rule_id"`
+	HostID   uint       `json:"host_id"`
+	StartsAt *time.Time `json:"starts_at"`
+	EndsAt   time.Time  `json:"ends_at" validate:"required"`
+	Comment  string     `json:"comment" validate:"max=256"`
+}
+
+type QueryAlertEvent struct {
+	PageInfo
+	State  string `form:"state" json:"state" validate:"omitempty,oneof=firing resolved"`
+	RuleID uint   `form:"rule_id" json:"rule_id"`
+	HostID uint   `form:"host_id" json:"host_id"`
+}
+
+type AlertEventInfo struct {
+	ID             uint       `json:"id"`
+	RuleID         uint       `json:"rule_id"`
+	RuleName       string     `json:"rule_name"`
+	HostID         uint       `json:"host_id"`
+	HostName       string     `json:"host_name"`
+	Metric         string     `json:"metric"`
+	Severity       string     `json:"severity"`
+	Value          float64    `json:"value"`
+	Message        string     `json:"message"`
+	State          string     `json:"state"`
+	Silenced       bool       `json:"silenced"`
+	StartsAt       time.Time  `json:"starts_at"`
+	ResolvedAt     *time.Time `json:"resolved_at"`
+	LastNotifiedAt *time.Time `json:"last_notified_at"`
+}
+
+// AlertNotification 发送到通知渠道的内容，webhook 渠道直接以 JSON 发送
+type AlertNotification struct {
+	State     string     `json:"state"`
+	RuleName  string     `json:"rule_name"`
+	Severity  string     `json:"severity"`
+	HostID    uint       `json:"host_id"`
+	HostName  string     `json:"host_name"`
+	Metric    string     `json:"metric"`
+	Value     float64    `json:"value"`
+	Threshold float64    `json:"threshold"`
+	Message   string     `json:"message"`
+	StartsAt  time.Time  `json:"starts_at"`
+	EndsAt    *time.Time `json:"ends_at,omitempty"`
+}
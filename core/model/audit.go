@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+type QueryAuditLog struct {
+	PageInfo
+	User   string `form:"user" json:"user"`
+	HostID uint   `form:"host_id" json:"host_id"`
+	Start  int64  `form:"start" json:"start"` // unix 秒，0 表示不限
+	End    int64  `form:"end" json:"end"`     // unix 秒，0 表示不限
+}
+
+type AuditLogInfo struct {
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UserID    uint      `json:"user_id"`
+	UserName  string    `json:"user_name"`
+	SourceIP  string    `json:"source_ip"`
+	HostID    uint      `json:"host_id"`
+	Source    string    `json:"source"`
+	Plugin    string    `json:"plugin"`
+	Route     string    `json:"route"`
+	Method    string    `json:"method"`
+	Action    string    `json:"action"`
+	Params    string    `json:"params"`
+	Result    string    `json:"result"`
+	Message   string    `json:"message"`
+}
@@ -9,4 +9,72 @@ type LoginResult struct {
 	ID    int    `json:"id"`
 	Name  string `json:"name"`
 	Token string `json:"token"`
+
+	// 开启两步验证时不返回 Token，需携带 Challenge 调用 /auth/sessions/2fa
+	TwoFactor bool   `json:"two_factor"`
+	Challenge string `json:"challenge,omitempty"`
+	// 系统要求两步验证但用户尚未绑定，登录后只能访问绑定相关接口
+	TwoFactorSetup bool `json:"two_factor_setup"`
+}
+
+type LoginTwoFactor struct {
+	Challenge string `json:"challenge" validate:"required"`
+	Code      string `json:"code" validate:"required"` // 验证码或恢复码
+}
+
+type TotpSetup struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+type TotpCode struct {
+	Code string `json:"code" validate:"required"`
+}
+
+type RecoveryCodes struct {
+	Codes []string `json:"codes"`
+}
+
+type ResetTwoFactor struct {
+	ID uint `json:"id" validate:"required"`
+}
+
+// GroupRoleMapping 身份源中的组映射到 iDB 角色，按顺序匹配第一条
+type GroupRoleMapping struct {
+	Group string `json:"group" validate:"required"`
+	Role  string `json:"role" validate:"required"`
+}
+
+type OidcSettings struct {
+	Enabled       bool   `json:"enabled"`
+	Issuer        string `json:"issuer"` // 通过 {issuer}/.well-known/openid-configuration 发现端点
+	ClientID      string `json:"client_id"`
+	ClientSecret  string `json:"client_secret"`  // 查询时不返回，更新时留空表示不修改
+	RedirectURL   string `json:"redirect_url"`   // 回调地址，如 https://idb.example.com/api/v1/auth/oidc/callback
+	Scopes        string `json:"scopes"`         // 空格分隔，默认 openid profile email groups
+	GroupsClaim   string `json:"groups_claim"`   // 默认 groups
+	UsernameClaim string `json:"username_claim"` // 默认 preferred_username
+
+	RoleMappings []GroupRoleMapping `json:"role_mappings" validate:"dive"`
+	DefaultRole  string             `json:"default_role"` // 未匹配到任何组时的角色，为空则拒绝登录
+}
+
+type OidcStatus struct {
+	Enabled bool `json:"enabled"`
+}
+
+type LdapSettings struct {
+	Enabled      bool   `json:"enabled"`
+	URL          string `json:"url"` // ldap://host:389 或 ldaps://host:636
+	StartTLS     bool   `json:"start_tls"`
+	SkipVerify   bool   `json:"skip_verify"`
+	BindDN       string `json:"bind_dn"`       // 用于查找用户的服务账号，为空时匿名查找
+	BindPassword string `json:"bind_password"` // 查询时不返回，更新时留空表示不修改
+	SearchBase   string `json:"search_base"`
+	UserFilter   string `json:"user_filter"` // %s 替换为登录名，默认 (uid=%s)，AD 可使用 (sAMAccountName=%s)
+	UserAttr     string `json:"user_attr"`   // 用户名属性，默认 uid
+	GroupAttr    string `json:"group_attr"`  // 组属性，默认 memberOf，映射时可使用组的完整 DN 或 CN
+
+	RoleMappings []GroupRoleMapping `json:"role_mappings" validate:"dive"`
+	DefaultRole  string             `json:"default_role"` // 未匹配到任何组时的角色，为空则拒绝登录
 }
@@ -0,0 +1,157 @@
+package model
+
+import "time"
+
+// BackupRepository 快照仓库，基于 restic 实现去重及加密
+type BackupRepository struct {
+	Type     string `json:"type" validate:"required,oneof=local sftp s3"`
+	Path     string `json:"path"`               // local、sftp 为仓库目录，s3 为桶内前缀
+	Password string `json:"password,omitempty"` // 仓库加密密码，丢失后无法恢复
+
+	// sftp：指定 HostID 时由 center 根据设备的 SSH 信息填充以下字段
+	HostID        uint   `json:"host_id,omitempty"`
+	SSHHost       string `json:"ssh_host,omitempty"`
+	SSHPort       int    `json:"ssh_port,omitempty"`
+	SSHUser       string `json:"ssh_user,omitempty"`
+	SSHPassword   string `json:"ssh_password,omitempty"`
+	SSHPrivateKey string `json:"ssh_private_key,omitempty"` // 私钥内容
+
+	// s3：兼容 S3 的对象存储，如 MinIO
+	Endpoint  string `json:"endpoint,omitempty"` // 如 https://s3.amazonaws.com、http://127.0.0.1:9000
+	Bucket    string `json:"bucket,omitempty"`
+	Region    string `json:"region,omitempty"`
+	AccessKey string `json:"access_key,omitempty"`
+	SecretKey string `json:"secret_key,omitempty"`
+}
+
+// BackupSources 备份内容，应用按名称备份其 iDB_service_data_path，卷按名称备份其挂载点
+type BackupSources struct {
+	Paths   []string `json:"paths" validate:"omitempty,dive,startswith=/"`
+	Apps    []string `json:"apps" validate:"omitempty,dive,required"`
+	Volumes []string `json:"volumes" validate:"omitempty,dive,required"`
+}
+
+// BackupRetention 快照保留策略，全部为 0 表示保留所有快照
+type BackupRetention struct {
+	KeepLast    int `json:"keep_last" validate:"min=0"`
+	KeepDaily   int `json:"keep_daily" validate:"min=0"`
+	KeepWeekly  int `json:"keep_weekly" validate:"min=0"`
+	KeepMonthly int `json:"keep_monthly" validate:"min=0"`
+}
+
+type CreateBackupJob struct {
+	Name       string           `json:"name" validate:"required,max=64"`
+	Repository BackupRepository `json:"repository"`
+	Sources    BackupSources    `json:"sources"`
+	Excludes   []string         `json:"excludes" validate:"omitempty,dive,required"`
+	Schedule   string           `json:"schedule"` // cron 表达式或执行间隔，为空表示仅手动执行
+	Retention  BackupRetention  `json:"retention"`
+}
+
+// UpdateBackupJob 仓库的密码、密钥类字段为空时保留原值
+type UpdateBackupJob struct {
+	ID string `json:"id" validate:"required"`
+	CreateBackupJob
+}
+
+type QueryBackupJob struct {
+	ID string `form:"id" json:"id" validate:"required"`
+}
+
+// BackupSummary 单次备份的统计
+type BackupSummary struct {
+	FilesNew            int64   `json:"files_new"`
+	FilesChanged        int64   `json:"files_changed"`
+	FilesUnmodified     int64   `json:"files_unmodified"`
+	DataAdded           int64   `json:"data_added"`
+	TotalFilesProcessed int64   `json:"total_files_processed"`
+	TotalBytesProcessed int64   `json:"total_bytes_processed"`
+	TotalDuration       float64 `json:"total_duration"`
+}
+
+// BackupJobInfo 返回的仓库不含密码、密钥类字段
+type BackupJobInfo struct {
+	ID             string           `json:"id"`
+	Name           string           `json:"name"`
+	Repository     BackupRepository `json:"repository"`
+	Sources        BackupSources    `json:"sources"`
+	Excludes       []string         `json:"excludes"`
+	Schedule       string           `json:"schedule"`
+	NextRunAt      *time.Time       `json:"next_run_at,omitempty"`
+	Retention      BackupRetention  `json:"retention"`
+	State          string           `json:"state"` // pending、running、succeeded、failed
+	LastRunAt      *time.Time       `json:"last_run_at,omitempty"`
+	LastError      string           `json:"last_error,omitempty"`
+	LastSnapshotID string           `json:"last_snapshot_id,omitempty"`
+	LastSummary    *BackupSummary   `json:"last_summary,omitempty"`
+	LastLogPath    string           `json:"last_log_path,omitempty"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+}
+
+type BackupJobList struct {
+	Total int              `json:"total"`
+	Jobs  []*BackupJobInfo `json:"jobs"`
+}
+
+type BackupJobCreated struct {
+	ID string `json:"id"`
+}
+
+// BackupLog 异步执行的备份或恢复，可通过 /logs/{host}/follow 追踪日志
+type BackupLog struct {
+	LogPath string `json:"log_path"`
+}
+
+type BackupSnapshot struct {
+	ID       string         `json:"id"`
+	ShortID  string         `json:"short_id"`
+	Time     time.Time      `json:"time"`
+	Hostname string         `json:"hostname"`
+	Paths    []string       `json:"paths"`
+	Tags     []string       `json:"tags"`
+	Summary  *BackupSummary `json:"summary,omitempty"`
+}
+
+type BackupSnapshotList struct {
+	Total     int               `json:"total"`
+	Snapshots []*BackupSnapshot `json:"snapshots"`
+}
+
+// QueryBackupFiles 列出快照中 Path 目录下的文件，Path 为空表示根目录
+type QueryBackupFiles struct {
+	JobID    string `form:"job_id" json:"job_id" validate:"required"`
+	Snapshot string `form:"snapshot" json:"snapshot" validate:"required"`
+	Path     string `form:"path" json:"path"`
+}
+
+type BackupFile struct {
+	Name    string    `json:"name"`
+	Type    string    `json:"type"` // file、dir、symlink 等
+	Path    string    `json:"path"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+}
+
+type BackupFileList struct {
+	Total int           `json:"total"`
+	Files []*BackupFile `json:"files"`
+}
+
+// RestoreBackup 恢复快照，TargetHostID 为 0 或与源设备相同时恢复到源设备
+type RestoreBackup struct {
+	JobID        string   `json:"job_id" validate:"required"`
+	Snapshot     string   `json:"snapshot" validate:"required"` // 快照 ID 或 latest
+	TargetHostID uint     `json:"target_host_id"`
+	TargetPath   string   `json:"target_path" validate:"required,startswith=/"`
+	Includes     []string `json:"includes" validate:"omitempty,dive,startswith=/"` // 仅恢复快照中的这些路径
+}
+
+// BackupRestoreRequest 发送给执行恢复的 agent，恢复到其他设备时携带源任务的仓库
+type BackupRestoreRequest struct {
+	JobID      string            `json:"job_id"`
+	Repository *BackupRepository `json:"repository,omitempty"`
+	Snapshot   string            `json:"snapshot"`
+	TargetPath string            `json:"target_path"`
+	Includes   []string          `json:"includes"`
+}
@@ -0,0 +1,46 @@
+package model
+
+import "time"
+
+// BatchTarget 批量操作的目标设备，三类条件取并集
+type BatchTarget struct {
+	HostIDs      []uint   `json:"host_ids"`
+	HostGroupIDs []uint   `json:"host_group_ids"`
+	Tags         []string `json:"tags"` // 同时具有全部标签的设备
+}
+
+// BatchExecute command、script、action 三选一
+type BatchExecute struct {
+	BatchTarget
+	Command     string  `json:"command"`
+	Script      string  `json:"script"` // 以 bash 执行的脚本内容
+	Action      *Action `json:"action"`
+	Concurrency int     `json:"concurrency" validate:"omitempty,min=1,max=50"` // 默认 10
+	Timeout     int     `json:"timeout" validate:"omitempty,min=1,max=3600"`   // 单台设备超时时间（秒），默认 60
+}
+
+type BatchHostResult struct {
+	HostID   uint   `json:"host_id"`
+	HostName string `json:"host_name"`
+	Success  bool   `json:"success"`
+	Output   string `json:"output"`
+	Error    string `json:"error"`
+	Duration int64  `json:"duration"` // 毫秒
+}
+
+type BatchTaskInfo struct {
+	ID         uint       `json:"id"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UserName   string     `json:"user_name"`
+	Type       string     `json:"type"`
+	Content    string     `json:"content"`
+	Status     string     `json:"status"`
+	Total      int        `json:"total"`
+	Success    int        `json:"success"`
+	Failed     int        `json:"failed"`
+	LogHost    uint       `json:"log_host"`
+	LogPath    string     `json:"log_path"`
+	FinishedAt *time.Time `json:"finished_at"`
+
+	Results []BatchHostResult `json:"results,omitempty"`
+}
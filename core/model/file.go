@@ -1,6 +1,7 @@
 package model
 
 import (
+	"os"
 	"time"
 
 	"github.com/sensdata/idb/core/files"
@@ -56,7 +57,13 @@ type FileDelete struct {
 }
 
 type FileBatchDelete struct {
-	Paths []string `json:"paths" validate:"required"`
+	Paths       []string `json:"paths" validate:"required"`
+	ForceDelete bool     `json:"force_delete"`
+}
+
+// FileDeleteResult Purged 为超过回收站容量上限、未移入回收站而直接删除的路径，无法恢复
+type FileDeleteResult struct {
+	Purged []string `json:"purged"`
 }
 
 type FileCompress struct {
@@ -86,11 +93,82 @@ type FilePathCheck struct {
 	Path string `json:"path" validate:"required"`
 }
 
+// FileWget 下载 Url 到 Path/Name，Checksum 格式为 算法:十六进制值，算法支持 md5、sha1、sha256，省略算法时为 sha256
 type FileWget struct {
-	Url               string `json:"url" validate:"required"`
+	Url               string `json:"url" validate:"required,url"`
 	Path              string `json:"path" validate:"required"`
 	Name              string `json:"name" validate:"required"`
 	IgnoreCertificate bool   `json:"ignore_certificate"`
+	Checksum          string `json:"checksum"`
+}
+
+// FileWgetInfo 下载进度写入日志流任务，可通过 /logs/{log_host}/follow 追踪
+type FileWgetInfo struct {
+	ID      string `json:"id"`
+	LogHost uint   `json:"log_host"`
+	LogPath string `json:"log_path"`
+}
+
+type FileWgetCancel struct {
+	ID string `json:"id" validate:"required"`
+}
+
+// FileWgetQuery 查询或取消 agent 上的下载
+type FileWgetQuery struct {
+	Key string `json:"key" validate:"required"`
+}
+
+// FileWgetStatus State 为 running、done、failed 或 canceled，Total 为 -1 时大小未知
+type FileWgetStatus struct {
+	Key     string `json:"key"`
+	Path    string `json:"path"`
+	State   string `json:"state"`
+	Total   int64  `json:"total"`
+	Written int64  `json:"written"`
+	Error   string `json:"error"`
+}
+
+// FileGrep 在 Path 下递归搜索文件内容
+// Pattern 默认按字面匹配，Regex 为 true 时按正则表达式（RE2 语法）匹配；
+// Includes、Excludes 为匹配文件名的通配符，Excludes 同时用于跳过目录，如 .git、node_modules
+type FileGrep struct {
+	Path        string   `json:"path" validate:"required"`
+	Pattern     string   `json:"pattern" validate:"required"`
+	Regex       bool     `json:"regex"`
+	IgnoreCase  bool     `json:"ignore_case"`
+	Includes    []string `json:"includes"`
+	Excludes    []string `json:"excludes"`
+	MaxFileSize int64    `json:"max_file_size" validate:"omitempty,min=1,max=1073741824"` // 跳过大于该值的文件，默认 10MB，最大 1GB
+	MaxResults  int      `json:"max_results" validate:"omitempty,min=1,max=10000"`        // 默认 1000
+	Context     int      `json:"context" validate:"omitempty,max=10"`                     // 匹配行前后的行数
+}
+
+type FileGrepRes struct {
+	Key string `json:"key"`
+}
+
+// FileGrepQuery 获取第 Offset 个之后的匹配结果
+type FileGrepQuery struct {
+	Key    string `json:"key" validate:"required"`
+	Offset int    `json:"offset"`
+}
+
+type FileGrepMatch struct {
+	Path    string   `json:"path"`
+	Line    int      `json:"line"`
+	Content string   `json:"content"`
+	Before  []string `json:"before,omitempty"`
+	After   []string `json:"after,omitempty"`
+}
+
+// FileGrepResult Done 为 true 时搜索已结束，Truncated 表示结果数达到上限
+type FileGrepResult struct {
+	Matches   []FileGrepMatch `json:"matches"`
+	Scanned   int             `json:"scanned"` // 已搜索的文件数
+	Skipped   int             `json:"skipped"` // 因二进制、过大或无法读取而跳过的文件数
+	Done      bool            `json:"done"`
+	Truncated bool            `json:"truncated"`
+	Error     string          `json:"error,omitempty"`
 }
 
 type FileMove struct {
@@ -101,6 +179,71 @@ type FileMove struct {
 	Cover   bool     `json:"cover"`
 }
 
+// FileTransfer 跨设备复制或移动，文件及目录打包为 tar 经 center 传输，保留权限及属主
+// Conflict 为目标已存在时的处理方式：abort 中止（默认）、skip 跳过、overwrite 覆盖、rename 自动重命名
+type FileTransfer struct {
+	Type       string   `json:"type" validate:"required,oneof=cut copy"`
+	Sources    []string `json:"sources" validate:"required,min=1,dive,required"`
+	DestHostID uint     `json:"dest_host_id" validate:"required"`
+	Dest       string   `json:"dest" validate:"required"`
+	Conflict   string   `json:"conflict" validate:"omitempty,oneof=abort skip overwrite rename"`
+}
+
+// FileTransferInfo 传输进度写入日志流任务，可通过 /logs/{log_host}/follow 追踪
+type FileTransferInfo struct {
+	ID      string `json:"id"`
+	LogHost uint   `json:"log_host"`
+	LogPath string `json:"log_path"`
+}
+
+type FileTransferCancel struct {
+	ID string `json:"id" validate:"required"`
+}
+
+// FileTransferPack 在源设备上将 Source 打包到临时目录
+type FileTransferPack struct {
+	ID     string `json:"id" validate:"required"`
+	Source string `json:"source" validate:"required"`
+}
+
+type FileTransferArchive struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+	Hash string `json:"hash"` // SHA-256
+}
+
+// FileTransferUnpack 在目标设备上将 Archive 解包到 Dest
+type FileTransferUnpack struct {
+	ID       string `json:"id" validate:"required"`
+	Archive  string `json:"archive" validate:"required"`
+	Dest     string `json:"dest" validate:"required"`
+	Conflict string `json:"conflict"`
+}
+
+// FileTransferUnpacked Skipped 为 true 时目标已存在且按 skip 处理
+type FileTransferUnpacked struct {
+	Path    string `json:"path"`
+	Skipped bool   `json:"skipped"`
+}
+
+// FileTransferQuery 查询打包（pack）或解包（unpack）的进度
+type FileTransferQuery struct {
+	ID string `json:"id" validate:"required"`
+	Op string `json:"op" validate:"required,oneof=pack unpack"`
+}
+
+// FileTransferStatus State 为 running、done 或 failed，完成后 Archive 或 Unpacked 为结果
+type FileTransferStatus struct {
+	State    string                `json:"state"`
+	Archive  *FileTransferArchive  `json:"archive,omitempty"`
+	Unpacked *FileTransferUnpacked `json:"unpacked,omitempty"`
+	Error    string                `json:"error,omitempty"`
+}
+
+type FileTransferClean struct {
+	ID string `json:"id" validate:"required"`
+}
+
 type FileUpload struct {
 	Path      string `json:"path" validate:"required"` // 文件路径
 	TotalSize int64  `json:"total_size"`               // 文件总大小（可选，Agent端可校验完整性）
@@ -192,23 +335,44 @@ type FileExist struct {
 	Exist bool `json:"exist"`
 }
 
-type RecycleBinCreate struct {
-	SourcePath string `json:"source_path" validate:"required"`
+// TrashItem 回收站中的一项，Kind 为 delete（删除）或 modify（修改或覆盖前的内容快照）
+type TrashItem struct {
+	ID         string      `json:"id"`
+	Kind       string      `json:"kind"`
+	SourcePath string      `json:"source_path"`
+	Name       string      `json:"name"`
+	IsDir      bool        `json:"is_dir"`
+	Size       int64       `json:"size"`
+	Mode       os.FileMode `json:"mode"`
+	Uid        int         `json:"uid"`
+	Gid        int         `json:"gid"`
+	ModTime    time.Time   `json:"mod_time"`
+	TrashedAt  time.Time   `json:"trashed_at"`
+}
+
+// TrashList Path 不为空时只列出该路径及其下的项
+type TrashList struct {
+	Page     int    `json:"page" validate:"required,min=1"`
+	PageSize int    `json:"page_size" validate:"required,min=1,max=1000"`
+	Path     string `json:"path"`
+}
+
+// TrashRestore 恢复到原位置，原位置已存在时 Overwrite 为 true 则先将现有内容移入回收站
+type TrashRestore struct {
+	ID        string `json:"id" validate:"required"`
+	Overwrite bool   `json:"overwrite"`
 }
 
-type RecycleBinReduce struct {
-	From  string `json:"from" validate:"required"`
-	RName string `json:"rname" validate:"required"`
-	Name  string `json:"name"`
+// TrashPurge 彻底删除指定的项，All 为 true 时清空回收站
+type TrashPurge struct {
+	IDs []string `json:"ids"`
+	All bool     `json:"all"`
 }
 
-type RecycleBinDTO struct {
-	Name       string    `json:"name"`
-	Size       int       `json:"size"`
-	Type       string    `json:"type"`
-	DeleteTime time.Time `json:"delete_time"`
-	RName      string    `json:"rName"`
-	SourcePath string    `json:"source_path"`
-	IsDir      bool      `json:"is_dir"`
-	From       string    `json:"from"`
+// TrashSettings 回收站设置，超过总大小或保留天数时从最早的项开始清理
+type TrashSettings struct {
+	Enabled         bool  `json:"enabled"`
+	MaxSize         int64 `json:"max_size" validate:"min=0"`          // 总大小上限（字节），0 不限制
+	MaxAge          int   `json:"max_age" validate:"min=0"`           // 保留天数，0 不限制
+	MaxSnapshotSize int64 `json:"max_snapshot_size" validate:"min=0"` // 修改或覆盖时保存快照的最大文件大小（字节），0 不保存快照
 }
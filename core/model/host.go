@@ -24,6 +24,9 @@ type HostInfo struct {
 	AgentStatus  AgentStatus `json:"agent_status"`
 	AgentLatest  string      `json:"agent_latest"`
 	CanUpgrade   bool        `json:"can_upgrade"`
+
+	RecordTerminal bool     `json:"record_terminal"`
+	Tags           []string `json:"tags"`
 }
 
 type ListHost struct {
@@ -168,3 +171,38 @@ type HostStatusDTO struct {
 	Rx         float64 `json:"rx"` //接收实时速率
 	Tx         float64 `json:"tx"` //发送实时速率
 }
+
+type UpdateHostTags struct {
+	Tags []string `json:"tags" validate:"dive,required,max=64,excludes=0x2C"`
+}
+
+// QueryHostMetrics 时间为 unix 秒，step 为 0 时按时间范围自动选择
+type QueryHostMetrics struct {
+	From int64 `form:"from" json:"from" validate:"omitempty,min=0"`
+	To   int64 `form:"to" json:"to" validate:"omitempty,min=0"`
+	Step int   `form:"step" json:"step" validate:"omitempty,min=60"`
+}
+
+// HostMetricPoint 一个时间桶内的指标，除 *_max 外均为平均值
+type HostMetricPoint struct {
+	Timestamp      int64   `json:"timestamp"`
+	Cpu            float64 `json:"cpu"`
+	CpuMax         float64 `json:"cpu_max"`
+	Memory         float64 `json:"memory"`
+	MemoryMax      float64 `json:"memory_max"`
+	Disk           float64 `json:"disk"`
+	Rx             float64 `json:"rx"`
+	Tx             float64 `json:"tx"`
+	Goroutines     float64 `json:"goroutines"`
+	OpenFDs        float64 `json:"open_fds"`
+	ActiveSessions float64 `json:"active_sessions"`
+}
+
+type HostMetrics struct {
+	HostID     uint              `json:"host_id"`
+	From       int64             `json:"from"`
+	To         int64             `json:"to"`
+	Step       int               `json:"step"`
+	Resolution int               `json:"resolution"` // 实际使用的存储粒度
+	Points     []HostMetricPoint `json:"points"`
+}
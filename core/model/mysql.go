@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type GetComposesRequest struct {
 	Page     int `json:"page"`
 	PageSize int `json:"page_size"`
@@ -81,3 +83,179 @@ type GetConnectionInfoResponse struct {
 	ContainerConnection ConnectionInfo `json:"container_connection"`
 	PublicConnection    ConnectionInfo `json:"public_connection"`
 }
+
+// DumpRequest 立即导出，Database 为空时导出全部数据库，Redis 忽略 Database
+type DumpRequest struct {
+	Name     string `json:"name" validate:"required"`
+	Database string `json:"database"`
+	Compress bool   `json:"compress"`
+}
+
+// DumpTaskResponse 导出及恢复由插件异步执行，进度写入日志流任务，可通过 /logs/{host}/follow 追踪
+type DumpTaskResponse struct {
+	LogPath string `json:"log_path"`
+}
+
+type ListDumpsRequest struct {
+	Name     string `form:"name" json:"name" validate:"required"`
+	Page     int    `form:"page" json:"page" validate:"required,min=1"`
+	PageSize int    `form:"page_size" json:"page_size" validate:"required,min=1,max=100"`
+}
+
+type DumpInfo struct {
+	File       string    `json:"file"`
+	Database   string    `json:"database"`
+	Size       int64     `json:"size"`
+	Compressed bool      `json:"compressed"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type ListDumpsResponse struct {
+	Total int         `json:"total"`
+	Items []*DumpInfo `json:"items"`
+}
+
+type DeleteDumpRequest struct {
+	Name string `form:"name" json:"name" validate:"required"`
+	File string `form:"file" json:"file" validate:"required,excludesall=/\\"`
+}
+
+// RestoreDumpRequest Database 为空时恢复到导出时的数据库，CreateDatabase 为 true 时先创建目标数据库；Redis 恢复会替换全部数据并重启容器
+type RestoreDumpRequest struct {
+	Name           string `json:"name" validate:"required"`
+	File           string `json:"file" validate:"required,excludesall=/\\"`
+	Database       string `json:"database"`
+	CreateDatabase bool   `json:"create_database"`
+}
+
+type GetDumpScheduleRequest struct {
+	Name string `form:"name" json:"name" validate:"required"`
+}
+
+// DumpSchedule 定时导出，Databases 为空时导出全部数据库，Retention 为保留的导出文件数量，0 表示不清理
+type DumpSchedule struct {
+	Name      string   `json:"name" validate:"required"`
+	Enabled   bool     `json:"enabled"`
+	Schedule  string   `json:"schedule" validate:"required_if=Enabled true"` // cron 表达式
+	Databases []string `json:"databases"`
+	Compress  bool     `json:"compress"`
+	Retention int      `json:"retention" validate:"min=0"`
+}
+
+type ListDatabasesRequest struct {
+	Name string `form:"name" json:"name" validate:"required"`
+}
+
+// DatabaseInfo Charset 对 PostgreSQL 为 encoding，Owner 仅 PostgreSQL 有效，Size 单位为字节
+type DatabaseInfo struct {
+	Name      string `json:"name"`
+	Charset   string `json:"charset"`
+	Collation string `json:"collation"`
+	Owner     string `json:"owner"`
+	Size      int64  `json:"size"`
+}
+
+type ListDatabasesResponse struct {
+	Total int             `json:"total"`
+	Items []*DatabaseInfo `json:"items"`
+}
+
+type CreateDatabaseRequest struct {
+	Name      string `json:"name" validate:"required"`
+	Database  string `json:"database" validate:"required,max=63"`
+	Charset   string `json:"charset"`
+	Collation string `json:"collation"`
+	Owner     string `json:"owner"`
+}
+
+type DropDatabaseRequest struct {
+	Name     string `form:"name" json:"name" validate:"required"`
+	Database string `form:"database" json:"database" validate:"required"`
+}
+
+type ListDatabaseUsersRequest struct {
+	Name string `form:"name" json:"name" validate:"required"`
+}
+
+// DatabaseGrant Privileges 如 SELECT、INSERT，ALL 表示全部权限
+type DatabaseGrant struct {
+	Database   string   `json:"database" validate:"required"`
+	Privileges []string `json:"privileges" validate:"required,min=1,dive,required"`
+}
+
+// DatabaseUser Host 为 MySQL 用户允许登录的来源地址，PostgreSQL 为空
+type DatabaseUser struct {
+	User   string           `json:"user"`
+	Host   string           `json:"host"`
+	Grants []*DatabaseGrant `json:"grants"`
+}
+
+type ListDatabaseUsersResponse struct {
+	Total int             `json:"total"`
+	Items []*DatabaseUser `json:"items"`
+}
+
+// CreateDatabaseUserRequest MySQL 的 Host 为空时使用 %，即不限制来源地址
+type CreateDatabaseUserRequest struct {
+	Name     string           `json:"name" validate:"required"`
+	User     string           `json:"user" validate:"required,max=63"`
+	Host     string           `json:"host"`
+	Password string           `json:"password" validate:"required"`
+	Grants   []*DatabaseGrant `json:"grants" validate:"omitempty,dive"`
+}
+
+type DropDatabaseUserRequest struct {
+	Name string `form:"name" json:"name" validate:"required"`
+	User string `form:"user" json:"user" validate:"required"`
+	Host string `form:"host" json:"host"`
+}
+
+// DatabasePrivilegesRequest 授予或撤销用户在指定数据库上的权限
+type DatabasePrivilegesRequest struct {
+	Name       string   `json:"name" validate:"required"`
+	User       string   `json:"user" validate:"required"`
+	Host       string   `json:"host"`
+	Database   string   `json:"database" validate:"required"`
+	Privileges []string `json:"privileges" validate:"required,min=1,dive,required"`
+}
+
+type SetDatabaseUserPasswordRequest struct {
+	Name     string `json:"name" validate:"required"`
+	User     string `json:"user" validate:"required"`
+	Host     string `json:"host"`
+	Password string `json:"password" validate:"required"`
+}
+
+type ListDatabaseConnectionsRequest struct {
+	Name string `form:"name" json:"name" validate:"required"`
+}
+
+// DatabaseConnection Time 为当前状态持续的秒数
+type DatabaseConnection struct {
+	ID       int64  `json:"id"`
+	User     string `json:"user"`
+	Host     string `json:"host"`
+	Database string `json:"database"`
+	State    string `json:"state"`
+	Time     int64  `json:"time"`
+	Query    string `json:"query"`
+}
+
+type ListDatabaseConnectionsResponse struct {
+	Total int                   `json:"total"`
+	Items []*DatabaseConnection `json:"items"`
+}
+
+// GetSlowLogRequest Lines 为返回的末尾行数，为 0 时由插件决定
+type GetSlowLogRequest struct {
+	Name  string `form:"name" json:"name" validate:"required"`
+	Lines int    `form:"lines" json:"lines" validate:"min=0,max=5000"`
+}
+
+// GetSlowLogResponse Threshold 为慢查询阈值（秒）
+type GetSlowLogResponse struct {
+	Enabled   bool    `json:"enabled"`
+	Threshold float64 `json:"threshold"`
+	Path      string  `json:"path"`
+	Content   string  `json:"content"`
+}
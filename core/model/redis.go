@@ -0,0 +1,157 @@
+package model
+
+// RedisInfoRequest Section 为空时返回 INFO 的默认分组，all 返回全部
+type RedisInfoRequest struct {
+	Name    string `form:"name" json:"name" validate:"required"`
+	Section string `form:"section" json:"section" validate:"omitempty,alphanum"`
+}
+
+type RedisInfoSection struct {
+	Name  string            `json:"name"`
+	Items map[string]string `json:"items"`
+}
+
+type RedisInfoResponse struct {
+	Sections []*RedisInfoSection `json:"sections"`
+}
+
+type RedisKeyspaceRequest struct {
+	Name string `form:"name" json:"name" validate:"required"`
+}
+
+// RedisKeyspaceStat AvgTTL 单位为毫秒
+type RedisKeyspaceStat struct {
+	DB      int   `json:"db"`
+	Keys    int64 `json:"keys"`
+	Expires int64 `json:"expires"`
+	AvgTTL  int64 `json:"avg_ttl"`
+}
+
+type RedisKeyspaceResponse struct {
+	Items []*RedisKeyspaceStat `json:"items"`
+}
+
+// RedisScanKeysRequest 按 SCAN 游标分批浏览，Cursor 为空或 0 表示从头开始，Type 需要 Redis 6.0 及以上
+type RedisScanKeysRequest struct {
+	Name   string `form:"name" json:"name" validate:"required"`
+	DB     int    `form:"db" json:"db" validate:"min=0"`
+	Cursor string `form:"cursor" json:"cursor" validate:"omitempty,numeric"`
+	Match  string `form:"match" json:"match"`
+	Type   string `form:"type" json:"type" validate:"omitempty,oneof=string hash list set zset stream"`
+	Count  int    `form:"count" json:"count" validate:"min=0,max=1000"`
+}
+
+// RedisKeyBrief TTL 为剩余秒数，-1 表示永不过期，-2 表示已不存在
+type RedisKeyBrief struct {
+	Key  string `json:"key"`
+	Type string `json:"type"`
+	TTL  int64  `json:"ttl"`
+}
+
+// RedisScanKeysResponse Cursor 为 0 表示已遍历完成
+type RedisScanKeysResponse struct {
+	Cursor string           `json:"cursor"`
+	Keys   []*RedisKeyBrief `json:"keys"`
+}
+
+// RedisGetKeyRequest Limit 为预览的元素数量，0 表示默认值
+type RedisGetKeyRequest struct {
+	Name  string `form:"name" json:"name" validate:"required"`
+	DB    int    `form:"db" json:"db" validate:"min=0"`
+	Key   string `form:"key" json:"key" validate:"required"`
+	Limit int    `form:"limit" json:"limit" validate:"min=0,max=1000"`
+}
+
+// RedisKeyItem hash 为 Field/Value，list 的 Field 为下标，zset 为 Value/Score，stream 的 Field 为消息 ID、Fields 为消息内容
+type RedisKeyItem struct {
+	Field  string            `json:"field,omitempty"`
+	Value  string            `json:"value,omitempty"`
+	Score  string            `json:"score,omitempty"`
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// RedisKeyValue string 类型的内容在 Value 中，其他类型在 Items 中，Length 为元素数量或字符串字节数
+type RedisKeyValue struct {
+	Key       string          `json:"key"`
+	Type      string          `json:"type"`
+	TTL       int64           `json:"ttl"`
+	Length    int64           `json:"length"`
+	Value     string          `json:"value"`
+	Items     []*RedisKeyItem `json:"items"`
+	Truncated bool            `json:"truncated"`
+}
+
+// RedisSetTTLRequest TTL 为秒数，-1 表示移除过期时间
+type RedisSetTTLRequest struct {
+	Name string `json:"name" validate:"required"`
+	DB   int    `json:"db" validate:"min=0"`
+	Key  string `json:"key" validate:"required"`
+	TTL  int64  `json:"ttl" validate:"min=-1,ne=0"`
+}
+
+type RedisSlowlogRequest struct {
+	Name  string `form:"name" json:"name" validate:"required"`
+	Count int    `form:"count" json:"count" validate:"min=0,max=1000"`
+}
+
+// RedisSlowlogEntry Duration 单位为微秒
+type RedisSlowlogEntry struct {
+	ID         int64    `json:"id"`
+	Timestamp  int64    `json:"timestamp"`
+	Duration   int64    `json:"duration"`
+	Args       []string `json:"args"`
+	Client     string   `json:"client"`
+	ClientName string   `json:"client_name"`
+}
+
+type RedisSlowlogResponse struct {
+	Items []*RedisSlowlogEntry `json:"items"`
+}
+
+type RedisClientsRequest struct {
+	Name string `form:"name" json:"name" validate:"required"`
+}
+
+// RedisClientInfo Age、Idle 单位为秒
+type RedisClientInfo struct {
+	ID    int64  `json:"id"`
+	Addr  string `json:"addr"`
+	Name  string `json:"name"`
+	Age   int64  `json:"age"`
+	Idle  int64  `json:"idle"`
+	DB    int    `json:"db"`
+	Flags string `json:"flags"`
+	Cmd   string `json:"cmd"`
+	User  string `json:"user"`
+}
+
+type RedisClientsResponse struct {
+	Items []*RedisClientInfo `json:"items"`
+}
+
+type RedisKillClientRequest struct {
+	Name string `json:"name" validate:"required"`
+	ID   int64  `json:"id" validate:"required,min=1"`
+}
+
+// RedisBigKeysRequest 通过 SCAN 抽样 Sample 个 key 并以 MEMORY USAGE 估算内存，返回最大的 Top 个
+type RedisBigKeysRequest struct {
+	Name   string `form:"name" json:"name" validate:"required"`
+	DB     int    `form:"db" json:"db" validate:"min=0"`
+	Match  string `form:"match" json:"match"`
+	Sample int    `form:"sample" json:"sample" validate:"min=0,max=100000"`
+	Top    int    `form:"top" json:"top" validate:"min=0,max=100"`
+}
+
+// RedisBigKey Size 为估算的内存占用（字节）
+type RedisBigKey struct {
+	Key    string `json:"key"`
+	Type   string `json:"type"`
+	Size   int64  `json:"size"`
+	Length int64  `json:"length"`
+}
+
+type RedisBigKeysResponse struct {
+	Scanned int            `json:"scanned"`
+	Items   []*RedisBigKey `json:"items"`
+}
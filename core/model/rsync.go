@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type RsyncListTaskRequest struct {
 	Page     int `form:"page" json:"page"`
 	PageSize int `form:"page_size" json:"page_size"`
@@ -88,7 +90,9 @@ type RsyncClientCreateTaskRequest struct {
 	SSHPrivateKey string `json:"ssh_private_key"`
 	RemotePath    string `json:"remote_path" validate:"required"`
 	Module        string `json:"module"`
-	Enqueue       bool   `json:"enqueue"` // whether to start immediately
+	Schedule      string `json:"schedule"` // cron 表达式（如 0 2 * * *）或执行间隔（如 @every 6h、30m），为空表示不定时执行
+	Enqueue       bool   `json:"enqueue"`  // whether to start immediately
+	RsyncOptions
 }
 
 type RsyncClientUpdateTaskRequest struct {
@@ -105,6 +109,8 @@ type RsyncClientUpdateTaskRequest struct {
 	SSHPrivateKey string `json:"ssh_private_key"`
 	RemotePath    string `json:"remote_path" validate:"required"`
 	Module        string `json:"module"`
+	Schedule      string `json:"schedule"` // 同创建，为空表示取消定时执行
+	RsyncOptions
 }
 
 type RsyncClientTask struct {
@@ -126,6 +132,13 @@ type RsyncClientTask struct {
 	State         string `json:"state"`
 	LastError     string `json:"last_error,omitempty"`
 	Attempt       int    `json:"attempt"`
+	Schedule      string `json:"schedule,omitempty"`
+	NextRunAt     string `json:"next_run_at,omitempty"`
+	// 以下两项仅在执行中返回，ProgressPath 可通过 /logs/{host}/follow 追踪，每行为一条 JSON 格式的 RsyncProgress
+	Progress     *RsyncProgress      `json:"progress,omitempty"`
+	ProgressPath string              `json:"progress_path,omitempty"`
+	LastStats    *RsyncTransferStats `json:"last_stats,omitempty"`
+	RsyncOptions
 	// internal runtime fields (not persisted) could be omitted in JSON if desired
 }
 
@@ -145,7 +158,59 @@ type RsyncTaskLogListResponse struct {
 	Logs  []*RsyncTaskLog `json:"logs"`
 }
 
+// RsyncOptions 任务级的 rsync 参数，默认启用压缩
+type RsyncOptions struct {
+	BwLimit        int      `json:"bw_limit" validate:"min=0"` // 带宽限制（KiB/s），0 表示不限制
+	Includes       []string `json:"includes" validate:"omitempty,dive,required,max=1024"`
+	Excludes       []string `json:"excludes" validate:"omitempty,dive,required,max=1024"`
+	FilterFile     string   `json:"filter_file" validate:"omitempty,startswith=/"`              // 设备上的过滤规则文件
+	DeleteMode     string   `json:"delete_mode" validate:"omitempty,oneof=delete delete-after"` // 为空表示不删除接收端多余的文件，可先试运行预览
+	Checksum       bool     `json:"checksum"`
+	NoCompress     bool     `json:"no_compress"`
+	PreserveACLs   bool     `json:"preserve_acls"`
+	PreserveXattrs bool     `json:"preserve_xattrs"`
+}
+
+// RsyncProgress 传输进度，rsync 3.1 以下版本的百分比为当前文件的进度
+type RsyncProgress struct {
+	Percent          int       `json:"percent"`
+	Bytes            int64     `json:"bytes"`
+	Rate             float64   `json:"rate"` // 字节/秒
+	ETA              int       `json:"eta"`  // 剩余秒数
+	FilesTransferred int64     `json:"files_transferred"`
+	FilesRemaining   int64     `json:"files_remaining"`
+	FilesTotal       int64     `json:"files_total"`
+	Done             bool      `json:"done"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// RsyncTransferStats rsync --stats 汇总
+type RsyncTransferStats struct {
+	FilesTotal       int64 `json:"files_total"`
+	FilesCreated     int64 `json:"files_created"`
+	FilesDeleted     int64 `json:"files_deleted"`
+	FilesTransferred int64 `json:"files_transferred"`
+	TotalSize        int64 `json:"total_size"`
+	TransferredSize  int64 `json:"transferred_size"`
+	BytesSent        int64 `json:"bytes_sent"`
+	BytesReceived    int64 `json:"bytes_received"`
+}
+
+// RsyncTaskLog 执行记录，Path 为该次执行的日志文件；早期仅有日志文件的记录不含其余字段
 type RsyncTaskLog struct {
-	ID   string `json:"id"`
-	Path string `json:"path"`
+	ID               string     `json:"id"`
+	Path             string     `json:"path"`
+	ProgressPath     string     `json:"progress_path,omitempty"`
+	RunID            string     `json:"run_id,omitempty"`
+	Trigger          string     `json:"trigger,omitempty"` // manual、schedule
+	State            string     `json:"state,omitempty"`   // succeeded、failed、stopped、skipped
+	StartedAt        *time.Time `json:"started_at,omitempty"`
+	EndedAt          *time.Time `json:"ended_at,omitempty"`
+	ExitCode         int        `json:"exit_code"`
+	BytesTransferred int64      `json:"bytes_transferred"`
+	FilesChanged     int64      `json:"files_changed"`
+	Error            string     `json:"error,omitempty"`
+	// 试运行时返回，任务设置了删除策略时为将被删除的文件
+	DeleteCount   int      `json:"delete_count,omitempty"`
+	DeletePreview []string `json:"delete_preview,omitempty"`
 }
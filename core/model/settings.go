@@ -1,8 +1,25 @@
 package model
 
 type Profile struct {
-	ID   uint   `json:"id"`
-	Name string `json:"name"`
+	ID        uint   `json:"id"`
+	Name      string `json:"name"`
+	Role      string `json:"role"`
+	TwoFactor bool   `json:"two_factor"`
+}
+
+type SecuritySettings struct {
+	TwoFactorRequired bool `json:"two_factor_required"`
+}
+
+// MetricsSettings Prometheus 采集状态，令牌只保存摘要
+type MetricsSettings struct {
+	Enabled bool   `json:"enabled"`
+	Prefix  string `json:"prefix"` // 令牌前几位，用于识别
+}
+
+// MetricsToken 令牌明文仅在生成时返回一次
+type MetricsToken struct {
+	Token string `json:"token"`
 }
 
 type BindIp struct {
@@ -16,3 +16,27 @@ type SessionInfo struct {
 	Time    time.Time `json:"time"`
 	Status  string    `json:"status"`
 }
+
+type TerminalRecordingInfo struct {
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	HostID    uint      `json:"host_id"`
+	UserID    uint      `json:"user_id"`
+	UserName  string    `json:"user_name"`
+	Type      string    `json:"type"`
+	Session   string    `json:"session"`
+	Cols      int       `json:"cols"`
+	Rows      int       `json:"rows"`
+	Size      int64     `json:"size"`
+	Duration  float64   `json:"duration"`
+	Finished  bool      `json:"finished"`
+}
+
+type UpdateRecording struct {
+	Enabled bool `json:"enabled"`
+}
+
+type UpdateUserRecording struct {
+	ID      uint `json:"id" validate:"required"`
+	Enabled bool `json:"enabled"`
+}
@@ -15,12 +15,18 @@ type UserInfo struct {
 	RoleInfo  RoleInfo  `json:"role"`
 	GroupInfo GroupInfo `json:"group"`
 	Valid     uint      `json:"valid"`
+
+	HostGroupIDs   []uint `json:"host_group_ids"`
+	RecordTerminal bool   `json:"record_terminal"`
+	TwoFactor      bool   `json:"two_factor"`
+	Source         string `json:"source"` // local、oidc 等
 }
 
 type CreateUser struct {
 	Name     string `json:"name" validate:"required"`
 	Password string `json:"password" validate:"required"`
 	GroupID  uint   `json:"group_id" validate:"required"`
+	RoleID   uint   `json:"role_id"`
 }
 
 type UpdateUser struct {
@@ -40,3 +46,85 @@ type ChangePassword struct {
 	OldPassword string `json:"old_password" validate:"required"`
 	Password    string `json:"password" validate:"required"`
 }
+
+type UpdateUserRole struct {
+	ID     uint `json:"id" validate:"required"`
+	RoleID uint `json:"role_id" validate:"required"`
+}
+
+// PermissionInfo 权限项，Resource 为路由组（如 hosts、files、docker），* 表示全部
+type PermissionInfo struct {
+	Resource string `json:"resource" validate:"required"`
+	Access   string `json:"access" validate:"required,oneof=read write"`
+}
+
+type RoleDetail struct {
+	ID          uint             `json:"id"`
+	CreatedAt   time.Time        `json:"created_at"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Permissions []PermissionInfo `json:"permissions"`
+
+	HostGroupIDs []uint `json:"host_group_ids"`
+}
+
+type CreateRole struct {
+	Name        string           `json:"name" validate:"required"`
+	Description string           `json:"description"`
+	Permissions []PermissionInfo `json:"permissions" validate:"dive"`
+}
+
+type UpdateRole struct {
+	ID          uint             `json:"id" validate:"required"`
+	Description string           `json:"description"`
+	Permissions []PermissionInfo `json:"permissions" validate:"dive"`
+}
+
+// UpdateHostScope 设置用户或角色可访问的设备组，为空表示不限制
+type UpdateHostScope struct {
+	ID           uint   `json:"id" validate:"required"`
+	HostGroupIDs []uint `json:"host_group_ids"`
+}
+
+type CreateAccessToken struct {
+	Name         string `json:"name" validate:"required"`
+	Access       string `json:"access" validate:"required,oneof=read write"`
+	ExpiresAt    int64  `json:"expires_at"` // unix 秒，0 表示永不过期
+	HostGroupIDs []uint `json:"host_group_ids"`
+}
+
+type QueryAccessToken struct {
+	UserID uint `form:"user_id" json:"user_id"` // 管理员可查看其他用户的令牌
+}
+
+type AccessTokenInfo struct {
+	ID           uint       `json:"id"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UserID       uint       `json:"user_id"`
+	Name         string     `json:"name"`
+	Prefix       string     `json:"prefix"`
+	Access       string     `json:"access"`
+	ExpiresAt    *time.Time `json:"expires_at"`
+	LastUsedAt   *time.Time `json:"last_used_at"`
+	HostGroupIDs []uint     `json:"host_group_ids"`
+}
+
+type AccessTokenCreated struct {
+	AccessTokenInfo
+	Token string `json:"token"` // 明文令牌，仅在创建时返回一次
+}
+
+type QuerySession struct {
+	UserID uint `form:"user_id" json:"user_id" validate:"required"`
+}
+
+type LoginSessionInfo struct {
+	ID         uint       `json:"id"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UserID     uint       `json:"user_id"`
+	SourceIP   string     `json:"source_ip"`
+	UserAgent  string     `json:"user_agent"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	LastSeenAt *time.Time `json:"last_seen_at"`
+	Current    bool       `json:"current"` // 是否为当前请求所用的会话
+}
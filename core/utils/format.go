@@ -2,6 +2,8 @@ package utils
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -79,3 +81,24 @@ func FormatMemorySize(size uint64) string {
 		return fmt.Sprintf("%dB", size)
 	}
 }
+
+// JoinIDs 将 ID 列表保存为逗号分隔的字符串
+func JoinIDs(ids []uint) string {
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		parts = append(parts, strconv.FormatUint(uint64(id), 10))
+	}
+	return strings.Join(parts, ",")
+}
+
+// SplitIDs 解析 JoinIDs 保存的字符串，忽略无效项
+func SplitIDs(value string) []uint {
+	ids := make([]uint, 0)
+	for _, part := range strings.Split(value, ",") {
+		id, err := strconv.ParseUint(strings.TrimSpace(part), 10, 32)
+		if err == nil {
+			ids = append(ids, uint(id))
+		}
+	}
+	return ids
+}
@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRedactJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "top level",
+			data: `{"name":"a","password":"x"}`,
+			want: `{"name":"a","password":"******"}`,
+		},
+		{
+			name: "nested object",
+			data: `{"db":{"port":3306,"root_password":"x"}}`,
+			want: `{"db":{"port":3306,"root_password":"******"}}`,
+		},
+		{
+			name: "object in array",
+			data: `{"users":[{"id":1,"token":"t"}]}`,
+			want: `{"users":[{"id":1,"token":"******"}]}`,
+		},
+		{
+			name: "key case",
+			data: `{"ApiToken":"t","PrivateKey":"k","private_key":"k"}`,
+			want: `{"ApiToken":"******","PrivateKey":"k","private_key":"******"}`,
+		},
+		{
+			name: "sensitive object value",
+			data: `{"secret":{"a":1}}`,
+			want: `{"secret":"******"}`,
+		},
+		{
+			name: "json string field",
+			data: `{"action":"x","data":"{\"agent_key\":\"k\",\"host\":\"h\"}"}`,
+			want: `{"action":"x","data":"{\"agent_key\":\"******\",\"host\":\"h\"}"}`,
+		},
+		{
+			name: "no sensitive keys",
+			data: `{"mode":493,"path":"/etc/passwd"}`,
+			want: `{"mode":493,"path":"/etc/passwd"}`,
+		},
+		{
+			name: "not json",
+			data: `password=x`,
+			want: `password=x`,
+		},
+		{
+			name: "plain string field",
+			data: `{"content":"{not json"}`,
+			want: `{"content":"{not json"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RedactJSON(tt.data); got != tt.want {
+				t.Errorf("RedactJSON(%s) = %s, want %s", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactValues(t *testing.T) {
+	values := map[string][]string{"user": {"a"}, "Password": {"x", "y"}, "access_key": {"k"}}
+	want := map[string][]string{"user": {"a"}, "Password": {"******"}, "access_key": {"******"}}
+	if got := RedactValues(values); !reflect.DeepEqual(got, want) {
+		t.Errorf("RedactValues = %v, want %v", got, want)
+	}
+}
+
+func TestRedactCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		want    string
+	}{
+		{"long option with equals", "pg_dump --password=x db", "pg_dump --password=****** db"},
+		{"long option with space", "app --password x --user y", "app --password ****** --user y"},
+		{"long option quoted", `app --db-pass "a b" -v`, "app --db-pass ****** -v"},
+		{"token option", "cli --token=abc --api-key k", "cli --token=****** --api-key ******"},
+		{"env variable", "FOO_TOKEN=x ./run.sh", "FOO_TOKEN=****** ./run.sh"},
+		{"env after separator", "export MYSQL_PWD=abc; mysql -u root", "export MYSQL_PWD=******; mysql -u root"},
+		{"env quoted", `PGPASSWORD='a b' psql -h db`, "PGPASSWORD=****** psql -h db"},
+		{"mysql short password", "mysql -uroot -pSECRET db", "mysql -uroot -p****** db"},
+		{"mysqldump quoted password", "mysqldump -u root -p'se cret' db > db.sql", "mysqldump -u root -p****** db > db.sql"},
+		{"redis-cli auth", "redis-cli -h 127.0.0.1 -a pass get k", "redis-cli -h 127.0.0.1 -a ****** get k"},
+		{"sshpass", "sshpass -p pass ssh root@host", "sshpass -p ****** ssh root@host"},
+		{"url password", "mysqlsh mysql://root:pass@db:3306", "mysqlsh mysql://root:******@db:3306"},
+		{"multiple commands", "mysql -pa db && redis-cli -a b ping", "mysql -p****** db && redis-cli -a ****** ping"},
+
+		// 不含凭据的命令保持不变
+		{"mkdir parents", "mkdir -p /data/x", "mkdir -p /data/x"},
+		{"cp preserve", "cp -pr a b", "cp -pr a b"},
+		{"docker ps", "docker ps -a", "docker ps -a"},
+		{"mysql without password", "mysql -u root -h db -P 3306", "mysql -u root -h db -P 3306"},
+		{"grep for password", "grep -r password /etc/app", "grep -r password /etc/app"},
+		{"ordinary env", "export PATH=/usr/local/bin:$PATH", "export PATH=/usr/local/bin:$PATH"},
+		{"url without password", "git clone https://github.com/a/b.git", "git clone https://github.com/a/b.git"},
+		{"ssh user host", "ssh root@host uptime", "ssh root@host uptime"},
+		{"ordinary long option", "ls --color=auto /tmp", "ls --color=auto /tmp"},
+		{"redis-cli without auth", "redis-cli -n 1 keys '*'", "redis-cli -n 1 keys '*'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RedactCommand(tt.command); got != tt.want {
+				t.Errorf("RedactCommand(%q) = %q, want %q", tt.command, got, tt.want)
+			}
+		})
+	}
+}
@@ -14,8 +14,8 @@ type Claims struct {
 	jwt.RegisteredClaims
 }
 
-// GenerateJWT generates a JWT token for a given username
-func GenerateJWT(id uint, username string, expire int, key string) (string, error) {
+// GenerateJWT generates a JWT token for a given username, sessionID is stored as the jti claim
+func GenerateJWT(id uint, username string, sessionID string, expire int, key string) (string, error) {
 	jwtKey := []byte(key)
 	expirationTime := time.Now().Add(time.Second * time.Duration(expire))
 	claims := &Claims{
@@ -23,6 +23,7 @@ func GenerateJWT(id uint, username string, expire int, key string) (string, erro
 		Name:       username,
 		BufferTime: 3600,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        sessionID,
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			Issuer:    "idb",
 		},
@@ -72,3 +72,12 @@ func GenerateUuid() string {
 	b[8] = (b[8] & 0x3f) | 0x80
 	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
 }
+
+// GenerateSecureToken 使用 crypto/rand 生成 n 字节随机数，返回十六进制字符串
+func GenerateSecureToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := io.ReadFull(cryptoRand.Reader, b); err != nil {
+		return "", fmt.Errorf("failed to read random bytes: %v", err)
+	}
+	return fmt.Sprintf("%x", b), nil
+}
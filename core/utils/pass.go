@@ -2,6 +2,7 @@ package utils
 
 import (
 	"crypto/md5"
+	"crypto/sha256"
 	"encoding/hex"
 )
 
@@ -21,3 +22,9 @@ func ValidatePassword(storedHash, password, salt string) bool {
 	inputHash := HashPassword(password, salt)
 	return storedHash == inputHash
 }
+
+// HashToken 对高熵的随机令牌（恢复码、访问令牌）做 SHA-256 摘要，无需加盐
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
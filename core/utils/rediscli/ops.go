@@ -0,0 +1,513 @@
+package rediscli
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sensdata/idb/core/model"
+)
+
+const (
+	defaultScanCount    = 100
+	defaultPreview      = 100
+	maxStringPreview    = 64 * 1024
+	defaultBigKeySample = 1000
+	defaultBigKeyTop    = 20
+)
+
+// streamScript 将 XRANGE 的嵌套回复转换为 ID、字段数、字段及值的平铺列表
+const streamScript = `local out = {}
+for _, e in ipairs(redis.call('XRANGE', KEYS[1], '-', '+', 'COUNT', tonumber(ARGV[1]))) do
+  out[#out+1] = e[1]
+  out[#out+1] = #e[2]
+  for _, v in ipairs(e[2]) do out[#out+1] = v end
+end
+return out`
+
+// slowlogScript 将 SLOWLOG GET 的嵌套回复转换为平铺列表，参数前附带参数个数
+const slowlogScript = `local out = {}
+for _, e in ipairs(redis.call('SLOWLOG', 'GET', tonumber(ARGV[1]))) do
+  out[#out+1] = e[1]
+  out[#out+1] = e[2]
+  out[#out+1] = e[3]
+  out[#out+1] = #e[4]
+  for _, a in ipairs(e[4]) do out[#out+1] = a end
+  out[#out+1] = e[5] or ''
+  out[#out+1] = e[6] or ''
+end
+return out`
+
+// Info 解析 INFO 输出，section 为空时返回默认分组
+func (c *CLI) Info(section string) (*model.RedisInfoResponse, error) {
+	args := []string{"INFO"}
+	if section != "" {
+		args = append(args, section)
+	}
+	reply, err := c.Exec(args...)
+	if err != nil {
+		return nil, err
+	}
+	return parseInfo(reply.String()), nil
+}
+
+func parseInfo(text string) *model.RedisInfoResponse {
+	resp := &model.RedisInfoResponse{Sections: []*model.RedisInfoSection{}}
+	var current *model.RedisInfoSection
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			current = &model.RedisInfoSection{
+				Name:  strings.ToLower(strings.TrimSpace(strings.TrimPrefix(line, "#"))),
+				Items: map[string]string{},
+			}
+			resp.Sections = append(resp.Sections, current)
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		if current == nil {
+			current = &model.RedisInfoSection{Items: map[string]string{}}
+			resp.Sections = append(resp.Sections, current)
+		}
+		current.Items[key] = value
+	}
+	return resp
+}
+
+// Keyspace 解析 INFO keyspace，如 db0:keys=1,expires=0,avg_ttl=0
+func (c *CLI) Keyspace() (*model.RedisKeyspaceResponse, error) {
+	info, err := c.Info("keyspace")
+	if err != nil {
+		return nil, err
+	}
+	resp := &model.RedisKeyspaceResponse{Items: []*model.RedisKeyspaceStat{}}
+	for _, section := range info.Sections {
+		for name, value := range section.Items {
+			db, err := strconv.Atoi(strings.TrimPrefix(name, "db"))
+			if err != nil || !strings.HasPrefix(name, "db") {
+				continue
+			}
+			stat := &model.RedisKeyspaceStat{DB: db}
+			for _, field := range strings.Split(value, ",") {
+				k, v, _ := strings.Cut(field, "=")
+				n, _ := strconv.ParseInt(v, 10, 64)
+				switch k {
+				case "keys":
+					stat.Keys = n
+				case "expires":
+					stat.Expires = n
+				case "avg_ttl":
+					stat.AvgTTL = n
+				}
+			}
+			resp.Items = append(resp.Items, stat)
+		}
+	}
+	sort.Slice(resp.Items, func(i, j int) bool { return resp.Items[i].DB < resp.Items[j].DB })
+	return resp, nil
+}
+
+// ScanKeys 执行一次 SCAN，并查询返回 key 的类型及剩余时间
+func (c *CLI) ScanKeys(cursor string, match string, keyType string, count int) (*model.RedisScanKeysResponse, error) {
+	if cursor == "" {
+		cursor = "0"
+	}
+	if count <= 0 {
+		count = defaultScanCount
+	}
+	args := []string{"SCAN", cursor, "COUNT", strconv.Itoa(count)}
+	if match != "" {
+		args = append(args, "MATCH", match)
+	}
+	if keyType != "" {
+		args = append(args, "TYPE", keyType)
+	}
+	reply, err := c.Exec(args...)
+	if err != nil {
+		return nil, err
+	}
+	values := reply.Strings()
+	if len(values) == 0 {
+		return nil, errors.New("unexpected SCAN reply")
+	}
+	resp := &model.RedisScanKeysResponse{Cursor: values[0], Keys: []*model.RedisKeyBrief{}}
+	keys := values[1:]
+	if len(keys) == 0 {
+		return resp, nil
+	}
+
+	cmds := make([][]string, 0, len(keys)*2)
+	for _, key := range keys {
+		cmds = append(cmds, []string{"TYPE", key}, []string{"TTL", key})
+	}
+	replies, err := c.Do(cmds...)
+	if err != nil {
+		return nil, err
+	}
+	for i, key := range keys {
+		resp.Keys = append(resp.Keys, &model.RedisKeyBrief{
+			Key:  key,
+			Type: replies[i*2].String(),
+			TTL:  replies[i*2+1].Int(),
+		})
+	}
+	return resp, nil
+}
+
+// GetKey 按类型预览 key 的内容，最多返回 limit 个元素，字符串最多返回 64KB
+func (c *CLI) GetKey(key string, limit int) (*model.RedisKeyValue, error) {
+	if limit <= 0 {
+		limit = defaultPreview
+	}
+	replies, err := c.Do([]string{"TYPE", key}, []string{"TTL", key})
+	if err != nil {
+		return nil, err
+	}
+	value := &model.RedisKeyValue{
+		Key:   key,
+		Type:  replies[0].String(),
+		TTL:   replies[1].Int(),
+		Items: []*model.RedisKeyItem{},
+	}
+
+	n := strconv.Itoa(limit)
+	switch value.Type {
+	case "none":
+		return nil, fmt.Errorf("key %q not found", key)
+	case "string":
+		err = c.previewString(value)
+	case "list":
+		err = c.preview(value, []string{"LLEN", key}, []string{"LRANGE", key, "0", strconv.Itoa(limit - 1)}, func(values []string) {
+			for i, v := range values {
+				value.Items = append(value.Items, &model.RedisKeyItem{Field: strconv.Itoa(i), Value: v})
+			}
+		})
+	case "set":
+		err = c.previewScan(value, "SCARD", "SSCAN", limit, 1)
+	case "hash":
+		err = c.previewScan(value, "HLEN", "HSCAN", limit, 2)
+	case "zset":
+		err = c.preview(value, []string{"ZCARD", key}, []string{"ZRANGE", key, "0", strconv.Itoa(limit - 1), "WITHSCORES"}, func(values []string) {
+			for i := 0; i+1 < len(values); i += 2 {
+				value.Items = append(value.Items, &model.RedisKeyItem{Value: values[i], Score: values[i+1]})
+			}
+		})
+	case "stream":
+		err = c.preview(value, []string{"XLEN", key}, []string{"EVAL", streamScript, "1", key, n}, func(values []string) {
+			value.Items = parseStream(values)
+		})
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", value.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if value.Type != "string" {
+		value.Truncated = int64(len(value.Items)) < value.Length
+	}
+	return value, nil
+}
+
+func (c *CLI) previewString(value *model.RedisKeyValue) error {
+	replies, err := c.Do(
+		[]string{"STRLEN", value.Key},
+		[]string{"GETRANGE", value.Key, "0", strconv.Itoa(maxStringPreview - 1)},
+	)
+	if err != nil {
+		return err
+	}
+	for _, r := range replies {
+		if r.Err != "" {
+			return errors.New(r.Err)
+		}
+	}
+	value.Length = replies[0].Int()
+	value.Value = replies[1].String()
+	value.Truncated = value.Length > maxStringPreview
+	return nil
+}
+
+// preview 查询元素数量及内容
+func (c *CLI) preview(value *model.RedisKeyValue, lengthCmd []string, rangeCmd []string, parse func([]string)) error {
+	replies, err := c.Do(lengthCmd, rangeCmd)
+	if err != nil {
+		return err
+	}
+	for _, r := range replies {
+		if r.Err != "" {
+			return errors.New(r.Err)
+		}
+	}
+	value.Length = replies[0].Int()
+	parse(replies[1].Strings())
+	return nil
+}
+
+// previewScan 通过 SSCAN、HSCAN 分批读取，避免一次读取过大的集合，width 为每个元素占用的值个数
+func (c *CLI) previewScan(value *model.RedisKeyValue, lengthCmd string, scanCmd string, limit int, width int) error {
+	reply, err := c.Exec(lengthCmd, value.Key)
+	if err != nil {
+		return err
+	}
+	value.Length = reply.Int()
+
+	cursor := "0"
+	// 限制扫描次数，避免集合在扫描过程中持续变化时无法结束
+	for round := 0; round < 20 && len(value.Items) < limit; round++ {
+		reply, err := c.Exec(scanCmd, value.Key, cursor, "COUNT", strconv.Itoa(limit))
+		if err != nil {
+			return err
+		}
+		values := reply.Strings()
+		if len(values) == 0 {
+			return fmt.Errorf("unexpected %s reply", scanCmd)
+		}
+		cursor = values[0]
+		for i := 1; i+width-1 < len(values) && len(value.Items) < limit; i += width {
+			item := &model.RedisKeyItem{Value: values[i]}
+			if width == 2 {
+				item.Field, item.Value = values[i], values[i+1]
+			}
+			value.Items = append(value.Items, item)
+		}
+		if cursor == "0" {
+			break
+		}
+	}
+	return nil
+}
+
+// parseStream 解析 streamScript 的输出
+func parseStream(values []string) []*model.RedisKeyItem {
+	items := []*model.RedisKeyItem{}
+	for i := 0; i+1 < len(values); {
+		item := &model.RedisKeyItem{Field: values[i], Fields: map[string]string{}}
+		count, _ := strconv.Atoi(values[i+1])
+		i += 2
+		for j := 0; j+1 < count && i+1 < len(values); j += 2 {
+			item.Fields[values[i]] = values[i+1]
+			i += 2
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// SetTTL 设置剩余秒数，ttl 为 -1 时移除过期时间
+func (c *CLI) SetTTL(key string, ttl int64) error {
+	var (
+		reply Reply
+		err   error
+	)
+	if ttl == -1 {
+		reply, err = c.Exec("PERSIST", key)
+		if err != nil {
+			return err
+		}
+		exists, err := c.Exec("EXISTS", key)
+		if err != nil {
+			return err
+		}
+		reply = exists
+	} else {
+		if ttl <= 0 {
+			return fmt.Errorf("invalid ttl %d", ttl)
+		}
+		reply, err = c.Exec("EXPIRE", key, strconv.FormatInt(ttl, 10))
+		if err != nil {
+			return err
+		}
+	}
+	if reply.Int() == 0 {
+		return fmt.Errorf("key %q not found", key)
+	}
+	return nil
+}
+
+// Slowlog 返回最近的慢查询
+func (c *CLI) Slowlog(count int) (*model.RedisSlowlogResponse, error) {
+	if count <= 0 {
+		count = 128
+	}
+	reply, err := c.Exec("EVAL", slowlogScript, "0", strconv.Itoa(count))
+	if err != nil {
+		return nil, err
+	}
+	return parseSlowlog(reply.Strings()), nil
+}
+
+func parseSlowlog(values []string) *model.RedisSlowlogResponse {
+	resp := &model.RedisSlowlogResponse{Items: []*model.RedisSlowlogEntry{}}
+	for i := 0; i+3 < len(values); {
+		entry := &model.RedisSlowlogEntry{Args: []string{}}
+		entry.ID, _ = strconv.ParseInt(values[i], 10, 64)
+		entry.Timestamp, _ = strconv.ParseInt(values[i+1], 10, 64)
+		entry.Duration, _ = strconv.ParseInt(values[i+2], 10, 64)
+		argc, _ := strconv.Atoi(values[i+3])
+		i += 4
+		if i+argc+2 > len(values) {
+			break
+		}
+		entry.Args = append(entry.Args, values[i:i+argc]...)
+		i += argc
+		entry.Client, entry.ClientName = values[i], values[i+1]
+		i += 2
+		resp.Items = append(resp.Items, entry)
+	}
+	return resp
+}
+
+// Clients 解析 CLIENT LIST
+func (c *CLI) Clients() (*model.RedisClientsResponse, error) {
+	reply, err := c.Exec("CLIENT", "LIST")
+	if err != nil {
+		return nil, err
+	}
+	return parseClients(reply.String()), nil
+}
+
+func parseClients(text string) *model.RedisClientsResponse {
+	resp := &model.RedisClientsResponse{Items: []*model.RedisClientInfo{}}
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		client := &model.RedisClientInfo{}
+		for _, field := range strings.Fields(line) {
+			k, v, _ := strings.Cut(field, "=")
+			switch k {
+			case "id":
+				client.ID, _ = strconv.ParseInt(v, 10, 64)
+			case "addr":
+				client.Addr = v
+			case "name":
+				client.Name = v
+			case "age":
+				client.Age, _ = strconv.ParseInt(v, 10, 64)
+			case "idle":
+				client.Idle, _ = strconv.ParseInt(v, 10, 64)
+			case "db":
+				client.DB, _ = strconv.Atoi(v)
+			case "flags":
+				client.Flags = v
+			case "cmd":
+				client.Cmd = v
+			case "user":
+				client.User = v
+			}
+		}
+		resp.Items = append(resp.Items, client)
+	}
+	return resp
+}
+
+// KillClient 断开指定 ID 的客户端连接
+func (c *CLI) KillClient(id int64) error {
+	reply, err := c.Exec("CLIENT", "KILL", "ID", strconv.FormatInt(id, 10))
+	if err != nil {
+		return err
+	}
+	if reply.Int() == 0 {
+		return fmt.Errorf("client %d not found", id)
+	}
+	return nil
+}
+
+// BigKeys 抽样 sample 个 key，按 MEMORY USAGE 估算的大小返回最大的 top 个
+func (c *CLI) BigKeys(match string, sample int, top int) (*model.RedisBigKeysResponse, error) {
+	if sample <= 0 {
+		sample = defaultBigKeySample
+	}
+	if top <= 0 {
+		top = defaultBigKeyTop
+	}
+	resp := &model.RedisBigKeysResponse{Items: []*model.RedisBigKey{}}
+	cursor := "0"
+	for resp.Scanned < sample {
+		args := []string{"SCAN", cursor, "COUNT", strconv.Itoa(min(sample-resp.Scanned, 500))}
+		if match != "" {
+			args = append(args, "MATCH", match)
+		}
+		reply, err := c.Exec(args...)
+		if err != nil {
+			return nil, err
+		}
+		values := reply.Strings()
+		if len(values) == 0 {
+			return nil, errors.New("unexpected SCAN reply")
+		}
+		cursor = values[0]
+		keys := values[1:]
+		if len(keys) > sample-resp.Scanned {
+			keys = keys[:sample-resp.Scanned]
+		}
+		if len(keys) > 0 {
+			cmds := make([][]string, 0, len(keys)*2)
+			for _, key := range keys {
+				cmds = append(cmds, []string{"TYPE", key}, []string{"MEMORY", "USAGE", key, "SAMPLES", "5"})
+			}
+			replies, err := c.Do(cmds...)
+			if err != nil {
+				return nil, err
+			}
+			for i, key := range keys {
+				if replies[i*2+1].Err != "" {
+					return nil, errors.New(replies[i*2+1].Err)
+				}
+				resp.Items = append(resp.Items, &model.RedisBigKey{
+					Key:  key,
+					Type: replies[i*2].String(),
+					Size: replies[i*2+1].Int(),
+				})
+			}
+			resp.Scanned += len(keys)
+			sort.Slice(resp.Items, func(i, j int) bool { return resp.Items[i].Size > resp.Items[j].Size })
+			if len(resp.Items) > top {
+				resp.Items = resp.Items[:top]
+			}
+		}
+		if cursor == "0" {
+			break
+		}
+	}
+	return resp, c.fillLength(resp.Items)
+}
+
+// fillLength 查询元素数量，字符串为字节数
+func (c *CLI) fillLength(items []*model.RedisBigKey) error {
+	lengthCmds := map[string]string{
+		"string": "STRLEN",
+		"list":   "LLEN",
+		"set":    "SCARD",
+		"hash":   "HLEN",
+		"zset":   "ZCARD",
+		"stream": "XLEN",
+	}
+	var (
+		cmds    [][]string
+		targets []*model.RedisBigKey
+	)
+	for _, item := range items {
+		if cmd, ok := lengthCmds[item.Type]; ok {
+			cmds = append(cmds, []string{cmd, item.Key})
+			targets = append(targets, item)
+		}
+	}
+	replies, err := c.Do(cmds...)
+	if err != nil {
+		return err
+	}
+	for i, item := range targets {
+		item.Length = replies[i].Int()
+	}
+	return nil
+}
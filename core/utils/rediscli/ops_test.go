@@ -0,0 +1,294 @@
+package rediscli
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sensdata/idb/core/model"
+)
+
+func TestInfo(t *testing.T) {
+	fake := newFake(t, `"# Server\r\nredis_version:7.2.4\r\nredis_mode:standalone\r\n\r\n# Clients\r\nconnected_clients:2\r\n"`+"\n")
+	info, err := New(fake.run, 0).Info("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.done()
+	want := []*model.RedisInfoSection{
+		{Name: "server", Items: map[string]string{"redis_version": "7.2.4", "redis_mode": "standalone"}},
+		{Name: "clients", Items: map[string]string{"connected_clients": "2"}},
+	}
+	if !reflect.DeepEqual(info.Sections, want) {
+		t.Errorf("sections = %+v, want %+v", info.Sections, want)
+	}
+}
+
+func TestKeyspace(t *testing.T) {
+	fake := newFake(t, `"# Keyspace\r\ndb10:keys=5,expires=0,avg_ttl=0\r\ndb0:keys=12,expires=3,avg_ttl=4500\r\n"`+"\n")
+	resp, err := New(fake.run, 0).Keyspace()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fake.commands(); !reflect.DeepEqual(got, []string{`"INFO" "keyspace"`}) {
+		t.Errorf("commands = %v", got)
+	}
+	want := []*model.RedisKeyspaceStat{
+		{DB: 0, Keys: 12, Expires: 3, AvgTTL: 4500},
+		{DB: 10, Keys: 5},
+	}
+	if !reflect.DeepEqual(resp.Items, want) {
+		t.Errorf("items = %+v, want %+v", resp.Items, want)
+	}
+}
+
+func TestScanKeys(t *testing.T) {
+	fake := newFake(t,
+		`"17","user:1","queue"`+"\n",
+		"\"hash\"\n-1\n\"list\"\n30\n",
+	)
+	resp, err := New(fake.run, 0).ScanKeys("", "u*", "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.done()
+	wantCmds := []string{
+		`"SCAN" "0" "COUNT" "100" "MATCH" "u*"`,
+		`"TYPE" "user:1"`, `"TTL" "user:1"`, `"TYPE" "queue"`, `"TTL" "queue"`,
+	}
+	if got := fake.commands(); !reflect.DeepEqual(got, wantCmds) {
+		t.Errorf("commands = %v, want %v", got, wantCmds)
+	}
+	want := &model.RedisScanKeysResponse{
+		Cursor: "17",
+		Keys: []*model.RedisKeyBrief{
+			{Key: "user:1", Type: "hash", TTL: -1},
+			{Key: "queue", Type: "list", TTL: 30},
+		},
+	}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("resp = %+v, want %+v", resp, want)
+	}
+}
+
+func TestScanKeysEmpty(t *testing.T) {
+	// 没有 key 时不再查询类型
+	fake := newFake(t, `"0"`+"\n")
+	resp, err := New(fake.run, 0).ScanKeys("0", "", "string", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.done()
+	if resp.Cursor != "0" || len(resp.Keys) != 0 {
+		t.Errorf("resp = %+v", resp)
+	}
+}
+
+func TestGetKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		outputs []string
+		limit   int
+		want    *model.RedisKeyValue
+		wantErr string
+	}{
+		{
+			name:    "string",
+			outputs: []string{"\"string\"\n-1\n", "5\n\"hello\"\n"},
+			want:    &model.RedisKeyValue{Key: "k", Type: "string", TTL: -1, Length: 5, Value: "hello", Items: []*model.RedisKeyItem{}},
+		},
+		{
+			name:    "list truncated",
+			outputs: []string{"\"list\"\n-1\n", "3\n\"a\",\"b\"\n"},
+			limit:   2,
+			want: &model.RedisKeyValue{Key: "k", Type: "list", TTL: -1, Length: 3, Truncated: true, Items: []*model.RedisKeyItem{
+				{Field: "0", Value: "a"}, {Field: "1", Value: "b"},
+			}},
+		},
+		{
+			name:    "hash scanned in rounds",
+			outputs: []string{"\"hash\"\n60\n", "3\n", "\"9\",\"f1\",\"v1\"\n", "\"0\",\"f2\",\"v2\",\"f3\",\"v3\"\n"},
+			want: &model.RedisKeyValue{Key: "k", Type: "hash", TTL: 60, Length: 3, Items: []*model.RedisKeyItem{
+				{Field: "f1", Value: "v1"}, {Field: "f2", Value: "v2"}, {Field: "f3", Value: "v3"},
+			}},
+		},
+		{
+			name:    "set",
+			outputs: []string{"\"set\"\n-1\n", "2\n", "\"0\",\"m1\",\"m2\"\n"},
+			want: &model.RedisKeyValue{Key: "k", Type: "set", TTL: -1, Length: 2, Items: []*model.RedisKeyItem{
+				{Value: "m1"}, {Value: "m2"},
+			}},
+		},
+		{
+			name:    "zset",
+			outputs: []string{"\"zset\"\n-1\n", "1\n\"z1\",\"1.5\"\n"},
+			want: &model.RedisKeyValue{Key: "k", Type: "zset", TTL: -1, Length: 1, Items: []*model.RedisKeyItem{
+				{Value: "z1", Score: "1.5"},
+			}},
+		},
+		{
+			name:    "stream",
+			outputs: []string{"\"stream\"\n-1\n", "2\n\"1-1\",4,\"a\",\"1\",\"b\",\"2\",\"1-2\",2,\"c\",\"3\"\n"},
+			want: &model.RedisKeyValue{Key: "k", Type: "stream", TTL: -1, Length: 2, Items: []*model.RedisKeyItem{
+				{Field: "1-1", Fields: map[string]string{"a": "1", "b": "2"}},
+				{Field: "1-2", Fields: map[string]string{"c": "3"}},
+			}},
+		},
+		{
+			name:    "missing",
+			outputs: []string{"\"none\"\n-2\n"},
+			wantErr: "not found",
+		},
+		{
+			name:    "range error",
+			outputs: []string{"\"list\"\n-1\n", "3\nERROR,\"OOM command not allowed\"\n"},
+			wantErr: "OOM",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newFake(t, tt.outputs...)
+			got, err := New(fake.run, 0).GetKey("k", tt.limit)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			fake.done()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetKey = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetTTL(t *testing.T) {
+	tests := []struct {
+		name     string
+		ttl      int64
+		outputs  []string
+		wantCmds []string
+		wantErr  bool
+	}{
+		{name: "expire", ttl: 60, outputs: []string{"1\n"}, wantCmds: []string{`"EXPIRE" "k" "60"`}},
+		{name: "persist", ttl: -1, outputs: []string{"0\n", "1\n"}, wantCmds: []string{`"PERSIST" "k"`, `"EXISTS" "k"`}},
+		{name: "missing", ttl: 60, outputs: []string{"0\n"}, wantCmds: []string{`"EXPIRE" "k" "60"`}, wantErr: true},
+		{name: "invalid", ttl: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newFake(t, tt.outputs...)
+			err := New(fake.run, 0).SetTTL("k", tt.ttl)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			fake.done()
+			if got := fake.commands(); !reflect.DeepEqual(got, tt.wantCmds) {
+				t.Errorf("commands = %v, want %v", got, tt.wantCmds)
+			}
+		})
+	}
+}
+
+func TestSlowlog(t *testing.T) {
+	fake := newFake(t, `"7","1700000000","1500","3","SET","k","v","127.0.0.1:5000","app","6","1700000001","20","1","PING","",""`+"\n")
+	resp, err := New(fake.run, 0).Slowlog(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.done()
+	if !strings.HasPrefix(fake.commands()[0], `"EVAL" "local out = {}\x0a`) || !strings.HasSuffix(fake.commands()[0], `"0" "128"`) {
+		t.Errorf("command = %s", fake.commands()[0])
+	}
+	want := []*model.RedisSlowlogEntry{
+		{ID: 7, Timestamp: 1700000000, Duration: 1500, Args: []string{"SET", "k", "v"}, Client: "127.0.0.1:5000", ClientName: "app"},
+		{ID: 6, Timestamp: 1700000001, Duration: 20, Args: []string{"PING"}},
+	}
+	if !reflect.DeepEqual(resp.Items, want) {
+		t.Errorf("items = %+v, want %+v", resp.Items, want)
+	}
+}
+
+func TestClients(t *testing.T) {
+	fake := newFake(t, `"id=3 addr=127.0.0.1:40000 laddr=127.0.0.1:6379 name=worker age=12 idle=1 flags=N db=2 cmd=client|list user=default\nid=4 addr=10.0.0.2:5000 name= age=1 idle=0 flags=N db=0 cmd=get user=app\n"`+"\n")
+	resp, err := New(fake.run, 0).Clients()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.done()
+	want := []*model.RedisClientInfo{
+		{ID: 3, Addr: "127.0.0.1:40000", Name: "worker", Age: 12, Idle: 1, Flags: "N", DB: 2, Cmd: "client|list", User: "default"},
+		{ID: 4, Addr: "10.0.0.2:5000", Age: 1, Flags: "N", Cmd: "get", User: "app"},
+	}
+	if !reflect.DeepEqual(resp.Items, want) {
+		t.Errorf("items = %+v, want %+v", resp.Items, want)
+	}
+}
+
+func TestKillClient(t *testing.T) {
+	fake := newFake(t, "1\n", "0\n")
+	cli := New(fake.run, 0)
+	if err := cli.KillClient(3); err != nil {
+		t.Fatal(err)
+	}
+	if err := cli.KillClient(4); err == nil {
+		t.Error("expected error for unknown client")
+	}
+	fake.done()
+	if got := fake.commands(); !reflect.DeepEqual(got, []string{`"CLIENT" "KILL" "ID" "3"`, `"CLIENT" "KILL" "ID" "4"`}) {
+		t.Errorf("commands = %v", got)
+	}
+}
+
+func TestBigKeys(t *testing.T) {
+	fake := newFake(t,
+		`"5","a","b"`+"\n",
+		"\"string\"\n100\n\"list\"\n5000\n",
+		`"0","c"`+"\n",
+		"\"hash\"\n900\n",
+		"200\n3\n",
+	)
+	resp, err := New(fake.run, 0).BigKeys("", 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.done()
+	want := &model.RedisBigKeysResponse{
+		Scanned: 3,
+		Items: []*model.RedisBigKey{
+			{Key: "b", Type: "list", Size: 5000, Length: 200},
+			{Key: "c", Type: "hash", Size: 900, Length: 3},
+		},
+	}
+	if !reflect.DeepEqual(resp, want) {
+		t.Errorf("resp = %+v, want %+v", resp, want)
+	}
+	cmds := fake.commands()
+	if cmds[0] != `"SCAN" "0" "COUNT" "500"` || cmds[len(cmds)-2] != `"LLEN" "b"` || cmds[len(cmds)-1] != `"HLEN" "c"` {
+		t.Errorf("commands = %v", cmds)
+	}
+}
+
+func TestBigKeysSampleLimit(t *testing.T) {
+	// 达到抽样数量后不再继续扫描
+	fake := newFake(t,
+		`"5","a","b","c"`+"\n",
+		"\"set\"\n10\n\"zset\"\n20\n",
+		"1\n4\n",
+	)
+	resp, err := New(fake.run, 0).BigKeys("k*", 2, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.done()
+	if resp.Scanned != 2 || len(resp.Items) != 2 || resp.Items[0].Key != "b" {
+		t.Errorf("resp = %+v", resp)
+	}
+	if cmds := fake.commands(); cmds[0] != `"SCAN" "0" "COUNT" "2" "MATCH" "k*"` {
+		t.Errorf("commands = %v", cmds)
+	}
+}
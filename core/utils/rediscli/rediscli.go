@@ -0,0 +1,139 @@
+// Package rediscli 通过 redis-cli 执行命令并解析结果，用于管理容器中的 Redis
+//
+// 命令以 --csv 模式从标准输入批量执行，每条命令的回复输出为一行，
+// 嵌套回复在 csv 中会被展开，需要保留结构的命令通过 Lua 脚本转换为带长度的平铺列表。
+package rediscli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// Runner 执行 redis-cli，args 为附加的命令行参数，stdin 中每行为一条命令，返回标准输出
+type Runner func(args []string, stdin string) (string, error)
+
+// LocalRunner 使用本机的 redis-cli 连接指定地址，密码通过 REDISCLI_AUTH 传递
+func LocalRunner(bin string, host string, port int, password string) Runner {
+	return func(args []string, stdin string) (string, error) {
+		cmdArgs := append([]string{"-h", host, "-p", strconv.Itoa(port)}, args...)
+		cmd := exec.Command(bin, cmdArgs...)
+		cmd.Env = os.Environ()
+		if password != "" {
+			cmd.Env = append(cmd.Env, "REDISCLI_AUTH="+password)
+		}
+		return run(cmd, stdin)
+	}
+}
+
+// ContainerRunner 在容器内执行 redis-cli
+func ContainerRunner(container string, password string) Runner {
+	return func(args []string, stdin string) (string, error) {
+		cmdArgs := []string{"exec", "-i"}
+		env := os.Environ()
+		if password != "" {
+			cmdArgs = append(cmdArgs, "-e", "REDISCLI_AUTH")
+			env = append(env, "REDISCLI_AUTH="+password)
+		}
+		cmdArgs = append(cmdArgs, container, "redis-cli")
+		cmd := exec.Command("docker", append(cmdArgs, args...)...)
+		cmd.Env = env
+		return run(cmd, stdin)
+	}
+}
+
+func run(cmd *exec.Cmd, stdin string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd.Stdin = strings.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return stdout.String(), fmt.Errorf("%v: %s", err, msg)
+		}
+		return stdout.String(), err
+	}
+	return stdout.String(), nil
+}
+
+// CLI 在指定的数据库上执行命令
+type CLI struct {
+	run Runner
+	db  int
+}
+
+func New(run Runner, db int) *CLI {
+	return &CLI{run: run, db: db}
+}
+
+// Do 批量执行命令，按顺序返回每条命令的回复，命令本身的错误记录在回复中
+func (c *CLI) Do(cmds ...[]string) ([]Reply, error) {
+	if len(cmds) == 0 {
+		return nil, nil
+	}
+	var stdin strings.Builder
+	for _, cmd := range cmds {
+		for i, arg := range cmd {
+			if i > 0 {
+				stdin.WriteByte(' ')
+			}
+			stdin.WriteString(quote(arg))
+		}
+		stdin.WriteByte('\n')
+	}
+
+	out, err := c.run([]string{"--csv", "-n", strconv.Itoa(c.db)}, stdin.String())
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	if len(lines) != len(cmds) {
+		// 连接或认证失败时 redis-cli 只输出错误信息
+		return nil, fmt.Errorf("unexpected redis-cli output: %s", strings.TrimSpace(out))
+	}
+	replies := make([]Reply, len(lines))
+	for i, line := range lines {
+		reply, err := parseReply(line)
+		if err != nil {
+			return nil, err
+		}
+		replies[i] = reply
+	}
+	return replies, nil
+}
+
+// Exec 执行单条命令，命令返回错误时作为 error 返回
+func (c *CLI) Exec(args ...string) (Reply, error) {
+	replies, err := c.Do(args)
+	if err != nil {
+		return Reply{}, err
+	}
+	if replies[0].Err != "" {
+		return replies[0], errors.New(replies[0].Err)
+	}
+	return replies[0], nil
+}
+
+// quote 按 redis-cli 的参数解析规则加引号，不可打印字符以 \xHH 转义
+func quote(arg string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(arg); i++ {
+		ch := arg[i]
+		switch {
+		case ch == '"' || ch == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(ch)
+		case ch < 0x20 || ch > 0x7e:
+			fmt.Fprintf(&b, "\\x%02x", ch)
+		default:
+			b.WriteByte(ch)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,264 @@
+package rediscli
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os/exec"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeRunner 按顺序返回预设的输出，并记录每次调用的参数及输入
+type fakeRunner struct {
+	t       *testing.T
+	outputs []string
+	args    [][]string
+	stdins  []string
+}
+
+func newFake(t *testing.T, outputs ...string) *fakeRunner {
+	return &fakeRunner{t: t, outputs: outputs}
+}
+
+func (f *fakeRunner) run(args []string, stdin string) (string, error) {
+	f.args = append(f.args, args)
+	f.stdins = append(f.stdins, stdin)
+	if len(f.outputs) == 0 {
+		f.t.Fatalf("unexpected redis-cli call with input %q", stdin)
+	}
+	out := f.outputs[0]
+	f.outputs = f.outputs[1:]
+	return out, nil
+}
+
+// commands 返回所有调用中执行的命令，每行一条
+func (f *fakeRunner) commands() []string {
+	var cmds []string
+	for _, stdin := range f.stdins {
+		cmds = append(cmds, strings.Split(strings.TrimSuffix(stdin, "\n"), "\n")...)
+	}
+	return cmds
+}
+
+func (f *fakeRunner) done() {
+	f.t.Helper()
+	if len(f.outputs) > 0 {
+		f.t.Errorf("%d outputs not consumed", len(f.outputs))
+	}
+}
+
+func TestDo(t *testing.T) {
+	fake := newFake(t, "\"OK\"\n1\nERROR,\"ERR unknown command\"\n")
+	replies, err := New(fake.run, 3).Do(
+		[]string{"SET", "a b", "va\"l"},
+		[]string{"EXISTS", "a b"},
+		[]string{"FOO"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.done()
+
+	if want := []string{"--csv", "-n", "3"}; !reflect.DeepEqual(fake.args[0], want) {
+		t.Errorf("args = %v, want %v", fake.args[0], want)
+	}
+	wantStdin := "\"SET\" \"a b\" \"va\\\"l\"\n\"EXISTS\" \"a b\"\n\"FOO\"\n"
+	if fake.stdins[0] != wantStdin {
+		t.Errorf("stdin = %q, want %q", fake.stdins[0], wantStdin)
+	}
+	want := []Reply{
+		{Values: []Value{{Str: "OK"}}},
+		{Values: []Value{{Str: "1", IsInt: true}}},
+		{Err: "ERR unknown command"},
+	}
+	if !reflect.DeepEqual(replies, want) {
+		t.Errorf("replies = %+v, want %+v", replies, want)
+	}
+}
+
+func TestDoUnexpectedOutput(t *testing.T) {
+	// 认证失败时只输出一行错误
+	fake := newFake(t, "AUTH failed: WRONGPASS invalid username-password pair\n")
+	_, err := New(fake.run, 0).Do([]string{"GET", "a"}, []string{"GET", "b"})
+	if err == nil || !strings.Contains(err.Error(), "WRONGPASS") {
+		t.Fatalf("err = %v, want unexpected output error", err)
+	}
+}
+
+func TestDoRunnerError(t *testing.T) {
+	runErr := errors.New("exit status 1")
+	_, err := New(func([]string, string) (string, error) { return "", runErr }, 0).Do([]string{"PING"})
+	if !errors.Is(err, runErr) {
+		t.Fatalf("err = %v, want %v", err, runErr)
+	}
+}
+
+func TestExec(t *testing.T) {
+	fake := newFake(t, "\"PONG\"\n", "ERROR,\"NOAUTH Authentication required.\"\n")
+	cli := New(fake.run, 0)
+	reply, err := cli.Exec("PING")
+	if err != nil || reply.String() != "PONG" {
+		t.Fatalf("Exec(PING) = %+v, %v", reply, err)
+	}
+	if _, err := cli.Exec("PING"); err == nil || err.Error() != "NOAUTH Authentication required." {
+		t.Fatalf("err = %v, want NOAUTH", err)
+	}
+	fake.done()
+}
+
+// TestLocalRunner 启动本机的 redis-server，通过 redis-cli 执行各项操作，未安装时跳过
+func TestLocalRunner(t *testing.T) {
+	server, err := exec.LookPath("redis-server")
+	if err != nil {
+		t.Skip("redis-server not found in PATH")
+	}
+	client, err := exec.LookPath("redis-cli")
+	if err != nil {
+		t.Skip("redis-cli not found in PATH")
+	}
+
+	port := freePort(t)
+	const password = "test-pass"
+	cmd := exec.Command(server, "--port", fmt.Sprint(port), "--bind", "127.0.0.1",
+		"--save", "", "--appendonly", "no", "--requirepass", password,
+		"--slowlog-log-slower-than", "0", "--dir", t.TempDir())
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+
+	cli := New(LocalRunner(client, "127.0.0.1", port, password), 1)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, err := cli.Exec("PING"); err == nil {
+			break
+		} else if time.Now().After(deadline) {
+			t.Fatalf("redis-server not ready: %v", err)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if _, err := New(LocalRunner(client, "127.0.0.1", port, "wrong"), 1).Exec("PING"); err == nil {
+		t.Error("expected error with wrong password")
+	}
+
+	replies, err := cli.Do(
+		[]string{"SET", "str", "line1\nline2 \"quoted\""},
+		[]string{"RPUSH", "list", "a", "b", "c"},
+		[]string{"HSET", "hash", "f1", "v1", "f2", "v2"},
+		[]string{"SADD", "set", "m1", "m2"},
+		[]string{"ZADD", "zset", "1.5", "z1", "2", "z2"},
+		[]string{"XADD", "stream", "1-1", "k1", "v1", "k2", "v2"},
+		[]string{"EXPIRE", "str", "100"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, r := range replies {
+		if r.Err != "" {
+			t.Fatalf("command %d failed: %s", i, r.Err)
+		}
+	}
+
+	value, err := cli.GetKey("str", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value.Type != "string" || value.Value != "line1\nline2 \"quoted\"" || value.TTL <= 0 {
+		t.Errorf("GetKey(str) = %+v", value)
+	}
+	value, err = cli.GetKey("list", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value.Length != 3 || len(value.Items) != 2 || !value.Truncated || value.Items[1].Value != "b" {
+		t.Errorf("GetKey(list) = %+v", value)
+	}
+	value, err = cli.GetKey("hash", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value.Length != 2 || len(value.Items) != 2 {
+		t.Errorf("GetKey(hash) = %+v", value)
+	}
+	value, err = cli.GetKey("zset", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(value.Items) != 2 || value.Items[0].Value != "z1" || value.Items[0].Score != "1.5" {
+		t.Errorf("GetKey(zset) = %+v", value)
+	}
+	value, err = cli.GetKey("stream", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(value.Items) != 1 || value.Items[0].Field != "1-1" || value.Items[0].Fields["k2"] != "v2" {
+		t.Errorf("GetKey(stream) = %+v", value)
+	}
+
+	keys, err := cli.ScanKeys("", "*", "", 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys.Keys) != 6 {
+		t.Errorf("ScanKeys = %d keys, want 6", len(keys.Keys))
+	}
+	keyspace, err := cli.Keyspace()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keyspace.Items) != 1 || keyspace.Items[0].DB != 1 || keyspace.Items[0].Keys != 6 || keyspace.Items[0].Expires != 1 {
+		t.Errorf("Keyspace = %+v", keyspace.Items)
+	}
+
+	if err := cli.SetTTL("list", 50); err != nil {
+		t.Fatal(err)
+	}
+	if err := cli.SetTTL("list", -1); err != nil {
+		t.Fatal(err)
+	}
+	if err := cli.SetTTL("missing", 50); err == nil {
+		t.Error("expected error for missing key")
+	}
+
+	slowlog, err := cli.Slowlog(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slowlog.Items) == 0 || len(slowlog.Items[0].Args) == 0 {
+		t.Errorf("Slowlog = %+v", slowlog.Items)
+	}
+	clients, err := cli.Clients()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(clients.Items) == 0 || clients.Items[0].ID == 0 {
+		t.Errorf("Clients = %+v", clients.Items)
+	}
+	if err := cli.KillClient(1 << 40); err == nil {
+		t.Error("expected error for unknown client")
+	}
+
+	bigKeys, err := cli.BigKeys("", 0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bigKeys.Scanned != 6 || len(bigKeys.Items) != 3 || bigKeys.Items[0].Size < bigKeys.Items[2].Size {
+		t.Errorf("BigKeys = %+v", bigKeys)
+	}
+}
+
+func freePort(t *testing.T) int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
@@ -0,0 +1,137 @@
+package rediscli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Value csv 回复中的单个值，Nil 对应 NULL
+type Value struct {
+	Str   string
+	IsInt bool
+	Nil   bool
+}
+
+func (v Value) Int() int64 {
+	n, _ := strconv.ParseInt(v.Str, 10, 64)
+	return n
+}
+
+// Reply 单条命令的回复，数组回复展开为 Values
+type Reply struct {
+	Values []Value
+	Err    string
+}
+
+func (r Reply) Strings() []string {
+	out := make([]string, 0, len(r.Values))
+	for _, v := range r.Values {
+		out = append(out, v.Str)
+	}
+	return out
+}
+
+// String 返回第一个值，用于单值回复
+func (r Reply) String() string {
+	if len(r.Values) == 0 {
+		return ""
+	}
+	return r.Values[0].Str
+}
+
+func (r Reply) Int() int64 {
+	if len(r.Values) == 0 {
+		return 0
+	}
+	return r.Values[0].Int()
+}
+
+// parseReply 解析 redis-cli --csv 输出的一行
+func parseReply(line string) (Reply, error) {
+	var reply Reply
+	if strings.HasPrefix(line, "ERROR,") {
+		msg, _, err := unquote(line[len("ERROR,"):])
+		if err != nil {
+			return reply, err
+		}
+		reply.Err = msg
+		return reply, nil
+	}
+	for i := 0; i < len(line); {
+		switch line[i] {
+		case ',':
+			// 空数组在 csv 中没有任何输出
+			i++
+		case '"':
+			s, n, err := unquote(line[i:])
+			if err != nil {
+				return reply, err
+			}
+			reply.Values = append(reply.Values, Value{Str: s})
+			i += n
+		default:
+			end := strings.IndexByte(line[i:], ',')
+			if end < 0 {
+				end = len(line) - i
+			}
+			token := line[i : i+end]
+			switch {
+			case token == "NULL":
+				reply.Values = append(reply.Values, Value{Nil: true})
+			default:
+				_, err := strconv.ParseInt(token, 10, 64)
+				reply.Values = append(reply.Values, Value{Str: token, IsInt: err == nil})
+			}
+			i += end
+		}
+	}
+	return reply, nil
+}
+
+// unquote 解析以双引号开头、由 redis-cli 转义的字符串，返回内容及消耗的字节数
+func unquote(s string) (string, int, error) {
+	if len(s) == 0 || s[0] != '"' {
+		return "", 0, fmt.Errorf("invalid quoted string %q", s)
+	}
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		ch := s[i]
+		if ch == '"' {
+			return b.String(), i + 1, nil
+		}
+		if ch != '\\' {
+			b.WriteByte(ch)
+			continue
+		}
+		i++
+		if i >= len(s) {
+			break
+		}
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'a':
+			b.WriteByte('\a')
+		case 'b':
+			b.WriteByte('\b')
+		case 'x':
+			if i+2 >= len(s) {
+				return "", 0, fmt.Errorf("invalid escape in %q", s)
+			}
+			v, err := strconv.ParseUint(s[i+1:i+3], 16, 8)
+			if err != nil {
+				return "", 0, fmt.Errorf("invalid escape in %q", s)
+			}
+			b.WriteByte(byte(v))
+			i += 2
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated quoted string %q", s)
+}
@@ -0,0 +1,118 @@
+package rediscli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseReply(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    Reply
+		wantErr bool
+	}{
+		{
+			name: "string",
+			line: `"hello"`,
+			want: Reply{Values: []Value{{Str: "hello"}}},
+		},
+		{
+			name: "integer",
+			line: `42`,
+			want: Reply{Values: []Value{{Str: "42", IsInt: true}}},
+		},
+		{
+			name: "negative integer",
+			line: `-2`,
+			want: Reply{Values: []Value{{Str: "-2", IsInt: true}}},
+		},
+		{
+			name: "nil",
+			line: `NULL`,
+			want: Reply{Values: []Value{{Nil: true}}},
+		},
+		{
+			name: "empty array",
+			line: ``,
+			want: Reply{},
+		},
+		{
+			name: "flattened nested array",
+			line: `"0","a","b"`,
+			want: Reply{Values: []Value{{Str: "0"}, {Str: "a"}, {Str: "b"}}},
+		},
+		{
+			name: "mixed values",
+			line: `"a",1,NULL,"b"`,
+			want: Reply{Values: []Value{{Str: "a"}, {Str: "1", IsInt: true}, {Nil: true}, {Str: "b"}}},
+		},
+		{
+			name: "empty nested array between values",
+			line: `"a",,"b"`,
+			want: Reply{Values: []Value{{Str: "a"}, {Str: "b"}}},
+		},
+		{
+			name: "escapes",
+			line: `"a\"b\\c\r\n\t\x00\xff"`,
+			want: Reply{Values: []Value{{Str: "a\"b\\c\r\n\t\x00\xff"}}},
+		},
+		{
+			name: "comma inside quotes",
+			line: `"a,b","c"`,
+			want: Reply{Values: []Value{{Str: "a,b"}, {Str: "c"}}},
+		},
+		{
+			name: "error",
+			line: `ERROR,"WRONGTYPE Operation against a key holding the wrong kind of value"`,
+			want: Reply{Err: "WRONGTYPE Operation against a key holding the wrong kind of value"},
+		},
+		{
+			name:    "unterminated string",
+			line:    `"abc`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid hex escape",
+			line:    `"\xzz"`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseReply(tt.line)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseReply(%q) error = %v, wantErr %v", tt.line, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseReply(%q) = %+v, want %+v", tt.line, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuote(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want string
+	}{
+		{"key", `"key"`},
+		{"with space", `"with space"`},
+		{`a"b\c`, `"a\"b\\c"`},
+		{"line\nbreak", `"line\x0abreak"`},
+		{"\xff", `"\xff"`},
+	}
+	for _, tt := range tests {
+		if got := quote(tt.arg); got != tt.want {
+			t.Errorf("quote(%q) = %s, want %s", tt.arg, got, tt.want)
+		}
+		// 加引号后按 csv 的转义规则能解析回原值
+		s, _, err := unquote(quote(tt.arg))
+		if err != nil || s != tt.arg {
+			t.Errorf("unquote(quote(%q)) = %q, %v", tt.arg, s, err)
+		}
+	}
+}